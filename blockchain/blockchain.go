@@ -44,6 +44,11 @@ func newTBIndex(bulkIndex, indexInBulk uint64) tbindex {
 	return tbindex{BulkIndex: bulkIndex, IndexInBulk: indexInBulk}
 }
 
+type localNonce struct {
+	nonce     uint64
+	timestamp uint64
+}
+
 // Blockchain object for interact with blockchain
 type Blockchain struct {
 	*blockchain.BaseBlockchain
@@ -51,17 +56,30 @@ type Blockchain struct {
 	pricing      *blockchain.Contract
 	reserve      *blockchain.Contract
 	tokenIndices map[string]tbindex
+	// tokenIndicesErr is set while token indices may not match the pricing contract.
+	tokenIndicesErr error
 	// listed tokens is all listed tokens on
 	// our pricing contract
 	listedTokens []ethereum.Address
 	mu           sync.RWMutex
 
-	localSetRateNonce, localDepositNonce         uint64
-	setRateNonceTimestamp, depositNonceTimestamp uint64
+	// localNonces are the cached mined nonces of pricing and deposit operators.
+	localNonces map[string]*localNonce
+	// pricingOPs are the pricing operator followed by the standby pricing operators.
+	pricingOPs []string
 
 	contractAddress *common.ContractAddressConfiguration
 	sr              storage.SettingReader
 	l               *zap.SugaredLogger
+
+	// readQuorum is the number of nodes that must agree on balance and rate fetches.
+	readQuorum int
+}
+
+// SetReadQuorum makes balance and rate fetches succeed only if quorum nodes return the same
+// result, 0 or 1 to read from the healthiest node.
+func (bc *Blockchain) SetReadQuorum(quorum int) {
+	bc.readQuorum = quorum
 }
 
 // ListedTokens return listed tokens from pricing contract
@@ -90,156 +108,88 @@ func (bc *Blockchain) CheckTokenIndices(tokenAddr ethereum.Address) error {
 	return nil
 }
 
-// LoadAndSetTokenIndices load and set token indices
+// LoadAndSetTokenIndices load and set token indices, set rate is blocked until it succeeds
 func (bc *Blockchain) LoadAndSetTokenIndices() error {
-	bc.mu.Lock()
-	defer bc.mu.Unlock()
-	bc.tokenIndices = map[string]tbindex{}
-	opts := bc.GetCallOpts(0)
-	pricingAddr := bc.contractAddress.Pricing
-
 	tokenAddrs, err := bc.getListedTokens()
 	if err != nil {
+		bc.setTokenIndicesError(err)
 		return err
 	}
-	bc.listedTokens = tokenAddrs
-
-	bulkIndices, indicesInBulk, err := bc.GeneratedGetTokenIndicies(
-		opts,
-		pricingAddr,
-		tokenAddrs,
-	)
-	if err != nil {
-		return err
-	}
-	for i, tok := range tokenAddrs {
-		bc.tokenIndices[tok.Hex()] = newTBIndex(
-			bulkIndices[i].Uint64(),
-			indicesInBulk[i].Uint64(),
-		)
-	}
-	bc.l.Infof("Token indices: %+v", bc.tokenIndices)
-	return nil
+	return bc.loadTokenIndices(tokenAddrs)
 }
 
-// RegisterPricingOperator add address as reserve operator for set token rates
+// RegisterPricingOperator add address as reserve operator for set token rates, it replaces the
+// registered one when the key is rotated.
 func (bc *Blockchain) RegisterPricingOperator(signer blockchain.Signer, nonceCorpus blockchain.NonceCorpus) {
 	bc.l.Infow("reserve pricing address", "address", signer.GetAddress().Hex())
-	bc.MustRegisterOperator(blockchain.PricingOP, blockchain.NewOperator(signer, nonceCorpus))
+	bc.ReplaceOperator(blockchain.PricingOP, blockchain.NewOperator(signer, nonceCorpus))
+	bc.ResetMinedNonce(blockchain.PricingOP)
 }
 
-// RegisterDepositOperator add address as depostor for reserve
+// RegisterPricingStandbyOperator add address as the next standby pricing operator, it must be an
+// operator of the pricing contract too.
+func (bc *Blockchain) RegisterPricingStandbyOperator(signer blockchain.Signer, nonceCorpus blockchain.NonceCorpus) {
+	op := blockchain.PricingStandbyOP(len(bc.pricingOPs))
+	bc.l.Infow("reserve standby pricing address", "operator", op, "address", signer.GetAddress().Hex())
+	bc.MustRegisterOperator(op, blockchain.NewOperator(signer, nonceCorpus))
+	bc.localNonces[op] = &localNonce{}
+	bc.pricingOPs = append(bc.pricingOPs, op)
+}
+
+// PricingOperators returns the pricing operator followed by the standby pricing operators.
+func (bc *Blockchain) PricingOperators() []string {
+	return append([]string(nil), bc.pricingOPs...)
+}
+
+// RegisterDepositOperator add address as depostor for reserve, it replaces the registered one
+// when the key is rotated.
 func (bc *Blockchain) RegisterDepositOperator(signer blockchain.Signer, nonceCorpus blockchain.NonceCorpus) {
 	bc.l.Infow("reserve depositor address", "address", signer.GetAddress().Hex())
-	bc.MustRegisterOperator(blockchain.DepositOP, blockchain.NewOperator(signer, nonceCorpus))
+	bc.ReplaceOperator(blockchain.DepositOP, blockchain.NewOperator(signer, nonceCorpus))
+	bc.ResetMinedNonce(blockchain.DepositOP)
 }
 
-func readablePrint(data map[ethereum.Address]byte) string {
-	result := ""
-	for addr, b := range data {
-		result = result + "|" + fmt.Sprintf("%s-%d", addr.Hex(), b)
+// ResetMinedNonce drops the cached mined nonce of op, it must be called when the key of op is
+// replaced as the cached nonce belongs to the old key.
+func (bc *Blockchain) ResetMinedNonce(op string) {
+	if cached, ok := bc.localNonces[op]; ok {
+		*cached = localNonce{}
 	}
-	return result
 }
 
 //====================== Write calls ===============================
 
-// SetRates set token rate to reserve
-// we got a bug when compact is not set to old compact
-// or when one of buy/sell got overflowed, it discards
-// the other's compact
-// TODO: Need better test coverage
+// SetRates set token rate to reserve.
+// It plans the call to send, estimates its gas and simulates the cheapest plan that
+// writes less than every bulk against the pending block before broadcasting. The full plan,
+// which writes every bulk, is the fallback if the others fail the simulation.
 func (bc *Blockchain) SetRates(
+	op string,
 	tokens []ethereum.Address,
 	buys []*big.Int,
 	sells []*big.Int,
 	block *big.Int,
 	nonce *big.Int,
 	gasPrice *big.Int) (*types.Transaction, error) {
-	pricingAddr := bc.contractAddress.Pricing
 	block.Add(block, big.NewInt(1))
-	copts := bc.GetCallOpts(0)
-	baseBuys, baseSells, _, _, _, err := bc.GeneratedGetTokenRates(
-		copts, pricingAddr, tokens,
-	)
+	plans, err := bc.PlanSetRates(op, tokens, buys, sells, block)
 	if err != nil {
 		return nil, err
 	}
-
-	// This is commented out because we dont want to make too much of change. Don't remove
-	// this check, it can be useful in the future.
-	//
-	// Don't submit any txs if it is just trying to set all tokens to 0 when they are already 0
-	// if common.AllZero(buys, sells, baseBuys, baseSells) {
-	// 	return nil, errors.New("Trying to set all rates to 0 but they are already 0. Skip the tx.")
-	// }
-
-	baseTokens := []ethereum.Address{}
-	newBSells := []*big.Int{}
-	newBBuys := []*big.Int{}
-	newCSells := map[ethereum.Address]byte{}
-	newCBuys := map[ethereum.Address]byte{}
-	for i, token := range tokens {
-		compactSell, overflow1 := BigIntToCompactRate(sells[i], baseSells[i])
-		compactBuy, overflow2 := BigIntToCompactRate(buys[i], baseBuys[i])
-		if overflow1 || overflow2 {
-			baseTokens = append(baseTokens, token)
-			newBSells = append(newBSells, sells[i])
-			newBBuys = append(newBBuys, buys[i])
-			newCSells[token] = 0
-			newCBuys[token] = 0
-		} else {
-			newCSells[token] = compactSell.Compact
-			newCBuys[token] = compactBuy.Compact
-		}
+	for _, plan := range plans {
+		bc.l.Infow("set rate plan", "plan", plan.Name, "method", plan.Call.Method,
+			"estimated gas", plan.EstimatedGas, "skipped", len(plan.Skipped))
 	}
-	bbuys, bsells, indices := BuildCompactBulk(
-		newCBuys,
-		newCSells,
-		bc.tokenIndices,
-	)
-	opts, err := bc.GetTxOpts(blockchain.PricingOP, nonce, gasPrice, nil)
-	if err != nil {
-		bc.l.Infow("Getting transaction opts failed", "err", err)
-		return nil, err
-	}
-	var tx *types.Transaction
-	if len(baseTokens) > 0 {
-		// set base tx
-		tx, err = bc.GeneratedSetBaseRate(
-			opts, baseTokens, newBBuys, newBSells,
-			bbuys, bsells, block, indices)
-		if tx != nil {
-			bc.l.Infof(
-				"broadcasting setbase tx %s, target buys(%s), target sells(%s), old base buy(%s) || old base sell(%s) || new base buy(%s) || new base sell(%s) || new compact buy(%s) || new compact sell(%s) || new buy bulk(%v) || new sell bulk(%v) || indices(%v)",
-				tx.Hash().Hex(),
-				buys, sells,
-				baseBuys, baseSells,
-				newBBuys, newBSells,
-				readablePrint(newCBuys), readablePrint(newCSells),
-				bbuys, bsells, indices,
-			)
-		}
-	} else {
-		// update compact tx
-		tx, err = bc.GeneratedSetCompactData(
-			opts, bbuys, bsells, block, indices)
-		if tx != nil {
-			bc.l.Infof(
-				"broadcasting setcompact tx %s, target buys(%s), target sells(%s), old base buy(%s) || old base sell(%s) || new compact buy(%s) || new compact sell(%s) || new buy bulk(%v) || new sell bulk(%v) || indices(%v)",
-				tx.Hash().Hex(),
-				buys, sells,
-				baseBuys, baseSells,
-				readablePrint(newCBuys), readablePrint(newCSells),
-				bbuys, bsells, indices,
-			)
+	for _, plan := range plans {
+		if err := bc.simulateSetRatePlan(op, plan, tokens, buys, sells, block); err != nil {
+			bc.l.Warnw("set rate plan failed simulation", "plan", plan.Name, "err", err)
+			continue
 		}
+		bc.l.Infow("sending set rate plan", "plan", plan.Name, "estimated gas", plan.EstimatedGas,
+			"target buys", buys, "target sells", sells)
+		return bc.sendSetRatePlan(op, plan, block, nonce, gasPrice)
 	}
-	if err != nil {
-		return nil, err
-	}
-	return bc.SignAndBroadcast(tx, blockchain.PricingOP)
-
+	return nil, fmt.Errorf("no set rate plan passed simulation")
 }
 
 // Send withdraw token from reserve to another address (here is cex)
@@ -306,6 +256,7 @@ func (bc *Blockchain) FetchBalanceData(reserve ethereum.Address, atBlock uint64)
 	}
 	timestamp := common.GetTimestamp()
 	opts := bc.GetCallOpts(atBlock)
+	opts.Quorum = bc.readQuorum
 	balances, err := bc.GeneratedGetBalances(opts, reserve, tokens)
 	returnTime := common.GetTimestamp()
 	bc.l.Infow("Fetcher ------> balances", "balances", balances, "err", err)
@@ -372,6 +323,7 @@ func (bc *Blockchain) FetchRates(atBlock uint64, currentBlock uint64) (common.Al
 	}
 	timestamp := common.GetTimestamp()
 	opts := bc.GetCallOpts(atBlock)
+	opts.Quorum = bc.readQuorum
 	pricingAddr := bc.contractAddress.Pricing
 	baseBuys, baseSells, compactBuys, compactSells, blocks, err := bc.GeneratedGetTokenRates(
 		opts, pricingAddr, tokenAddrs,
@@ -420,16 +372,12 @@ func (bc *Blockchain) GetPrice(token ethereum.Address, block *big.Int, priceType
 // and assign it to the nonce from node.
 func (bc *Blockchain) GetMinedNonceWithOP(op string) (uint64, error) {
 	const localNonceExpiration = time.Minute * 2
-	var localNonce, localTimestamp *uint64
-	// base on op value, we bind selected nonce and timestamp field to local var for easier use it with below main logic
-	switch op {
-	case blockchain.PricingOP:
-		localNonce, localTimestamp = &bc.localSetRateNonce, &bc.setRateNonceTimestamp
-	case blockchain.DepositOP:
-		localNonce, localTimestamp = &bc.localDepositNonce, &bc.depositNonceTimestamp
-	default:
+	cached, ok := bc.localNonces[op]
+	if !ok {
 		return 0, fmt.Errorf("get minedNonce for unexpected op [%s]", op)
 	}
+	// bind the cached nonce and timestamp to local var for easier use it with below main logic
+	localNonce, localTimestamp := &cached.nonce, &cached.timestamp
 	nonceFromNode, err := bc.GetMinedNonce(op)
 	if err != nil {
 		return nonceFromNode, err
@@ -488,6 +436,11 @@ func NewBlockchain(base *blockchain.BaseBlockchain,
 		contractAddress: contractAddressConf,
 		sr:              sr,
 		l:               l,
+		localNonces: map[string]*localNonce{
+			blockchain.PricingOP: {},
+			blockchain.DepositOP: {},
+		},
+		pricingOPs: []string{blockchain.PricingOP},
 	}, nil
 }
 
@@ -521,6 +474,11 @@ func (bc *Blockchain) GetReserveAddress() ethereum.Address {
 	return bc.contractAddress.Reserve
 }
 
+// GetPricingAddress return pricing address
+func (bc *Blockchain) GetPricingAddress() ethereum.Address {
+	return bc.contractAddress.Pricing
+}
+
 // GetRateQueryHelperAddress return rateQueryHelperAddress
 func (bc *Blockchain) GetRateQueryHelperAddress() ethereum.Address {
 	return bc.contractAddress.RateQueryHelper
@@ -0,0 +1,73 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+// testNonce is a key with a fixed mined nonce.
+type testNonce struct {
+	address ethereum.Address
+	mined   uint64
+}
+
+func (n testNonce) GetAddress() ethereum.Address {
+	return n.address
+}
+
+func (n testNonce) Sign(tx *types.Transaction) (*types.Transaction, error) {
+	return tx, nil
+}
+
+func (n testNonce) GetNextNonce(*ethclient.Client) (*big.Int, error) {
+	return new(big.Int).SetUint64(n.mined), nil
+}
+
+func (n testNonce) MinedNonce(*ethclient.Client) (*big.Int, error) {
+	return new(big.Int).SetUint64(n.mined), nil
+}
+
+func TestRegisterOperatorResetsMinedNonce(t *testing.T) {
+	base := blockchain.NewBaseBlockchain(nil, nil, map[string]*blockchain.Operator{}, nil, nil)
+	bc, err := NewBlockchain(base, &common.ContractAddressConfiguration{}, nil)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		op       string
+		register func(blockchain.Signer, blockchain.NonceCorpus)
+	}{
+		{op: blockchain.PricingOP, register: bc.RegisterPricingOperator},
+		{op: blockchain.DepositOP, register: bc.RegisterDepositOperator},
+	} {
+		oldKey := testNonce{address: ethereum.HexToAddress("0x1111111111111111111111111111111111111111"), mined: 42}
+		test.register(oldKey, oldKey)
+		nonce, err := bc.GetMinedNonceWithOP(test.op)
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), nonce)
+
+		// the new key has a lower mined nonce, the cached nonce of the old key must not be used
+		newKey := testNonce{address: ethereum.HexToAddress("0x2222222222222222222222222222222222222222"), mined: 3}
+		test.register(newKey, newKey)
+		nonce, err = bc.GetMinedNonceWithOP(test.op)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), nonce, test.op)
+	}
+
+	// the cached nonce is kept while the key is not replaced
+	bc.localNonces[blockchain.PricingOP].nonce = 10
+	nonce, err := bc.GetMinedNonceWithOP(blockchain.PricingOP)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), nonce)
+	bc.ResetMinedNonce(blockchain.PricingOP)
+	nonce, err = bc.GetMinedNonceWithOP(blockchain.PricingOP)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), nonce)
+}
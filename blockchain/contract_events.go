@@ -0,0 +1,152 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	ether "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+func (bc *Blockchain) watchedContracts() map[string]*blockchain.Contract {
+	return map[string]*blockchain.Contract{
+		common.ContractReserve: bc.reserve,
+		common.ContractPricing: bc.pricing,
+		common.ContractWrapper: bc.wrapper,
+	}
+}
+
+// GetContractEvents returns events of the reserve, pricing and wrapper contracts emitted from
+// fromBlock to toBlock, decoded with their ABIs. Only events named in events are returned.
+func (bc *Blockchain) GetContractEvents(fromBlock, toBlock uint64, events []string) ([]common.ContractEvent, error) {
+	contracts := bc.watchedContracts()
+	var (
+		addresses []ethereum.Address
+		topics    []ethereum.Hash
+		seen      = make(map[ethereum.Hash]bool)
+	)
+	for _, contract := range contracts {
+		addresses = append(addresses, contract.Address)
+		for _, name := range events {
+			ev, ok := contract.ABI.Events[name]
+			if ok && !seen[ev.ID] {
+				seen[ev.ID] = true
+				topics = append(topics, ev.ID)
+			}
+		}
+	}
+	if len(topics) == 0 {
+		return nil, nil
+	}
+	logs, err := bc.GetLogs(ether.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: addresses,
+		Topics:    [][]ethereum.Hash{topics},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		result []common.ContractEvent
+		txs    = make(map[ethereum.Hash]*blockchain.RPCTransaction)
+	)
+	for _, log := range logs {
+		if log.Removed || len(log.Topics) == 0 {
+			continue
+		}
+		name, contract := findContract(contracts, log.Address)
+		if contract == nil {
+			continue
+		}
+		event, err := decodeEvent(name, contract, log)
+		if err != nil {
+			// the log is still returned so that it is reported instead of failing every check
+			bc.l.Errorw("failed to decode contract event", "contract", name, "tx", log.TxHash.Hex(),
+				"log_index", log.Index, "err", err)
+			event.DecodeError = err.Error()
+		}
+		tx, ok := txs[log.TxHash]
+		if !ok {
+			if tx, err = bc.getTransaction(log.TxHash); err != nil {
+				return nil, fmt.Errorf("failed to get tx %s of event %s, err: %w", log.TxHash.Hex(), event.Event, err)
+			}
+			txs[log.TxHash] = tx
+		}
+		event.Sender = tx.From
+		// the tx may call another contract, e.g a multisig wallet, which calls the watched contract
+		if method, err := contract.ABI.MethodById(tx.Data()); err == nil {
+			event.Method = method.Name
+		}
+		result = append(result, event)
+	}
+	return result, nil
+}
+
+func (bc *Blockchain) getTransaction(hash ethereum.Hash) (*blockchain.RPCTransaction, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	tx, _, err := bc.TransactionByHash(ctx, hash)
+	return tx, err
+}
+
+func findContract(contracts map[string]*blockchain.Contract, addr ethereum.Address) (string, *blockchain.Contract) {
+	for name, contract := range contracts {
+		if contract.Address == addr {
+			return name, contract
+		}
+	}
+	return "", nil
+}
+
+// decodeEvent decodes log with the ABI of contract, the returned event has the log position and
+// the event name if it is known even if decoding fails.
+func decodeEvent(name string, contract *blockchain.Contract, log types.Log) (common.ContractEvent, error) {
+	event := common.ContractEvent{
+		Contract:    name,
+		Address:     log.Address,
+		Event:       log.Topics[0].Hex(),
+		BlockNumber: log.BlockNumber,
+		TxHash:      log.TxHash,
+		LogIndex:    log.Index,
+	}
+	ev, err := contract.ABI.EventByID(log.Topics[0])
+	if err != nil {
+		return event, err
+	}
+	event.Event = ev.Name
+	params := make(map[string]interface{})
+	if err := ev.Inputs.UnpackIntoMap(params, log.Data); err != nil {
+		return event, fmt.Errorf("failed to decode event %s, err: %w", ev.Name, err)
+	}
+	var indexed abi.Arguments
+	for _, input := range ev.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(params, indexed, log.Topics[1:]); err != nil {
+		return event, fmt.Errorf("failed to decode topics of event %s, err: %w", ev.Name, err)
+	}
+	event.Params = params
+	return event, nil
+}
+
+// GetPricingAdmin returns the admin of the pricing contract at block, 0 for the latest block.
+func (bc *Blockchain) GetPricingAdmin(block uint64) (ethereum.Address, error) {
+	return bc.GeneratedPricingAdmin(bc.GetCallOpts(block))
+}
+
+// IsApprovedWithdrawAddress returns true if addr is an approved withdraw address of token of the
+// reserve at block, 0 for the latest block.
+func (bc *Blockchain) IsApprovedWithdrawAddress(token, addr ethereum.Address, block uint64) (bool, error) {
+	return bc.GeneratedApprovedWithdrawAddresses(bc.GetCallOpts(block), crypto.Keccak256Hash(token.Bytes(), addr.Bytes()))
+}
@@ -0,0 +1,41 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	var (
+		reserveAddr = ethereum.HexToAddress("0x63825c174ab367968EC60f061753D3bbD36A0D8F")
+		token       = ethereum.HexToAddress("0x14535eE720e329f66071B86486763Da4637034aE")
+		sendTo      = ethereum.HexToAddress("0x24535eE720e329F66071b86486763da4637034AE")
+	)
+	reserve := blockchain.NewContract(reserveAddr, reserveABI)
+	ev := reserve.ABI.Events["TokenWithdraw"]
+	data, err := ev.Inputs.Pack(token, big.NewInt(100), sendTo)
+	require.NoError(t, err)
+
+	event, err := decodeEvent(common.ContractReserve, reserve, types.Log{
+		Address:     reserveAddr,
+		Topics:      []ethereum.Hash{ev.ID},
+		Data:        data,
+		BlockNumber: 10,
+		Index:       2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "TokenWithdraw", event.Event)
+	require.Equal(t, common.ContractReserve, event.Contract)
+	require.Equal(t, token, event.Params["token"])
+	require.Equal(t, sendTo, event.Params["sendTo"])
+	require.Equal(t, big.NewInt(100), event.Params["amount"])
+	require.Equal(t, uint64(10), event.BlockNumber)
+	require.Equal(t, uint(2), event.LogIndex)
+}
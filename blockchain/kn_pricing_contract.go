@@ -27,9 +27,9 @@ func (bc *Blockchain) GeneratedSetCompactData(opts blockchain.TxOpts, buy [][14]
 // GeneratedGetRate get token rate from reserve
 func (bc *Blockchain) GeneratedGetRate(opts blockchain.CallOpts, token ethereum.Address, currentBlockNumber *big.Int, buy bool, qty *big.Int) (*big.Int, error) {
 	timeOut := 2 * time.Second
-	out := big.NewInt(0)
+	out := new(*big.Int)
 	err := bc.Call(timeOut, opts, bc.pricing, out, "getRate", token, currentBlockNumber, buy, qty)
-	return out, err
+	return *out, err
 }
 
 // GeneratedGetListedTokens return listed tokens on reserve
@@ -42,3 +42,64 @@ func (bc *Blockchain) GeneratedGetListedTokens(opts blockchain.CallOpts) ([]ethe
 	err := bc.Call(timeout, opts, bc.pricing, out, "getListedTokens")
 	return *ret0, err
 }
+
+// GeneratedPricingAdmin returns the admin of pricing contract
+func (bc *Blockchain) GeneratedPricingAdmin(opts blockchain.CallOpts) (ethereum.Address, error) {
+	var out ethereum.Address
+	err := bc.Call(2*time.Second, opts, bc.pricing, &out, "admin")
+	return out, err
+}
+
+// GeneratedValidRateDurationInBlocks returns the number of blocks a rate is valid after it is set
+func (bc *Blockchain) GeneratedValidRateDurationInBlocks(opts blockchain.CallOpts) (*big.Int, error) {
+	timeOut := 2 * time.Second
+	out := new(*big.Int)
+	err := bc.Call(timeOut, opts, bc.pricing, out, "validRateDurationInBlocks")
+	return *out, err
+}
+
+// GeneratedSetQtyStepFunction build tx to set quantity step functions of token
+func (bc *Blockchain) GeneratedSetQtyStepFunction(opts blockchain.TxOpts, token ethereum.Address, xBuy, yBuy, xSell, ySell []*big.Int) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, bc.pricing, "setQtyStepFunction", token, xBuy, yBuy, xSell, ySell)
+}
+
+// GeneratedSetImbalanceStepFunction build tx to set imbalance step functions of token
+func (bc *Blockchain) GeneratedSetImbalanceStepFunction(opts blockchain.TxOpts, token ethereum.Address, xBuy, yBuy, xSell, ySell []*big.Int) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, bc.pricing, "setImbalanceStepFunction", token, xBuy, yBuy, xSell, ySell)
+}
+
+// GeneratedSetTokenControlInfo build tx to set control info of token
+func (bc *Blockchain) GeneratedSetTokenControlInfo(opts blockchain.TxOpts, token ethereum.Address, minimalRecordResolution, maxPerBlockImbalance, maxTotalImbalance *big.Int) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, bc.pricing, "setTokenControlInfo", token, minimalRecordResolution, maxPerBlockImbalance, maxTotalImbalance)
+}
+
+// GeneratedGetStepFunctionData returns a value of the step functions of token selected by command and param
+func (bc *Blockchain) GeneratedGetStepFunctionData(opts blockchain.CallOpts, token ethereum.Address, command, param *big.Int) (*big.Int, error) {
+	timeOut := 2 * time.Second
+	out := new(*big.Int)
+	err := bc.Call(timeOut, opts, bc.pricing, out, "getStepFunctionData", token, command, param)
+	return *out, err
+}
+
+// GeneratedGetTokenControlInfo returns minimal record resolution, max per block imbalance and max total imbalance of token
+func (bc *Blockchain) GeneratedGetTokenControlInfo(opts blockchain.CallOpts, token ethereum.Address) (*big.Int, *big.Int, *big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(*big.Int)
+		ret2 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+		ret2,
+	}
+	timeOut := 2 * time.Second
+	err := bc.Call(timeOut, opts, bc.pricing, out, "getTokenControlInfo", token)
+	return *ret0, *ret1, *ret2, err
+}
@@ -31,7 +31,8 @@ func TestGeneratedGetListedTokens(t *testing.T) {
 		Client: ethClient,
 		URL:    rpcEndpoint,
 	}
-	contractCaller := baseblockchain.NewContractCaller([]*common.EthClient{&commonEthClient})
+	contractCaller := baseblockchain.NewContractCaller(
+		baseblockchain.NewNodePool([]*common.EthClient{&commonEthClient}, baseblockchain.DefaultMaxBlockLag))
 
 	baseBlockchain := baseblockchain.NewBaseBlockchain(
 		rpcClient,      // rpc client
@@ -15,3 +15,53 @@ func (bc *Blockchain) GeneratedWithdraw(opts blockchain.TxOpts, token ethereum.A
 	defer cancel()
 	return bc.BuildTx(timeout, opts, bc.reserve, "withdraw", token, amount, destination)
 }
+
+// GeneratedEnableTrade build tx to enable trades of the reserve
+func (bc *Blockchain) GeneratedEnableTrade(opts blockchain.TxOpts) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, bc.reserve, "enableTrade")
+}
+
+// GeneratedDisableTrade build tx to disable trades of the reserve
+func (bc *Blockchain) GeneratedDisableTrade(opts blockchain.TxOpts) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, bc.reserve, "disableTrade")
+}
+
+// GeneratedApproveWithdrawAddress build tx to approve or revoke a withdraw address of token
+func (bc *Blockchain) GeneratedApproveWithdrawAddress(opts blockchain.TxOpts, token ethereum.Address, addr ethereum.Address, approve bool) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, bc.reserve, "approveWithdrawAddress", token, addr, approve)
+}
+
+// GeneratedWithdrawToken build tx to withdraw token from the reserve to sendTo
+func (bc *Blockchain) GeneratedWithdrawToken(opts blockchain.TxOpts, token ethereum.Address, amount *big.Int, sendTo ethereum.Address) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, bc.reserve, "withdrawToken", token, amount, sendTo)
+}
+
+// GeneratedWithdrawEther build tx to withdraw ether from the reserve to sendTo
+func (bc *Blockchain) GeneratedWithdrawEther(opts blockchain.TxOpts, amount *big.Int, sendTo ethereum.Address) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, bc.reserve, "withdrawEther", amount, sendTo)
+}
+
+// GeneratedSetContracts build tx to set the network, conversion rates and sanity rates contracts of the reserve
+func (bc *Blockchain) GeneratedSetContracts(opts blockchain.TxOpts, network, conversionRates, sanityRates ethereum.Address) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, bc.reserve, "setContracts", network, conversionRates, sanityRates)
+}
+
+// GeneratedApprovedWithdrawAddresses returns true if the withdraw address hash, keccak256 of token
+// and address, is approved
+func (bc *Blockchain) GeneratedApprovedWithdrawAddresses(opts blockchain.CallOpts, hash ethereum.Hash) (bool, error) {
+	var out bool
+	err := bc.Call(2*time.Second, opts, bc.reserve, &out, "approvedWithdrawAddresses", [32]byte(hash))
+	return out, err
+}
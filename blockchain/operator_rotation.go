@@ -0,0 +1,133 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+// GeneratedGetOperators returns operators of a contract inheriting PermissionGroups
+func (bc *Blockchain) GeneratedGetOperators(opts blockchain.CallOpts, contract *blockchain.Contract) ([]ethereum.Address, error) {
+	timeOut := 2 * time.Second
+	var out []ethereum.Address
+	err := bc.Call(timeOut, opts, contract, &out, "getOperators")
+	return out, err
+}
+
+// GeneratedAddOperator build tx to add operator to a contract inheriting PermissionGroups
+func (bc *Blockchain) GeneratedAddOperator(opts blockchain.TxOpts, contract *blockchain.Contract, operator ethereum.Address) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, contract, "addOperator", operator)
+}
+
+// GeneratedRemoveOperator build tx to remove operator from a contract inheriting PermissionGroups
+func (bc *Blockchain) GeneratedRemoveOperator(opts blockchain.TxOpts, contract *blockchain.Contract, operator ethereum.Address) (*types.Transaction, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return bc.BuildTx(timeout, opts, contract, "removeOperator", operator)
+}
+
+// operatorContract returns the contract op is operator of and the operator which is its admin.
+// The pricing operator sets rates in the pricing contract, the deposit operator withdraws from
+// the reserve.
+func (bc *Blockchain) operatorContract(op string) (*blockchain.Contract, string, error) {
+	switch op {
+	case blockchain.PricingOP:
+		return bc.pricing, blockchain.PricingAdminOP, nil
+	case blockchain.DepositOP:
+		return bc.reserve, blockchain.ReserveAdminOP, nil
+	default:
+		return nil, "", fmt.Errorf("operator %s can not be rotated", op)
+	}
+}
+
+// IsContractOperator returns true if addr is an operator of the contract op is operator of.
+func (bc *Blockchain) IsContractOperator(op string, addr ethereum.Address) (bool, error) {
+	contract, _, err := bc.operatorContract(op)
+	if err != nil {
+		return false, err
+	}
+	operators, err := bc.GeneratedGetOperators(bc.GetCallOpts(0), contract)
+	if err != nil {
+		return false, err
+	}
+	for _, o := range operators {
+		if o == addr {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AddContractOperator adds addr as operator of the contract op is operator of with the admin of
+// the contract, it waits for the tx to be mined and verifies addr is an operator.
+func (bc *Blockchain) AddContractOperator(op string, addr ethereum.Address) (ethereum.Hash, error) {
+	return bc.changeContractOperator(op, addr, true)
+}
+
+// RemoveContractOperator removes addr from operators of the contract op is operator of with the
+// admin of the contract, it waits for the tx to be mined and verifies addr is not an operator.
+func (bc *Blockchain) RemoveContractOperator(op string, addr ethereum.Address) (ethereum.Hash, error) {
+	return bc.changeContractOperator(op, addr, false)
+}
+
+func (bc *Blockchain) changeContractOperator(op string, addr ethereum.Address, add bool) (ethereum.Hash, error) {
+	contract, adminOP, err := bc.operatorContract(op)
+	if err != nil {
+		return ethereum.Hash{}, err
+	}
+	if _, ok := bc.OperatorAddresses()[adminOP]; !ok {
+		return ethereum.Hash{}, fmt.Errorf("admin operator %s of %s is not configured", adminOP, op)
+	}
+	gasPrice, err := bc.RecommendedGasPriceFromNode()
+	if err != nil {
+		return ethereum.Hash{}, fmt.Errorf("failed to get gas price: %w", err)
+	}
+	opts, err := bc.GetTxOpts(adminOP, nil, gasPrice, nil)
+	if err != nil {
+		return ethereum.Hash{}, err
+	}
+	var tx *types.Transaction
+	if add {
+		tx, err = bc.GeneratedAddOperator(opts, contract, addr)
+	} else {
+		tx, err = bc.GeneratedRemoveOperator(opts, contract, addr)
+	}
+	if err != nil {
+		return ethereum.Hash{}, err
+	}
+	if tx, err = bc.SignAndBroadcast(tx, adminOP); err != nil {
+		return ethereum.Hash{}, err
+	}
+	bc.l.Infow("broadcast change operator tx", "operator", op, "address", addr.Hex(), "add", add, "tx", tx.Hash().Hex())
+	if err := bc.waitMined([]ethereum.Hash{tx.Hash()}); err != nil {
+		return tx.Hash(), err
+	}
+	isOperator, err := bc.IsContractOperator(op, addr)
+	if err != nil {
+		return tx.Hash(), fmt.Errorf("failed to read back operators: %w", err)
+	}
+	if isOperator != add {
+		return tx.Hash(), fmt.Errorf("operators of %s are not changed by tx %s", op, tx.Hash().Hex())
+	}
+	return tx.Hash(), nil
+}
+
+// HasPendingTxs returns true if op has sent txs which are not mined yet.
+func (bc *Blockchain) HasPendingTxs(op string) (bool, error) {
+	mined, err := bc.GetMinedNonce(op)
+	if err != nil {
+		return false, err
+	}
+	pending, err := bc.GetPendingNonce(op)
+	if err != nil {
+		return false, err
+	}
+	return pending > mined, nil
+}
@@ -0,0 +1,46 @@
+package blockchain
+
+import (
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+// RegisterFundingOperator add address as funding wallet which tops up ETH of other operators.
+func (bc *Blockchain) RegisterFundingOperator(signer blockchain.Signer, nonceCorpus blockchain.NonceCorpus) {
+	bc.l.Infow("funding address", "address", signer.GetAddress().Hex())
+	bc.MustRegisterOperator(blockchain.FundingOP, blockchain.NewOperator(signer, nonceCorpus))
+}
+
+// TopUpOperator sends amount wei of ETH to addr, from the funding wallet if it is registered or
+// withdrawn from the reserve with the reserve admin otherwise.
+func (bc *Blockchain) TopUpOperator(addr ethereum.Address, amount *big.Int, gasPrice *big.Int) (*types.Transaction, error) {
+	operators := bc.OperatorAddresses()
+	if _, ok := operators[blockchain.FundingOP]; ok {
+		opts, err := bc.GetTxOpts(blockchain.FundingOP, nil, gasPrice, amount)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := bc.BuildSendETHTx(opts, addr)
+		if err != nil {
+			return nil, err
+		}
+		return bc.SignAndBroadcast(tx, blockchain.FundingOP)
+	}
+	if _, ok := operators[blockchain.ReserveAdminOP]; !ok {
+		return nil, ErrReserveAdminNotRegistered
+	}
+	opts, err := bc.GetTxOpts(blockchain.ReserveAdminOP, nil, gasPrice, nil)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := bc.GeneratedWithdrawEther(opts, amount, addr)
+	if err != nil {
+		bc.l.Errorw("failed to create top up tx", "err", err, "address", addr.Hex())
+		return nil, err
+	}
+	return bc.SignAndBroadcast(tx, blockchain.ReserveAdminOP)
+}
@@ -0,0 +1,196 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+const (
+	// pricingTxTimeout is how long operator txs are waited for to be mined.
+	pricingTxTimeout      = 5 * time.Minute
+	pricingTxPollInterval = 5 * time.Second
+
+	// commands of getStepFunctionData, a step function is read with the commands length of x,
+	// x[i], length of y and y[i] starting from its first command.
+	stepFunctionQtyBuy        = 0
+	stepFunctionQtySell       = 4
+	stepFunctionImbalanceBuy  = 8
+	stepFunctionImbalanceSell = 12
+)
+
+// ErrPricingAdminNotRegistered is returned when step functions or control info are set without a
+// pricing admin operator.
+var ErrPricingAdminNotRegistered = errors.New("pricing admin operator is not configured")
+
+// RegisterPricingAdminOperator add address as pricing admin for step functions and control info.
+// Control info can only be set by the admin of the pricing contract, step functions by its
+// operators, so the account should be both. It has its own nonce so that these txs never replace
+// set rate txs.
+func (bc *Blockchain) RegisterPricingAdminOperator(signer blockchain.Signer, nonceCorpus blockchain.NonceCorpus) {
+	bc.l.Infow("pricing admin address", "address", signer.GetAddress().Hex())
+	bc.MustRegisterOperator(blockchain.PricingAdminOP, blockchain.NewOperator(signer, nonceCorpus))
+}
+
+func (bc *Blockchain) hasPricingAdmin() bool {
+	_, ok := bc.OperatorAddresses()[blockchain.PricingAdminOP]
+	return ok
+}
+
+// SetQtyStepFunction sends the tx setting the quantity step functions of a token.
+func (bc *Blockchain) SetQtyStepFunction(fns commonv3.PricingStepFunctions, gasPrice *big.Int) (*types.Transaction, error) {
+	return bc.sendPricingAdminTx(gasPrice, func(opts blockchain.TxOpts) (*types.Transaction, error) {
+		return bc.GeneratedSetQtyStepFunction(opts, fns.Token,
+			fns.QtyBuy.X, fns.QtyBuy.Y, fns.QtySell.X, fns.QtySell.Y)
+	})
+}
+
+// SetImbalanceStepFunction sends the tx setting the imbalance step functions of a token.
+func (bc *Blockchain) SetImbalanceStepFunction(fns commonv3.PricingStepFunctions, gasPrice *big.Int) (*types.Transaction, error) {
+	return bc.sendPricingAdminTx(gasPrice, func(opts blockchain.TxOpts) (*types.Transaction, error) {
+		return bc.GeneratedSetImbalanceStepFunction(opts, fns.Token,
+			fns.ImbalanceBuy.X, fns.ImbalanceBuy.Y, fns.ImbalanceSell.X, fns.ImbalanceSell.Y)
+	})
+}
+
+// SetTokenControlInfo sends the tx setting the control info of a token.
+func (bc *Blockchain) SetTokenControlInfo(info commonv3.PricingControlInfo, gasPrice *big.Int) (*types.Transaction, error) {
+	return bc.sendPricingAdminTx(gasPrice, func(opts blockchain.TxOpts) (*types.Transaction, error) {
+		return bc.GeneratedSetTokenControlInfo(opts, info.Token,
+			info.MinimalRecordResolution, info.MaxPerBlockImbalance, info.MaxTotalImbalance)
+	})
+}
+
+// VerifyStepFunctions reads the step functions of fns.Token back from the pricing contract and
+// returns an error if they differ from fns.
+func (bc *Blockchain) VerifyStepFunctions(fns commonv3.PricingStepFunctions) error {
+	onchain, err := bc.GetStepFunctions(fns.Token)
+	if err != nil {
+		return fmt.Errorf("failed to read back step functions: %w", err)
+	}
+	if !onchain.Equal(fns) {
+		return fmt.Errorf("step functions of %s on chain %+v differ from %+v", fns.Token.Hex(), onchain, fns)
+	}
+	return nil
+}
+
+// VerifyTokenControlInfo reads the control info of info.Token back from the pricing contract and
+// returns an error if it differs from info.
+func (bc *Blockchain) VerifyTokenControlInfo(info commonv3.PricingControlInfo) error {
+	onchain, err := bc.GetTokenControlInfo(info.Token)
+	if err != nil {
+		return fmt.Errorf("failed to read back token control info: %w", err)
+	}
+	if !onchain.Equal(info) {
+		return fmt.Errorf("control info of %s on chain %+v differs from %+v", info.Token.Hex(), onchain, info)
+	}
+	return nil
+}
+
+// GetStepFunctions returns the step functions of token from the pricing contract.
+func (bc *Blockchain) GetStepFunctions(token ethereum.Address) (commonv3.PricingStepFunctions, error) {
+	var (
+		result = commonv3.PricingStepFunctions{Token: token}
+		opts   = bc.GetCallOpts(0)
+		err    error
+	)
+	for _, f := range []struct {
+		command int64
+		fn      *commonv3.PricingStepFunction
+	}{
+		{command: stepFunctionQtyBuy, fn: &result.QtyBuy},
+		{command: stepFunctionQtySell, fn: &result.QtySell},
+		{command: stepFunctionImbalanceBuy, fn: &result.ImbalanceBuy},
+		{command: stepFunctionImbalanceSell, fn: &result.ImbalanceSell},
+	} {
+		if f.fn.X, err = bc.stepFunctionValues(opts, token, f.command); err != nil {
+			return result, err
+		}
+		if f.fn.Y, err = bc.stepFunctionValues(opts, token, f.command+2); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// stepFunctionValues reads the values whose length is returned by command and values by command+1.
+func (bc *Blockchain) stepFunctionValues(opts blockchain.CallOpts, token ethereum.Address, command int64) ([]*big.Int, error) {
+	length, err := bc.GeneratedGetStepFunctionData(opts, token, big.NewInt(command), Big0)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]*big.Int, 0, length.Int64())
+	for i := int64(0); i < length.Int64(); i++ {
+		value, err := bc.GeneratedGetStepFunctionData(opts, token, big.NewInt(command+1), big.NewInt(i))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// GetTokenControlInfo returns the control info of token from the pricing contract.
+func (bc *Blockchain) GetTokenControlInfo(token ethereum.Address) (commonv3.PricingControlInfo, error) {
+	resolution, maxPerBlock, maxTotal, err := bc.GeneratedGetTokenControlInfo(bc.GetCallOpts(0), token)
+	if err != nil {
+		return commonv3.PricingControlInfo{}, err
+	}
+	return commonv3.PricingControlInfo{
+		Token:                   token,
+		MinimalRecordResolution: resolution,
+		MaxPerBlockImbalance:    maxPerBlock,
+		MaxTotalImbalance:       maxTotal,
+	}, nil
+}
+
+func (bc *Blockchain) sendPricingAdminTx(gasPrice *big.Int, build func(opts blockchain.TxOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	if !bc.hasPricingAdmin() {
+		return nil, ErrPricingAdminNotRegistered
+	}
+	opts, err := bc.GetTxOpts(blockchain.PricingAdminOP, nil, gasPrice, nil)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := build(opts)
+	if err != nil {
+		return nil, err
+	}
+	return bc.SignAndBroadcast(tx, blockchain.PricingAdminOP)
+}
+
+// waitMined waits until all txs are mined, it returns an error if any of them fails, is lost or
+// is not mined in time.
+func (bc *Blockchain) waitMined(hashes []ethereum.Hash) error {
+	deadline := time.Now().Add(pricingTxTimeout)
+	for _, hash := range hashes {
+		for {
+			status, _, err := bc.TxStatus(hash)
+			if err == nil {
+				switch status {
+				case common.MiningStatusMined:
+				case common.MiningStatusFailed, common.MiningStatusLost:
+					return fmt.Errorf("tx %s is %s", hash.Hex(), status)
+				default:
+					err = fmt.Errorf("tx %s is %s", hash.Hex(), status)
+				}
+			}
+			if err == nil {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("tx %s is not mined after %v: %w", hash.Hex(), pricingTxTimeout, err)
+			}
+			time.Sleep(pricingTxPollInterval)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// ErrReserveAdminNotRegistered is returned when a reserve admin operation is sent without a
+// reserve admin operator.
+var ErrReserveAdminNotRegistered = errors.New("reserve admin operator is not configured")
+
+// RegisterReserveAdminOperator add address as reserve admin for admin operations of the reserve
+// contract. The account should be the admin of the reserve and one of its alerters to disable
+// trades. Withdrawals to admin are sent to this address.
+func (bc *Blockchain) RegisterReserveAdminOperator(signer blockchain.Signer, nonceCorpus blockchain.NonceCorpus) {
+	bc.l.Infow("reserve admin address", "address", signer.GetAddress().Hex())
+	bc.MustRegisterOperator(blockchain.ReserveAdminOP, blockchain.NewOperator(signer, nonceCorpus))
+}
+
+// SendReserveAdmin builds the tx of a reserve admin operation, asset is only used by operations
+// applying to an asset, then signs and broadcasts it with the reserve admin operator.
+func (bc *Blockchain) SendReserveAdmin(entry commonv3.ReserveAdminEntry, asset commonv3.Asset, gasPrice *big.Int) (*types.Transaction, error) {
+	if _, ok := bc.OperatorAddresses()[blockchain.ReserveAdminOP]; !ok {
+		return nil, ErrReserveAdminNotRegistered
+	}
+	opts, err := bc.GetTxOpts(blockchain.ReserveAdminOP, nil, gasPrice, nil)
+	if err != nil {
+		return nil, err
+	}
+	var tx *types.Transaction
+	switch entry.Operation {
+	case commonv3.ReserveAdminEnableTrade:
+		tx, err = bc.GeneratedEnableTrade(opts)
+	case commonv3.ReserveAdminDisableTrade:
+		tx, err = bc.GeneratedDisableTrade(opts)
+	case commonv3.ReserveAdminApproveWithdrawAddress:
+		tx, err = bc.GeneratedApproveWithdrawAddress(opts, asset.Address, entry.Address, entry.Approve)
+	case commonv3.ReserveAdminWithdrawToAdmin:
+		amount := common.FloatToBigInt(entry.Amount, int64(asset.Decimals))
+		if common.IsEthereumAddress(asset.Address) {
+			tx, err = bc.GeneratedWithdrawEther(opts, amount, opts.Operator.Address)
+		} else {
+			tx, err = bc.GeneratedWithdrawToken(opts, asset.Address, amount, opts.Operator.Address)
+		}
+	case commonv3.ReserveAdminSetContracts:
+		tx, err = bc.GeneratedSetContracts(opts, entry.Network, entry.ConversionRates, entry.SanityRates)
+	default:
+		return nil, fmt.Errorf("unknown reserve admin operation %q", entry.Operation)
+	}
+	if err != nil {
+		bc.l.Errorw("failed to create reserve admin tx", "err", err, "operation", entry.Operation)
+		return nil, err
+	}
+	return bc.SignAndBroadcast(tx, blockchain.ReserveAdminOP)
+}
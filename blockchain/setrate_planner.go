@@ -0,0 +1,305 @@
+package blockchain
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// SetRatePlanFull writes every bulk of the set rate tokens in one call.
+	SetRatePlanFull = "full"
+	// SetRatePlanChanged writes only the bulks that changed or are about to expire in one call.
+	SetRatePlanChanged = "changed"
+
+	methodSetBaseRate    = "setBaseRate"
+	methodSetCompactData = "setCompactData"
+
+	// extraGasLimit is added to the estimated gas of a set rate call, the same as for other txs.
+	extraGasLimit = 50000
+	// simulatedRateTolerance is the relative difference allowed between the simulated and the
+	// intended rate of a token, compact data has a resolution of 0.1%.
+	simulatedRateTolerance = 0.001
+)
+
+// SetRateCall is the setBaseRate or setCompactData call of a set rate plan.
+type SetRateCall struct {
+	Method     string             `json:"method"`
+	BaseTokens []ethereum.Address `json:"base_tokens,omitempty"`
+	BaseBuys   []*big.Int         `json:"base_buys,omitempty"`
+	BaseSells  []*big.Int         `json:"base_sells,omitempty"`
+	Buys       [][14]byte         `json:"-"`
+	Sells      [][14]byte         `json:"-"`
+	Indices    []*big.Int         `json:"indices"`
+}
+
+func (c SetRateCall) params(block *big.Int) []interface{} {
+	if c.Method == methodSetBaseRate {
+		return []interface{}{c.BaseTokens, c.BaseBuys, c.BaseSells, c.Buys, c.Sells, block, c.Indices}
+	}
+	return []interface{}{c.Buys, c.Sells, block, c.Indices}
+}
+
+// SetRatePlan is a way to set rates of tokens with one call sent in one tx.
+type SetRatePlan struct {
+	Name         string      `json:"name"`
+	Call         SetRateCall `json:"call"`
+	EstimatedGas uint64      `json:"estimated_gas"`
+	// Skipped are the tokens the plan doesn't write, their on-chain rates must already be the intended ones.
+	Skipped []ethereum.Address `json:"skipped,omitempty"`
+}
+
+// onchainRate is the rate data of a token in pricing contract.
+type onchainRate struct {
+	baseBuy     *big.Int
+	baseSell    *big.Int
+	compactBuy  int8
+	compactSell int8
+	block       uint64
+}
+
+// buildSetRatePlans returns the plans to set buys and sells of tokens at block, full plan first.
+// A bulk is changed if any of its tokens has new base or compact rate, or if it was set
+// refreshBlocks or more blocks ago. Tokens in unchanged bulks are skipped by the changed plan.
+func buildSetRatePlans(tokens []ethereum.Address, buys, sells []*big.Int, onchain []onchainRate,
+	indices map[string]tbindex, block, refreshBlocks uint64) []SetRatePlan {
+	var (
+		baseTokens   []ethereum.Address
+		baseBuys     []*big.Int
+		baseSells    []*big.Int
+		newCBuys     = map[ethereum.Address]byte{}
+		newCSells    = map[ethereum.Address]byte{}
+		changedBulks = map[uint64]bool{}
+	)
+	for i, token := range tokens {
+		bulkIndex := indices[token.Hex()].BulkIndex
+		compactSell, overflow1 := BigIntToCompactRate(sells[i], onchain[i].baseSell)
+		compactBuy, overflow2 := BigIntToCompactRate(buys[i], onchain[i].baseBuy)
+		if overflow1 || overflow2 {
+			baseTokens = append(baseTokens, token)
+			baseBuys = append(baseBuys, buys[i])
+			baseSells = append(baseSells, sells[i])
+			newCBuys[token] = 0
+			newCSells[token] = 0
+			changedBulks[bulkIndex] = true
+			continue
+		}
+		newCBuys[token] = compactBuy.Compact
+		newCSells[token] = compactSell.Compact
+		if compactBuy.Compact != byte(onchain[i].compactBuy) ||
+			compactSell.Compact != byte(onchain[i].compactSell) ||
+			block >= onchain[i].block+refreshBlocks {
+			changedBulks[bulkIndex] = true
+		}
+	}
+
+	method := methodSetCompactData
+	if len(baseTokens) > 0 {
+		method = methodSetBaseRate
+	}
+	call := func(inBulk func(uint64) bool) SetRateCall {
+		cBuys, cSells := map[ethereum.Address]byte{}, map[ethereum.Address]byte{}
+		for _, token := range tokens {
+			if inBulk(indices[token.Hex()].BulkIndex) {
+				cBuys[token] = newCBuys[token]
+				cSells[token] = newCSells[token]
+			}
+		}
+		result := SetRateCall{Method: method}
+		result.Buys, result.Sells, result.Indices = BuildCompactBulk(cBuys, cSells, indices)
+		if method == methodSetBaseRate {
+			result.BaseTokens, result.BaseBuys, result.BaseSells = baseTokens, baseBuys, baseSells
+		}
+		return result
+	}
+	skipped := func(inBulk func(uint64) bool) []ethereum.Address {
+		var result []ethereum.Address
+		for _, token := range tokens {
+			if !inBulk(indices[token.Hex()].BulkIndex) {
+				result = append(result, token)
+			}
+		}
+		return result
+	}
+	all := func(uint64) bool { return true }
+	changed := func(bulk uint64) bool { return changedBulks[bulk] }
+
+	plans := []SetRatePlan{{Name: SetRatePlanFull, Call: call(all)}}
+	changedSkipped := skipped(changed)
+	if len(changedBulks) == 0 || len(changedSkipped) == 0 {
+		return plans
+	}
+	return append(plans, SetRatePlan{
+		Name:    SetRatePlanChanged,
+		Call:    call(changed),
+		Skipped: changedSkipped,
+	})
+}
+
+// PlanSetRates returns the plans to set buys and sells of tokens at block with their
+// estimated gas sent by op, cheapest first. Plans that fail to estimate gas are left out.
+func (bc *Blockchain) PlanSetRates(op string, tokens []ethereum.Address, buys, sells []*big.Int, block *big.Int) ([]SetRatePlan, error) {
+	indices, err := bc.tokenIndicesOf(tokens)
+	if err != nil {
+		return nil, err
+	}
+	copts := bc.GetCallOpts(0)
+	baseBuys, baseSells, compactBuys, compactSells, blocks, err := bc.GeneratedGetTokenRates(
+		copts, bc.contractAddress.Pricing, tokens,
+	)
+	if err != nil {
+		return nil, err
+	}
+	onchain := make([]onchainRate, len(tokens))
+	for i := range tokens {
+		onchain[i] = onchainRate{
+			baseBuy:     baseBuys[i],
+			baseSell:    baseSells[i],
+			compactBuy:  compactBuys[i],
+			compactSell: compactSells[i],
+			block:       blocks[i].Uint64(),
+		}
+	}
+	// refresh bulks at half of their valid duration, or always if it is unknown
+	var refreshBlocks uint64
+	validDuration, err := bc.GeneratedValidRateDurationInBlocks(copts)
+	if err != nil {
+		bc.l.Warnw("failed to get valid rate duration, refresh all bulks", "err", err)
+	} else {
+		refreshBlocks = validDuration.Uint64() / 2
+	}
+
+	var result []SetRatePlan
+	for _, plan := range buildSetRatePlans(tokens, buys, sells, onchain, indices, block.Uint64(), refreshBlocks) {
+		if err := bc.estimateSetRatePlan(op, &plan, block); err != nil {
+			bc.l.Warnw("failed to estimate gas of set rate plan", "plan", plan.Name, "err", err)
+			continue
+		}
+		result = append(result, plan)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("failed to estimate gas of all set rate plans")
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].EstimatedGas < result[j].EstimatedGas
+	})
+	return result, nil
+}
+
+func (bc *Blockchain) estimateSetRatePlan(op string, plan *SetRatePlan, block *big.Int) error {
+	gas, err := bc.EstimateTxGas(op, bc.pricing, plan.Call.Method, plan.Call.params(block)...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", plan.Call.Method, err)
+	}
+	plan.EstimatedGas = gas
+	return nil
+}
+
+// simulateSetRatePlan runs the call of plan from op against the pending block and reads the rates
+// of every token in the resulting state, in one eth_call. The rates are computed from the base
+// and compact rates like getRate does before applying the step functions, which would move them
+// away from the intended rates. The plan fails if the call reverts, if any token's rate is off or
+// if the rate of any token expires before block.
+func (bc *Blockchain) simulateSetRatePlan(op string, plan SetRatePlan, tokens []ethereum.Address, buys, sells []*big.Int, block *big.Int) error {
+	setRate, err := bc.pricing.ABI.Pack(plan.Call.Method, plan.Call.params(block)...)
+	if err != nil {
+		return err
+	}
+	getTokenRates, err := bc.wrapper.ABI.Pack("getTokenRates", bc.contractAddress.Pricing, tokens)
+	if err != nil {
+		return err
+	}
+	validDuration, err := bc.pricing.ABI.Pack("validRateDurationInBlocks")
+	if err != nil {
+		return err
+	}
+	results, err := bc.SimulateCalls(op,
+		[]ethereum.Address{bc.contractAddress.Pricing, bc.contractAddress.Wrapper, bc.contractAddress.Pricing},
+		[][]byte{setRate, getTokenRates, validDuration},
+	)
+	if err != nil {
+		return fmt.Errorf("%s reverted: %w", plan.Call.Method, err)
+	}
+	var (
+		baseBuys     = new([]*big.Int)
+		baseSells    = new([]*big.Int)
+		compactBuys  = new([]int8)
+		compactSells = new([]int8)
+		blocks       = new([]*big.Int)
+		duration     = new(*big.Int)
+	)
+	out := &[]interface{}{baseBuys, baseSells, compactBuys, compactSells, blocks}
+	if err := bc.wrapper.ABI.Unpack(out, "getTokenRates", results[1]); err != nil {
+		return err
+	}
+	if err := bc.pricing.ABI.Unpack(duration, "validRateDurationInBlocks", results[2]); err != nil {
+		return err
+	}
+	for i, token := range tokens {
+		expiry := new(big.Int).Add((*blocks)[i], *duration)
+		if expiry.Cmp(block) <= 0 {
+			return fmt.Errorf("rates of %s set at block %s expire before block %s", token.Hex(), (*blocks)[i], block)
+		}
+		for _, side := range []struct {
+			name     string
+			rate     *big.Int
+			intended *big.Int
+		}{
+			{"buy", compactRate((*baseBuys)[i], (*compactBuys)[i]), buys[i]},
+			{"sell", compactRate((*baseSells)[i], (*compactSells)[i]), sells[i]},
+		} {
+			if !rateMatches(side.rate, side.intended) {
+				return fmt.Errorf("%s rate of %s is %s, want %s", side.name, token.Hex(), side.rate, side.intended)
+			}
+		}
+	}
+	return nil
+}
+
+// compactRate returns base adjusted by compact, in 0.1% steps, like the pricing contract does.
+func compactRate(base *big.Int, compact int8) *big.Int {
+	rate := new(big.Int).Mul(base, big.NewInt(10000+int64(compact)*10))
+	return rate.Div(rate, big.NewInt(10000))
+}
+
+// rateMatches returns true if rate is within simulatedRateTolerance of intended.
+func rateMatches(rate, intended *big.Int) bool {
+	if intended.Sign() == 0 {
+		return rate.Sign() == 0
+	}
+	diff := new(big.Float).SetInt(new(big.Int).Sub(rate, intended))
+	relative, _ := new(big.Float).Quo(diff, new(big.Float).SetInt(intended)).Float64()
+	return relative <= simulatedRateTolerance && relative >= -simulatedRateTolerance
+}
+
+// sendSetRatePlan broadcasts the call of plan from op with nonce.
+func (bc *Blockchain) sendSetRatePlan(op string, plan SetRatePlan, block, nonce, gasPrice *big.Int) (*types.Transaction, error) {
+	opts, err := bc.GetTxOpts(op, nonce, gasPrice, nil)
+	if err != nil {
+		bc.l.Infow("Getting transaction opts failed", "err", err)
+		return nil, err
+	}
+	opts.GasLimit = plan.EstimatedGas + extraGasLimit
+	call := plan.Call
+	var tx *types.Transaction
+	if call.Method == methodSetBaseRate {
+		tx, err = bc.GeneratedSetBaseRate(opts, call.BaseTokens, call.BaseBuys, call.BaseSells,
+			call.Buys, call.Sells, block, call.Indices)
+	} else {
+		tx, err = bc.GeneratedSetCompactData(opts, call.Buys, call.Sells, block, call.Indices)
+	}
+	if err != nil {
+		return nil, err
+	}
+	tx, err = bc.SignAndBroadcast(tx, op)
+	if err != nil {
+		return nil, err
+	}
+	bc.l.Infow("broadcast set rate call", "plan", plan.Name, "method", call.Method, "tx", tx.Hash().Hex(),
+		"base tokens", call.BaseTokens, "base buys", call.BaseBuys, "base sells", call.BaseSells,
+		"buy bulks", call.Buys, "sell bulks", call.Sells, "indices", call.Indices, "estimated gas", plan.EstimatedGas)
+	return tx, nil
+}
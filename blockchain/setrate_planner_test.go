@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSetRatePlans(t *testing.T) {
+	var (
+		tokenA = ethereum.HexToAddress("0x14535eE720e329f66071B86486763Da4637034aE")
+		tokenB = ethereum.HexToAddress("0x24535eE720e329F66071b86486763da4637034AE")
+		tokenC = ethereum.HexToAddress("0x34535ee720e329f66071B86486763Da4637034aE")
+		tokenD = ethereum.HexToAddress("0x44535ee720e329f66071B86486763Da4637034aE")
+		tokens = []ethereum.Address{tokenA, tokenB, tokenC, tokenD}
+	)
+	indices := map[string]tbindex{
+		tokenA.Hex(): newTBIndex(0, 0),
+		tokenB.Hex(): newTBIndex(0, 1),
+		tokenC.Hex(): newTBIndex(1, 0),
+		tokenD.Hex(): newTBIndex(2, 0),
+	}
+	onchain := make([]onchainRate, len(tokens))
+	for i := range onchain {
+		onchain[i] = onchainRate{baseBuy: big.NewInt(1000), baseSell: big.NewInt(1000), block: 100}
+	}
+	unchanged := func() []*big.Int {
+		return []*big.Int{big.NewInt(1000), big.NewInt(1000), big.NewInt(1000), big.NewInt(1000)}
+	}
+	bulks := func(call SetRateCall) []int64 {
+		var result []int64
+		for _, index := range call.Indices {
+			result = append(result, index.Int64())
+		}
+		return result
+	}
+
+	t.Run("changed bulks only", func(t *testing.T) {
+		// A gets a new compact rate, D overflows and gets a new base rate, B and C are unchanged
+		buys := unchanged()
+		buys[0] = big.NewInt(1010)
+		buys[3] = big.NewInt(2000)
+		plans := buildSetRatePlans(tokens, buys, unchanged(), onchain, indices, 120, 50)
+		require.Len(t, plans, 2)
+
+		require.Equal(t, SetRatePlanFull, plans[0].Name)
+		require.Equal(t, methodSetBaseRate, plans[0].Call.Method)
+		require.ElementsMatch(t, []int64{0, 1, 2}, bulks(plans[0].Call))
+		require.Empty(t, plans[0].Skipped)
+
+		require.Equal(t, SetRatePlanChanged, plans[1].Name)
+		require.Equal(t, methodSetBaseRate, plans[1].Call.Method)
+		require.Equal(t, []ethereum.Address{tokenD}, plans[1].Call.BaseTokens)
+		require.ElementsMatch(t, []int64{0, 2}, bulks(plans[1].Call))
+		require.Equal(t, []ethereum.Address{tokenC}, plans[1].Skipped)
+	})
+
+	t.Run("expiring bulks are refreshed", func(t *testing.T) {
+		buys := unchanged()
+		buys[0] = big.NewInt(1010)
+		plans := buildSetRatePlans(tokens, buys, unchanged(), onchain, indices, 150, 50)
+		require.Len(t, plans, 1)
+		require.Equal(t, SetRatePlanFull, plans[0].Name)
+		require.Equal(t, methodSetCompactData, plans[0].Call.Method)
+	})
+
+	t.Run("nothing changed", func(t *testing.T) {
+		plans := buildSetRatePlans(tokens, unchanged(), unchanged(), onchain, indices, 120, 50)
+		require.Len(t, plans, 1)
+		require.Equal(t, SetRatePlanFull, plans[0].Name)
+	})
+}
+
+func TestRateMatches(t *testing.T) {
+	require.True(t, rateMatches(big.NewInt(100000), big.NewInt(100000)))
+	require.True(t, rateMatches(big.NewInt(100100), big.NewInt(100000)))
+	require.False(t, rateMatches(big.NewInt(100200), big.NewInt(100000)))
+	require.False(t, rateMatches(big.NewInt(1), big.NewInt(0)))
+	require.True(t, rateMatches(big.NewInt(0), big.NewInt(0)))
+}
+
+func TestCompactRate(t *testing.T) {
+	require.Equal(t, big.NewInt(1000), compactRate(big.NewInt(1000), 0))
+	require.Equal(t, big.NewInt(1010), compactRate(big.NewInt(1000), 10))
+	require.Equal(t, big.NewInt(872), compactRate(big.NewInt(1000), -128))
+}
@@ -0,0 +1,592 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20.sol";
+import "./PermissionGroups.sol";
+import "./Utils.sol";
+
+contract VolumeImbalanceRecorder is Withdrawable {
+    uint256 internal constant SLIDING_WINDOW_SIZE = 5;
+    uint256 internal constant POW_2_64 = 2**64;
+
+    struct TokenControlInfo {
+        uint256 minimalRecordResolution; // can be roughly 1 cent
+        uint256 maxPerBlockImbalance; // in twei resolution
+        uint256 maxTotalImbalance; // max total imbalance (between rate updates)
+    }
+
+    mapping(address => TokenControlInfo) internal tokenControlInfo;
+
+    struct TokenImbalanceData {
+        int256 lastBlockBuyUnitsImbalance;
+        uint256 lastBlock;
+        int256 totalBuyUnitsImbalance;
+        uint256 lastRateUpdateBlock;
+    }
+
+    mapping(address => mapping(uint256 => uint256)) public tokenImbalanceData;
+
+    constructor(address _admin) Withdrawable(_admin) {}
+
+    function setTokenControlInfo(
+        ERC20 token,
+        uint256 minimalRecordResolution,
+        uint256 maxPerBlockImbalance,
+        uint256 maxTotalImbalance
+    ) public onlyAdmin {
+        tokenControlInfo[address(token)] = TokenControlInfo(
+            minimalRecordResolution,
+            maxPerBlockImbalance,
+            maxTotalImbalance
+        );
+    }
+
+    function getTokenControlInfo(ERC20 token)
+        public
+        view
+        returns (
+            uint256,
+            uint256,
+            uint256
+        )
+    {
+        TokenControlInfo memory info = tokenControlInfo[address(token)];
+        return (info.minimalRecordResolution, info.maxPerBlockImbalance, info.maxTotalImbalance);
+    }
+
+    function addImbalance(
+        ERC20 token,
+        int256 buyAmount,
+        uint256 rateUpdateBlock,
+        uint256 currentBlock
+    ) internal {
+        uint256 currentBlockIndex = currentBlock % SLIDING_WINDOW_SIZE;
+        int256 recordedBuyAmount = buyAmount / int256(tokenControlInfo[address(token)].minimalRecordResolution);
+
+        int256 prevImbalance = 0;
+
+        TokenImbalanceData memory currentBlockData = decodeTokenImbalanceData(
+            tokenImbalanceData[address(token)][currentBlockIndex]
+        );
+
+        // first scenario - this is not the first tx in the current block
+        if (currentBlockData.lastBlock == currentBlock) {
+            if (currentBlockData.lastRateUpdateBlock == rateUpdateBlock) {
+                // just increase imbalance
+                currentBlockData.lastBlockBuyUnitsImbalance += recordedBuyAmount;
+                currentBlockData.totalBuyUnitsImbalance += recordedBuyAmount;
+            } else {
+                // imbalance was changed in the middle of the block
+                prevImbalance = getImbalanceInRange(token, rateUpdateBlock, currentBlock);
+                currentBlockData.totalBuyUnitsImbalance = prevImbalance + recordedBuyAmount;
+                currentBlockData.lastBlockBuyUnitsImbalance += recordedBuyAmount;
+                currentBlockData.lastRateUpdateBlock = rateUpdateBlock;
+            }
+        } else {
+            // first tx in the current block
+            int256 currentBlockImbalance;
+            (prevImbalance, currentBlockImbalance) = getImbalanceSinceRateUpdate(
+                token,
+                rateUpdateBlock,
+                currentBlock
+            );
+
+            currentBlockData.lastBlockBuyUnitsImbalance = recordedBuyAmount;
+            currentBlockData.lastBlock = currentBlock;
+            currentBlockData.lastRateUpdateBlock = rateUpdateBlock;
+            currentBlockData.totalBuyUnitsImbalance = prevImbalance + recordedBuyAmount;
+        }
+
+        tokenImbalanceData[address(token)][currentBlockIndex] = encodeTokenImbalanceData(currentBlockData);
+    }
+
+    function getImbalanceInRange(
+        ERC20 token,
+        uint256 startBlock,
+        uint256 endBlock
+    ) internal view returns (int256 buyImbalance) {
+        // check the imbalance in the sliding window
+        require(startBlock <= endBlock);
+
+        buyImbalance = 0;
+
+        for (uint256 windowInd = 0; windowInd < SLIDING_WINDOW_SIZE; windowInd++) {
+            TokenImbalanceData memory perBlockData = decodeTokenImbalanceData(
+                tokenImbalanceData[address(token)][windowInd]
+            );
+
+            if (perBlockData.lastBlock <= endBlock && perBlockData.lastBlock >= startBlock) {
+                buyImbalance += int256(perBlockData.lastBlockBuyUnitsImbalance);
+            }
+        }
+    }
+
+    function getImbalanceSinceRateUpdate(
+        ERC20 token,
+        uint256 rateUpdateBlock,
+        uint256 currentBlock
+    ) internal view returns (int256 buyImbalance, int256 currentBlockImbalance) {
+        buyImbalance = 0;
+        currentBlockImbalance = 0;
+        uint256 latestBlock = 0;
+        int256 imbalanceInRange = 0;
+        uint256 startBlock = rateUpdateBlock;
+        uint256 endBlock = currentBlock;
+
+        for (uint256 windowInd = 0; windowInd < SLIDING_WINDOW_SIZE; windowInd++) {
+            TokenImbalanceData memory perBlockData = decodeTokenImbalanceData(
+                tokenImbalanceData[address(token)][windowInd]
+            );
+
+            if (perBlockData.lastBlock <= endBlock && perBlockData.lastBlock >= startBlock) {
+                imbalanceInRange += perBlockData.lastBlockBuyUnitsImbalance;
+            }
+
+            if (perBlockData.lastRateUpdateBlock != rateUpdateBlock) continue;
+            if (perBlockData.lastBlock < latestBlock) continue;
+
+            latestBlock = perBlockData.lastBlock;
+            buyImbalance = perBlockData.totalBuyUnitsImbalance;
+            if (perBlockData.lastBlock == currentBlock) {
+                currentBlockImbalance = perBlockData.lastBlockBuyUnitsImbalance;
+            }
+        }
+
+        if (buyImbalance == 0) {
+            buyImbalance = imbalanceInRange;
+        }
+    }
+
+    function getImbalance(
+        ERC20 token,
+        uint256 rateUpdateBlock,
+        uint256 currentBlock
+    ) internal view returns (int256 totalImbalance, int256 currentBlockImbalance) {
+        int256 resolution = int256(tokenControlInfo[address(token)].minimalRecordResolution);
+
+        (totalImbalance, currentBlockImbalance) = getImbalanceSinceRateUpdate(
+            token,
+            rateUpdateBlock,
+            currentBlock
+        );
+
+        totalImbalance *= resolution;
+        currentBlockImbalance *= resolution;
+    }
+
+    function getMaxPerBlockImbalance(ERC20 token) internal view returns (uint256) {
+        return tokenControlInfo[address(token)].maxPerBlockImbalance;
+    }
+
+    function getMaxTotalImbalance(ERC20 token) internal view returns (uint256) {
+        return tokenControlInfo[address(token)].maxTotalImbalance;
+    }
+
+    function encodeTokenImbalanceData(TokenImbalanceData memory data) internal pure returns (uint256) {
+        // check for overflows
+        require(data.lastBlockBuyUnitsImbalance < int256(POW_2_64 / 2));
+        require(data.lastBlockBuyUnitsImbalance > int256(-1 * int256(POW_2_64) / 2));
+        require(data.lastBlock < POW_2_64);
+        require(data.totalBuyUnitsImbalance < int256(POW_2_64 / 2));
+        require(data.totalBuyUnitsImbalance > int256(-1 * int256(POW_2_64) / 2));
+        require(data.lastRateUpdateBlock < POW_2_64);
+
+        // do encoding
+        uint256 result = uint256(uint64(int64(data.lastBlockBuyUnitsImbalance)));
+        result |= data.lastBlock * POW_2_64;
+        result |= uint256(uint64(int64(data.totalBuyUnitsImbalance))) * POW_2_64 * POW_2_64;
+        result |= data.lastRateUpdateBlock * POW_2_64 * POW_2_64 * POW_2_64;
+
+        return result;
+    }
+
+    function decodeTokenImbalanceData(uint256 input) internal pure returns (TokenImbalanceData memory) {
+        TokenImbalanceData memory data;
+
+        data.lastBlockBuyUnitsImbalance = int256(int64(uint64(input & (POW_2_64 - 1))));
+        data.lastBlock = uint256(uint64((input / POW_2_64) & (POW_2_64 - 1)));
+        data.totalBuyUnitsImbalance = int256(int64(uint64((input / (POW_2_64 * POW_2_64)) & (POW_2_64 - 1))));
+        data.lastRateUpdateBlock = uint256(uint64((input / (POW_2_64 * POW_2_64 * POW_2_64))));
+
+        return data;
+    }
+}
+
+contract ConversionRates is VolumeImbalanceRecorder, Utils {
+    // bps - basic rate steps. one step is 1 / 10000 of the rate.
+    struct StepFunction {
+        int256[] x; // quantity for each step. Quantity of each step includes previous steps.
+        int256[] y; // rate change per quantity step  in bps.
+    }
+
+    struct TokenData {
+        bool listed; // was added to reserve
+        bool enabled; // whether trade is enabled
+        // position in the compact data
+        uint256 compactDataArrayIndex;
+        uint256 compactDataFieldIndex;
+        // rate data. base and changes according to quantity and reserve balance.
+        // generally speaking. Sell rate is 1 / buy rate i.e. the buy in the other direction.
+        uint256 baseBuyRate; // in PRECISION units. see KyberConstants
+        uint256 baseSellRate; // PRECISION units. without (sell / buy) spread it is 1 / baseBuyRate
+        StepFunction buyRateQtyStepFunction; // in bps. higher quantity - bigger the rate.
+        StepFunction sellRateQtyStepFunction; // in bps. higher the qua
+        StepFunction buyRateImbalanceStepFunction; // in BPS. higher reserve imbalance - bigger the rate.
+        StepFunction sellRateImbalanceStepFunction;
+    }
+
+    /*
+    this is the data for tokenRatesCompactData
+    but solidity compiler optimizer is sub-optimal, and cannot write this structure in a single storage write
+    so we represent it as bytes32 and do the byte tricks ourselves.
+    struct TokenRatesCompactData {
+        bytes14 buy;  // change buy rate of token from baseBuyRate in 10 bps
+        bytes14 sell; // change sell rate of token from baseSellRate in 10 bps
+
+        uint32 blockNumber;
+    } */
+    uint256 public validRateDurationInBlocks = 10; // rates are valid for this amount of blocks
+    ERC20[] internal listedTokens;
+    mapping(address => TokenData) internal tokenData;
+    bytes32[] internal tokenRatesCompactData;
+    uint256 public numTokensInCurrentCompactData = 0;
+    address public reserveContract;
+    uint256 internal constant NUM_TOKENS_IN_COMPACT_DATA = 14;
+    uint256 internal constant BYTES_14_OFFSET = (2**(8 * NUM_TOKENS_IN_COMPACT_DATA));
+    uint256 internal constant MAX_STEPS_IN_FUNCTION = 10;
+    int256 internal constant MAX_BPS_ADJUSTMENT = 10**11; // 1B %
+    int256 internal constant MIN_BPS_ADJUSTMENT = -100 * 100; // cannot go down by more than 100%
+
+    constructor(address _admin) VolumeImbalanceRecorder(_admin) {}
+
+    function addToken(ERC20 token) public onlyAdmin {
+        require(!tokenData[address(token)].listed);
+        tokenData[address(token)].listed = true;
+        listedTokens.push(token);
+
+        if (numTokensInCurrentCompactData == 0) {
+            tokenRatesCompactData.push(bytes32(0)); // add new structure
+        }
+
+        tokenData[address(token)].compactDataArrayIndex = tokenRatesCompactData.length - 1;
+        tokenData[address(token)].compactDataFieldIndex = numTokensInCurrentCompactData;
+
+        numTokensInCurrentCompactData = (numTokensInCurrentCompactData + 1) % NUM_TOKENS_IN_COMPACT_DATA;
+
+        setDecimals(address(token));
+    }
+
+    function setCompactData(
+        bytes14[] memory buy,
+        bytes14[] memory sell,
+        uint256 blockNumber,
+        uint256[] memory indices
+    ) public onlyOperator {
+        require(buy.length == sell.length);
+        require(indices.length == buy.length);
+        require(blockNumber <= 0xFFFFFFFF);
+
+        uint256 bytes14Offset = BYTES_14_OFFSET;
+
+        for (uint256 i = 0; i < indices.length; i++) {
+            require(indices[i] < tokenRatesCompactData.length);
+            uint256 data = uint256(uint112(buy[i])) |
+                (uint256(uint112(sell[i])) * bytes14Offset) |
+                (blockNumber * (bytes14Offset * bytes14Offset));
+            tokenRatesCompactData[indices[i]] = bytes32(data);
+        }
+    }
+
+    function setBaseRate(
+        ERC20[] memory tokens,
+        uint256[] memory baseBuy,
+        uint256[] memory baseSell,
+        bytes14[] memory buy,
+        bytes14[] memory sell,
+        uint256 blockNumber,
+        uint256[] memory indices
+    ) public onlyOperator {
+        require(tokens.length == baseBuy.length);
+        require(tokens.length == baseSell.length);
+        require(sell.length == buy.length);
+        require(sell.length == indices.length);
+
+        for (uint256 ind = 0; ind < tokens.length; ind++) {
+            require(tokenData[address(tokens[ind])].listed);
+            tokenData[address(tokens[ind])].baseBuyRate = baseBuy[ind];
+            tokenData[address(tokens[ind])].baseSellRate = baseSell[ind];
+        }
+
+        setCompactData(buy, sell, blockNumber, indices);
+    }
+
+    function setQtyStepFunction(
+        ERC20 token,
+        int256[] memory xBuy,
+        int256[] memory yBuy,
+        int256[] memory xSell,
+        int256[] memory ySell
+    ) public onlyOperator {
+        require(xBuy.length == yBuy.length);
+        require(xSell.length == ySell.length);
+        require(xBuy.length <= MAX_STEPS_IN_FUNCTION);
+        require(xSell.length <= MAX_STEPS_IN_FUNCTION);
+        require(tokenData[address(token)].listed);
+
+        tokenData[address(token)].buyRateQtyStepFunction = StepFunction(xBuy, yBuy);
+        tokenData[address(token)].sellRateQtyStepFunction = StepFunction(xSell, ySell);
+    }
+
+    function setImbalanceStepFunction(
+        ERC20 token,
+        int256[] memory xBuy,
+        int256[] memory yBuy,
+        int256[] memory xSell,
+        int256[] memory ySell
+    ) public onlyOperator {
+        require(xBuy.length == yBuy.length);
+        require(xSell.length == ySell.length);
+        require(xBuy.length <= MAX_STEPS_IN_FUNCTION);
+        require(xSell.length <= MAX_STEPS_IN_FUNCTION);
+        require(tokenData[address(token)].listed);
+
+        tokenData[address(token)].buyRateImbalanceStepFunction = StepFunction(xBuy, yBuy);
+        tokenData[address(token)].sellRateImbalanceStepFunction = StepFunction(xSell, ySell);
+    }
+
+    function setValidRateDurationInBlocks(uint256 duration) public onlyAdmin {
+        validRateDurationInBlocks = duration;
+    }
+
+    function enableTokenTrade(ERC20 token) public onlyAdmin {
+        require(tokenData[address(token)].listed);
+        require(tokenControlInfo[address(token)].minimalRecordResolution != 0);
+        tokenData[address(token)].enabled = true;
+    }
+
+    function disableTokenTrade(ERC20 token) public onlyAlerter {
+        require(tokenData[address(token)].listed);
+        tokenData[address(token)].enabled = false;
+    }
+
+    function setReserveAddress(address reserve) public onlyAdmin {
+        reserveContract = reserve;
+    }
+
+    function recordImbalance(
+        ERC20 token,
+        int256 buyAmount,
+        uint256 rateUpdateBlock,
+        uint256 currentBlock
+    ) public {
+        require(msg.sender == reserveContract);
+
+        if (rateUpdateBlock == 0) rateUpdateBlock = getRateUpdateBlock(token);
+
+        return addImbalance(token, buyAmount, rateUpdateBlock, currentBlock);
+    }
+
+    /* solhint-disable function-max-lines */
+    function getRate(
+        ERC20 token,
+        uint256 currentBlockNumber,
+        bool buy,
+        uint256 qty
+    ) public view returns (uint256) {
+        // check if trade is enabled
+        if (!tokenData[address(token)].enabled) return 0;
+        if (tokenControlInfo[address(token)].minimalRecordResolution == 0) return 0; // token control info not set
+
+        // get rate update block
+        bytes32 compactData = tokenRatesCompactData[tokenData[address(token)].compactDataArrayIndex];
+
+        uint256 updateRateBlock = getLast4Bytes(compactData);
+        if (currentBlockNumber >= updateRateBlock + validRateDurationInBlocks) return 0; // rate is expired
+        // check imbalance
+        int256 totalImbalance;
+        int256 blockImbalance;
+        (totalImbalance, blockImbalance) = getImbalance(token, updateRateBlock, currentBlockNumber);
+
+        // calculate actual rate
+        int256 imbalanceQty;
+        int256 extraBps;
+        int8 rateUpdate;
+        uint256 rate;
+
+        if (buy) {
+            // start with base rate
+            rate = tokenData[address(token)].baseBuyRate;
+
+            // add rate update
+            rateUpdate = getRateByteFromCompactData(compactData, token, true);
+            extraBps = int256(rateUpdate) * 10;
+            rate = addBps(rate, extraBps);
+
+            // compute token qty
+            qty = getTokenQty(token, qty, rate);
+            imbalanceQty = int256(qty);
+            totalImbalance += imbalanceQty;
+
+            // add qty overhead
+            extraBps = executeStepFunction(tokenData[address(token)].buyRateQtyStepFunction, int256(qty));
+            rate = addBps(rate, extraBps);
+
+            // add imbalance overhead
+            extraBps = executeStepFunction(tokenData[address(token)].buyRateImbalanceStepFunction, totalImbalance);
+            rate = addBps(rate, extraBps);
+        } else {
+            // start with base rate
+            rate = tokenData[address(token)].baseSellRate;
+
+            // add rate update
+            rateUpdate = getRateByteFromCompactData(compactData, token, false);
+            extraBps = int256(rateUpdate) * 10;
+            rate = addBps(rate, extraBps);
+
+            // compute token qty
+            imbalanceQty = -1 * int256(qty);
+            totalImbalance += imbalanceQty;
+
+            // add qty overhead
+            extraBps = executeStepFunction(tokenData[address(token)].sellRateQtyStepFunction, int256(qty));
+            rate = addBps(rate, extraBps);
+
+            // add imbalance overhead
+            extraBps = executeStepFunction(tokenData[address(token)].sellRateImbalanceStepFunction, totalImbalance);
+            rate = addBps(rate, extraBps);
+        }
+
+        if (abs(totalImbalance) >= getMaxTotalImbalance(token)) return 0;
+        if (abs(blockImbalance + imbalanceQty) >= getMaxPerBlockImbalance(token)) return 0;
+
+        return rate;
+    }
+
+    /* solhint-enable function-max-lines */
+
+    function getBasicRate(ERC20 token, bool buy) public view returns (uint256) {
+        if (buy) return tokenData[address(token)].baseBuyRate;
+        else return tokenData[address(token)].baseSellRate;
+    }
+
+    function getCompactData(ERC20 token)
+        public
+        view
+        returns (
+            uint256,
+            uint256,
+            bytes1,
+            bytes1
+        )
+    {
+        require(tokenData[address(token)].listed);
+
+        uint256 arrayIndex = tokenData[address(token)].compactDataArrayIndex;
+        uint256 fieldOffset = tokenData[address(token)].compactDataFieldIndex;
+
+        return (
+            arrayIndex,
+            fieldOffset,
+            bytes1(uint8(getRateByteFromCompactData(tokenRatesCompactData[arrayIndex], token, true))),
+            bytes1(uint8(getRateByteFromCompactData(tokenRatesCompactData[arrayIndex], token, false)))
+        );
+    }
+
+    function getTokenBasicData(ERC20 token) public view returns (bool, bool) {
+        return (tokenData[address(token)].listed, tokenData[address(token)].enabled);
+    }
+
+    /* solhint-disable code-complexity */
+    function getStepFunctionData(
+        ERC20 token,
+        uint256 command,
+        uint256 param
+    ) public view returns (int256) {
+        TokenData storage data = tokenData[address(token)];
+        if (command == 0) return int256(data.buyRateQtyStepFunction.x.length);
+        if (command == 1) return data.buyRateQtyStepFunction.x[param];
+        if (command == 2) return int256(data.buyRateQtyStepFunction.y.length);
+        if (command == 3) return data.buyRateQtyStepFunction.y[param];
+
+        if (command == 4) return int256(data.sellRateQtyStepFunction.x.length);
+        if (command == 5) return data.sellRateQtyStepFunction.x[param];
+        if (command == 6) return int256(data.sellRateQtyStepFunction.y.length);
+        if (command == 7) return data.sellRateQtyStepFunction.y[param];
+
+        if (command == 8) return int256(data.buyRateImbalanceStepFunction.x.length);
+        if (command == 9) return data.buyRateImbalanceStepFunction.x[param];
+        if (command == 10) return int256(data.buyRateImbalanceStepFunction.y.length);
+        if (command == 11) return data.buyRateImbalanceStepFunction.y[param];
+
+        if (command == 12) return int256(data.sellRateImbalanceStepFunction.x.length);
+        if (command == 13) return data.sellRateImbalanceStepFunction.x[param];
+        if (command == 14) return int256(data.sellRateImbalanceStepFunction.y.length);
+        if (command == 15) return data.sellRateImbalanceStepFunction.y[param];
+
+        revert();
+    }
+
+    /* solhint-enable code-complexity */
+
+    function getRateUpdateBlock(ERC20 token) public view returns (uint256) {
+        bytes32 compactData = tokenRatesCompactData[tokenData[address(token)].compactDataArrayIndex];
+        return getLast4Bytes(compactData);
+    }
+
+    function getListedTokens() public view returns (ERC20[] memory) {
+        return listedTokens;
+    }
+
+    function getTokenQty(
+        ERC20 token,
+        uint256 ethQty,
+        uint256 rate
+    ) internal view returns (uint256) {
+        uint256 dstDecimals = getDecimals(address(token));
+        uint256 srcDecimals = ETH_DECIMALS;
+
+        return calcDstQty(ethQty, srcDecimals, dstDecimals, rate);
+    }
+
+    function getLast4Bytes(bytes32 b) internal pure returns (uint256) {
+        // cannot trust compiler with not turning bit operations into EXP opcode
+        return uint256(b) / (BYTES_14_OFFSET * BYTES_14_OFFSET);
+    }
+
+    function getRateByteFromCompactData(
+        bytes32 data,
+        ERC20 token,
+        bool buy
+    ) internal view returns (int8) {
+        uint256 fieldOffset = tokenData[address(token)].compactDataFieldIndex;
+        uint256 byteOffset;
+        if (buy) byteOffset = 32 - NUM_TOKENS_IN_COMPACT_DATA + fieldOffset;
+        else byteOffset = 4 + fieldOffset;
+
+        return int8(uint8(data[byteOffset]));
+    }
+
+    function executeStepFunction(StepFunction storage f, int256 x) internal view returns (int256) {
+        uint256 len = f.y.length;
+        if (len == 0) return 0;
+        for (uint256 ind = 0; ind < len; ind++) {
+            if (x <= f.x[ind]) return f.y[ind];
+        }
+
+        return f.y[len - 1];
+    }
+
+    function addBps(uint256 rate, int256 bps) internal pure returns (uint256) {
+        require(rate <= MAX_RATE);
+        require(bps >= MIN_BPS_ADJUSTMENT);
+        require(bps <= MAX_BPS_ADJUSTMENT);
+
+        uint256 maxBps = 100 * 100;
+        return (rate * uint256(int256(maxBps) + bps)) / maxBps;
+    }
+
+    function abs(int256 x) internal pure returns (uint256) {
+        if (x < 0) return uint256(-1 * x);
+        else return uint256(x);
+    }
+}
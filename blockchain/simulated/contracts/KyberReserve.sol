@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20.sol";
+import "./PermissionGroups.sol";
+import "./Utils.sol";
+import "./ConversionRates.sol";
+
+interface SanityRatesInterface {
+    function getSanityRate(address src, address dest) external view returns (uint256);
+}
+
+contract KyberReserve is Withdrawable, Utils {
+    address public kyberNetwork;
+    bool public tradeEnabled;
+    ConversionRates public conversionRatesContract;
+    SanityRatesInterface public sanityRatesContract;
+    mapping(bytes32 => bool) public approvedWithdrawAddresses; // sha3(token,address)=>bool
+
+    event DepositToken(address token, uint256 amount);
+    event TradeExecute(
+        address indexed origin,
+        address src,
+        uint256 srcAmount,
+        address destToken,
+        uint256 destAmount,
+        address destAddress
+    );
+    event TradeEnabled(bool enable);
+    event WithdrawAddressApproved(address token, address addr, bool approve);
+    event WithdrawFunds(address token, uint256 amount, address destination);
+    event SetContractAddresses(address network, address rate, address sanity);
+
+    constructor(
+        address _kyberNetwork,
+        ConversionRates _ratesContract,
+        address _admin
+    ) Withdrawable(_admin) {
+        require(address(_ratesContract) != address(0));
+        require(_kyberNetwork != address(0));
+        kyberNetwork = _kyberNetwork;
+        conversionRatesContract = _ratesContract;
+        tradeEnabled = true;
+    }
+
+    receive() external payable {
+        emit DepositToken(ETH_TOKEN_ADDRESS, msg.value);
+    }
+
+    function trade(
+        address srcToken,
+        uint256 srcAmount,
+        address destToken,
+        address payable destAddress,
+        uint256 conversionRate,
+        bool validate
+    ) public payable returns (bool) {
+        require(tradeEnabled);
+        require(msg.sender == kyberNetwork);
+
+        require(doTrade(srcToken, srcAmount, destToken, destAddress, conversionRate, validate));
+
+        return true;
+    }
+
+    function enableTrade() public onlyAdmin returns (bool) {
+        tradeEnabled = true;
+        emit TradeEnabled(true);
+
+        return true;
+    }
+
+    function disableTrade() public onlyAlerter returns (bool) {
+        tradeEnabled = false;
+        emit TradeEnabled(false);
+
+        return true;
+    }
+
+    function approveWithdrawAddress(
+        address token,
+        address addr,
+        bool approve
+    ) public onlyAdmin {
+        approvedWithdrawAddresses[keccak256(abi.encodePacked(token, addr))] = approve;
+        emit WithdrawAddressApproved(token, addr, approve);
+
+        setDecimals(token);
+    }
+
+    function withdraw(
+        address token,
+        uint256 amount,
+        address payable destination
+    ) public onlyOperator returns (bool) {
+        require(approvedWithdrawAddresses[keccak256(abi.encodePacked(token, destination))]);
+
+        if (token == ETH_TOKEN_ADDRESS) {
+            destination.transfer(amount);
+        } else {
+            require(ERC20(token).transfer(destination, amount));
+        }
+
+        emit WithdrawFunds(token, amount, destination);
+
+        return true;
+    }
+
+    function setContracts(
+        address _kyberNetwork,
+        ConversionRates _conversionRates,
+        SanityRatesInterface _sanityRates
+    ) public onlyAdmin {
+        require(_kyberNetwork != address(0));
+        require(address(_conversionRates) != address(0));
+
+        kyberNetwork = _kyberNetwork;
+        conversionRatesContract = _conversionRates;
+        sanityRatesContract = _sanityRates;
+
+        emit SetContractAddresses(kyberNetwork, address(conversionRatesContract), address(sanityRatesContract));
+    }
+
+    function getBalance(address token) public view returns (uint256) {
+        if (token == ETH_TOKEN_ADDRESS) return address(this).balance;
+        else return ERC20(token).balanceOf(address(this));
+    }
+
+    function getDestQty(
+        address src,
+        address dest,
+        uint256 srcQty,
+        uint256 rate
+    ) public view returns (uint256) {
+        uint256 dstDecimals = getDecimals(dest);
+        uint256 srcDecimals = getDecimals(src);
+
+        return calcDstQty(srcQty, srcDecimals, dstDecimals, rate);
+    }
+
+    function getSrcQty(
+        address src,
+        address dest,
+        uint256 dstQty,
+        uint256 rate
+    ) public view returns (uint256) {
+        uint256 dstDecimals = getDecimals(dest);
+        uint256 srcDecimals = getDecimals(src);
+
+        return calcSrcQty(dstQty, srcDecimals, dstDecimals, rate);
+    }
+
+    function getConversionRate(
+        address src,
+        address dest,
+        uint256 srcQty,
+        uint256 blockNumber
+    ) public view returns (uint256) {
+        address token;
+        bool isBuy;
+
+        if (!tradeEnabled) return 0;
+
+        if (ETH_TOKEN_ADDRESS == src) {
+            isBuy = true;
+            token = dest;
+        } else if (ETH_TOKEN_ADDRESS == dest) {
+            isBuy = false;
+            token = src;
+        } else {
+            return 0; // pair is not listed
+        }
+
+        uint256 rate = conversionRatesContract.getRate(ERC20(token), blockNumber, isBuy, srcQty);
+        uint256 destQty = getDestQty(src, dest, srcQty, rate);
+
+        if (getBalance(dest) < destQty) return 0;
+
+        if (address(sanityRatesContract) != address(0)) {
+            uint256 sanityRate = sanityRatesContract.getSanityRate(src, dest);
+            if (rate > sanityRate) return 0;
+        }
+
+        return rate;
+    }
+
+    function doTrade(
+        address srcToken,
+        uint256 srcAmount,
+        address destToken,
+        address payable destAddress,
+        uint256 conversionRate,
+        bool validate
+    ) internal returns (bool) {
+        // can skip validation if done at kyber network level
+        if (validate) {
+            require(conversionRate > 0);
+            if (srcToken == ETH_TOKEN_ADDRESS) require(msg.value == srcAmount);
+            else require(msg.value == 0);
+        }
+
+        uint256 destAmount = getDestQty(srcToken, destToken, srcAmount, conversionRate);
+        // sanity check
+        require(destAmount > 0);
+
+        // add to imbalance
+        address token;
+        int256 tradeAmount;
+        if (srcToken == ETH_TOKEN_ADDRESS) {
+            tradeAmount = int256(destAmount);
+            token = destToken;
+        } else {
+            tradeAmount = -1 * int256(srcAmount);
+            token = srcToken;
+        }
+
+        conversionRatesContract.recordImbalance(ERC20(token), tradeAmount, 0, block.number);
+
+        // collect src tokens
+        if (srcToken != ETH_TOKEN_ADDRESS) {
+            require(ERC20(srcToken).transferFrom(msg.sender, address(this), srcAmount));
+        }
+
+        // send dest tokens
+        if (destToken == ETH_TOKEN_ADDRESS) {
+            destAddress.transfer(destAmount);
+        } else {
+            require(ERC20(destToken).transfer(destAddress, destAmount));
+        }
+
+        emit TradeExecute(msg.sender, srcToken, srcAmount, destToken, destAmount, destAddress);
+
+        return true;
+    }
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20.sol";
+
+contract PermissionGroups {
+    address public admin;
+    address public pendingAdmin;
+    mapping(address => bool) internal operators;
+    mapping(address => bool) internal alerters;
+    address[] internal operatorsGroup;
+    address[] internal alertersGroup;
+    uint256 internal constant MAX_GROUP_SIZE = 50;
+
+    event TransferAdminPending(address pendingAdmin);
+    event AdminClaimed(address newAdmin, address previousAdmin);
+    event AlerterAdded(address newAlerter, bool isAdd);
+    event OperatorAdded(address newOperator, bool isAdd);
+
+    constructor(address _admin) {
+        require(_admin != address(0));
+        admin = _admin;
+    }
+
+    modifier onlyAdmin() {
+        require(msg.sender == admin);
+        _;
+    }
+
+    modifier onlyOperator() {
+        require(operators[msg.sender]);
+        _;
+    }
+
+    modifier onlyAlerter() {
+        require(alerters[msg.sender]);
+        _;
+    }
+
+    function getOperators() external view returns (address[] memory) {
+        return operatorsGroup;
+    }
+
+    function getAlerters() external view returns (address[] memory) {
+        return alertersGroup;
+    }
+
+    function transferAdmin(address newAdmin) public onlyAdmin {
+        require(newAdmin != address(0));
+        emit TransferAdminPending(pendingAdmin);
+        pendingAdmin = newAdmin;
+    }
+
+    function claimAdmin() public {
+        require(pendingAdmin == msg.sender);
+        emit AdminClaimed(pendingAdmin, admin);
+        admin = pendingAdmin;
+        pendingAdmin = address(0);
+    }
+
+    function addAlerter(address newAlerter) public onlyAdmin {
+        require(!alerters[newAlerter]);
+        require(alertersGroup.length < MAX_GROUP_SIZE);
+        emit AlerterAdded(newAlerter, true);
+        alerters[newAlerter] = true;
+        alertersGroup.push(newAlerter);
+    }
+
+    function removeAlerter(address alerter) public onlyAdmin {
+        require(alerters[alerter]);
+        alerters[alerter] = false;
+        for (uint256 i = 0; i < alertersGroup.length; ++i) {
+            if (alertersGroup[i] == alerter) {
+                alertersGroup[i] = alertersGroup[alertersGroup.length - 1];
+                alertersGroup.pop();
+                emit AlerterAdded(alerter, false);
+                break;
+            }
+        }
+    }
+
+    function addOperator(address newOperator) public onlyAdmin {
+        require(!operators[newOperator]);
+        require(operatorsGroup.length < MAX_GROUP_SIZE);
+        emit OperatorAdded(newOperator, true);
+        operators[newOperator] = true;
+        operatorsGroup.push(newOperator);
+    }
+
+    function removeOperator(address operator) public onlyAdmin {
+        require(operators[operator]);
+        operators[operator] = false;
+        for (uint256 i = 0; i < operatorsGroup.length; ++i) {
+            if (operatorsGroup[i] == operator) {
+                operatorsGroup[i] = operatorsGroup[operatorsGroup.length - 1];
+                operatorsGroup.pop();
+                emit OperatorAdded(operator, false);
+                break;
+            }
+        }
+    }
+}
+
+contract Withdrawable is PermissionGroups {
+    event TokenWithdraw(address token, uint256 amount, address sendTo);
+    event EtherWithdraw(uint256 amount, address sendTo);
+
+    constructor(address _admin) PermissionGroups(_admin) {}
+
+    function withdrawToken(ERC20 token, uint256 amount, address sendTo) external onlyAdmin {
+        require(token.transfer(sendTo, amount));
+        emit TokenWithdraw(address(token), amount, sendTo);
+    }
+
+    function withdrawEther(uint256 amount, address payable sendTo) external onlyAdmin {
+        sendTo.transfer(amount);
+        emit EtherWithdraw(amount, sendTo);
+    }
+}
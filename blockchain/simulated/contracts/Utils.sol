@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20.sol";
+
+contract Utils {
+    address internal constant ETH_TOKEN_ADDRESS = 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE;
+    uint256 internal constant PRECISION = (10**18);
+    uint256 internal constant MAX_QTY = (10**28);
+    uint256 internal constant MAX_RATE = (PRECISION * 10**6);
+    uint256 internal constant MAX_DECIMALS = 18;
+    uint256 internal constant ETH_DECIMALS = 18;
+
+    mapping(address => uint256) internal decimals;
+
+    function setDecimals(address token) internal {
+        if (token == ETH_TOKEN_ADDRESS) {
+            decimals[token] = ETH_DECIMALS;
+        } else {
+            decimals[token] = ERC20(token).decimals();
+        }
+    }
+
+    function getDecimals(address token) public view returns (uint256) {
+        if (token == ETH_TOKEN_ADDRESS) {
+            return ETH_DECIMALS;
+        }
+        uint256 tokenDecimals = decimals[token];
+        // the token may not be set with setDecimals
+        if (tokenDecimals == 0) {
+            return ERC20(token).decimals();
+        }
+        return tokenDecimals;
+    }
+
+    function calcDstQty(uint256 srcQty, uint256 srcDecimals, uint256 dstDecimals, uint256 rate)
+        internal
+        pure
+        returns (uint256)
+    {
+        require(srcQty <= MAX_QTY);
+        require(rate <= MAX_RATE);
+        if (dstDecimals >= srcDecimals) {
+            require((dstDecimals - srcDecimals) <= MAX_DECIMALS);
+            return (srcQty * rate * (10**(dstDecimals - srcDecimals))) / PRECISION;
+        }
+        require((srcDecimals - dstDecimals) <= MAX_DECIMALS);
+        return (srcQty * rate) / (PRECISION * (10**(srcDecimals - dstDecimals)));
+    }
+
+    function calcSrcQty(uint256 dstQty, uint256 srcDecimals, uint256 dstDecimals, uint256 rate)
+        internal
+        pure
+        returns (uint256)
+    {
+        require(dstQty <= MAX_QTY);
+        require(rate <= MAX_RATE);
+        uint256 numerator;
+        uint256 denominator;
+        if (srcDecimals >= dstDecimals) {
+            require((srcDecimals - dstDecimals) <= MAX_DECIMALS);
+            numerator = (PRECISION * dstQty * (10**(srcDecimals - dstDecimals)));
+            denominator = rate;
+        } else {
+            require((dstDecimals - srcDecimals) <= MAX_DECIMALS);
+            numerator = (PRECISION * dstQty);
+            denominator = (rate * (10**(dstDecimals - srcDecimals)));
+        }
+        // avoid rounding down errors
+        return (numerator + denominator - 1) / denominator;
+    }
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20.sol";
+import "./ConversionRates.sol";
+import "./KyberReserve.sol";
+
+interface KyberNetworkInterface {
+    function getExpectedRate(
+        address src,
+        address dest,
+        uint256 srcQty
+    ) external view returns (uint256 expectedRate, uint256 slippageRate);
+}
+
+contract Wrapper {
+    address internal constant ETH_TOKEN_ADDRESS = 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE;
+
+    function getBalances(address reserve, ERC20[] memory tokens) public view returns (uint256[] memory) {
+        uint256[] memory result = new uint256[](tokens.length);
+        for (uint256 i = 0; i < tokens.length; i++) {
+            uint256 balance = 0;
+            if (address(tokens[i]) == ETH_TOKEN_ADDRESS) {
+                balance = reserve.balance;
+            } else {
+                balance = tokens[i].balanceOf(reserve);
+            }
+
+            result[i] = balance;
+        }
+
+        return result;
+    }
+
+    function getByteFromBytes14(bytes14 x, uint256 byteInd) public pure returns (bytes1) {
+        require(byteInd <= 13);
+        return x[byteInd];
+    }
+
+    function getInt8FromByte(bytes14 x, uint256 byteInd) public pure returns (int8) {
+        require(byteInd <= 13);
+        return int8(uint8(x[byteInd]));
+    }
+
+    function getTokenIndicies(ConversionRates ratesContract, ERC20[] memory tokenList)
+        public
+        view
+        returns (uint256[] memory, uint256[] memory)
+    {
+        uint256[] memory bulkIndices = new uint256[](tokenList.length);
+        uint256[] memory tokenIndexInBulk = new uint256[](tokenList.length);
+
+        for (uint256 i = 0; i < tokenList.length; i++) {
+            uint256 bulkIndex;
+            uint256 index;
+            (bulkIndex, index, , ) = ratesContract.getCompactData(tokenList[i]);
+            bulkIndices[i] = bulkIndex;
+            tokenIndexInBulk[i] = index;
+        }
+
+        return (bulkIndices, tokenIndexInBulk);
+    }
+
+    function getTokenRates(ConversionRates ratesContract, ERC20[] memory tokenList)
+        public
+        view
+        returns (
+            uint256[] memory,
+            uint256[] memory,
+            int8[] memory,
+            int8[] memory,
+            uint256[] memory
+        )
+    {
+        uint256[] memory buyBases = new uint256[](tokenList.length);
+        uint256[] memory sellBases = new uint256[](tokenList.length);
+        int8[] memory compactBuy = new int8[](tokenList.length);
+        int8[] memory compactSell = new int8[](tokenList.length);
+        uint256[] memory updateBlock = new uint256[](tokenList.length);
+
+        for (uint256 i = 0; i < tokenList.length; i++) {
+            buyBases[i] = ratesContract.getBasicRate(tokenList[i], true);
+            sellBases[i] = ratesContract.getBasicRate(tokenList[i], false);
+
+            bytes1 buy;
+            bytes1 sell;
+            (, , buy, sell) = ratesContract.getCompactData(tokenList[i]);
+            compactBuy[i] = int8(uint8(buy));
+            compactSell[i] = int8(uint8(sell));
+
+            updateBlock[i] = ratesContract.getRateUpdateBlock(tokenList[i]);
+        }
+
+        return (buyBases, sellBases, compactBuy, compactSell, updateBlock);
+    }
+
+    function getReserveRate(
+        KyberReserve reserve,
+        address[] memory srcs,
+        address[] memory dests
+    ) public view returns (uint256[] memory, uint256[] memory) {
+        require(srcs.length == dests.length);
+
+        uint256[] memory rates = new uint256[](srcs.length);
+        uint256[] memory sanityRates = new uint256[](srcs.length);
+
+        for (uint256 i = 0; i < srcs.length; i++) {
+            if (address(reserve.sanityRatesContract()) != address(0)) {
+                sanityRates[i] = reserve.sanityRatesContract().getSanityRate(srcs[i], dests[i]);
+            }
+            rates[i] = reserve.getConversionRate(srcs[i], dests[i], 0, block.number);
+        }
+
+        return (rates, sanityRates);
+    }
+
+    function getExpectedRates(
+        KyberNetworkInterface network,
+        address[] memory srcs,
+        address[] memory dests,
+        uint256[] memory qty
+    ) public view returns (uint256[] memory, uint256[] memory) {
+        require(srcs.length == dests.length);
+        require(srcs.length == qty.length);
+
+        uint256[] memory rates = new uint256[](srcs.length);
+        uint256[] memory slippage = new uint256[](srcs.length);
+        for (uint256 i = 0; i < srcs.length; i++) {
+            (rates[i], slippage[i]) = network.getExpectedRate(srcs[i], dests[i], qty[i]);
+        }
+        return (rates, slippage);
+    }
+}
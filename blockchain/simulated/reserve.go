@@ -0,0 +1,110 @@
+package simulated
+
+import (
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/blockchain"
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
+)
+
+// maxImbalance is the imbalance limit of listed tokens, large enough to never disable their rates.
+var maxImbalance = new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)
+
+// Reserve is a network proxy, reserve, conversion rates and wrapper deployed on a chain by its
+// admin.
+type Reserve struct {
+	chain     *Chain
+	Addresses common.ContractAddressConfiguration
+	Proxy     *blockchain.NetworkProxy
+	Rates     *ConversionRates
+	Reserve   *KyberReserve
+	Wrapper   *Wrapper
+}
+
+// DeployReserve deploys the reserve contracts, the conversion rates records the imbalance of the
+// reserve trades.
+func (c *Chain) DeployReserve(t testing.TB) *Reserve {
+	r := &Reserve{chain: c}
+	addr, tx, proxy, err := blockchain.DeployNetworkProxy(c.Admin, c.Backend, c.Admin.From)
+	c.Mine(t, tx, err)
+	r.Addresses.Proxy, r.Proxy = addr, proxy
+	addr, tx, rates, err := DeployConversionRates(c.Admin, c.Backend, c.Admin.From)
+	c.Mine(t, tx, err)
+	r.Addresses.Pricing, r.Rates = addr, rates
+	addr, tx, reserve, err := DeployKyberReserve(c.Admin, c.Backend, r.Addresses.Proxy, r.Addresses.Pricing, c.Admin.From)
+	c.Mine(t, tx, err)
+	r.Addresses.Reserve, r.Reserve = addr, reserve
+	addr, tx, wrapper, err := DeployWrapper(c.Admin, c.Backend)
+	c.Mine(t, tx, err)
+	r.Addresses.Wrapper, r.Wrapper = addr, wrapper
+	tx, err = r.Rates.SetReserveAddress(c.Admin, r.Addresses.Reserve)
+	c.Mine(t, tx, err)
+	return r
+}
+
+// DeployToken deploys a token with the whole supply of 10^9 tokens owned by the admin.
+func (c *Chain) DeployToken(t testing.TB, symbol string, decimals int64) ethereum.Address {
+	supply := new(big.Int).Mul(big.NewInt(1e9), new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals), nil))
+	addr, tx, _, err := DeployTestToken(c.Admin, c.Backend, symbol, symbol, big.NewInt(decimals), supply)
+	c.Mine(t, tx, err)
+	return addr
+}
+
+// ListToken lists token in the conversion rates with trade enabled.
+func (r *Reserve) ListToken(t testing.TB, token ethereum.Address) {
+	c := r.chain
+	tx, err := r.Rates.AddToken(c.Admin, token)
+	c.Mine(t, tx, err)
+	tx, err = r.Rates.SetTokenControlInfo(c.Admin, token, big.NewInt(1), maxImbalance, maxImbalance)
+	c.Mine(t, tx, err)
+	tx, err = r.Rates.EnableTokenTrade(c.Admin, token)
+	c.Mine(t, tx, err)
+}
+
+// AddOperator adds op as operator of the conversion rates and the reserve.
+func (r *Reserve) AddOperator(t testing.TB, op ethereum.Address) {
+	c := r.chain
+	tx, err := r.Rates.AddOperator(c.Admin, op)
+	c.Mine(t, tx, err)
+	tx, err = r.Reserve.AddOperator(c.Admin, op)
+	c.Mine(t, tx, err)
+}
+
+// ApproveWithdrawAddress allows withdrawing token from the reserve to addr.
+func (r *Reserve) ApproveWithdrawAddress(t testing.TB, token, addr ethereum.Address) {
+	tx, err := r.Reserve.ApproveWithdrawAddress(r.chain.Admin, token, addr, true)
+	r.chain.Mine(t, tx, err)
+}
+
+// Deposit sends amount of token, which may be ETH, from the admin to the reserve.
+func (r *Reserve) Deposit(t testing.TB, token ethereum.Address, amount *big.Int) {
+	c := r.chain
+	if common.IsEthereumAddress(token) {
+		opts := *c.Admin
+		opts.Value = amount
+		tx, err := r.Reserve.Receive(&opts)
+		c.Mine(t, tx, err)
+		return
+	}
+	erc20, err := NewTestToken(token, c.Backend)
+	require.NoError(t, err)
+	tx, err := erc20.Transfer(c.Admin, r.Addresses.Reserve, amount)
+	c.Mine(t, tx, err)
+}
+
+// AssetReader is a setting reader serving only assets.
+type AssetReader struct {
+	storage.SettingReader
+	Assets []commonv3.Asset
+}
+
+// GetAssets returns the assets of the reader.
+func (r *AssetReader) GetAssets() ([]commonv3.Asset, error) {
+	return r.Assets, nil
+}
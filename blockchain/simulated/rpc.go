@@ -0,0 +1,325 @@
+package simulated
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	ether "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ethAPI serves the eth namespace used by the blockchain package from a simulated backend. Sent txs
+// are mined at once, so the pending state is always the state of the latest block.
+type ethAPI struct {
+	backend *backends.SimulatedBackend
+}
+
+// callArgs are the arguments of eth_call and eth_estimateGas.
+type callArgs struct {
+	From     ethereum.Address  `json:"from"`
+	To       *ethereum.Address `json:"to"`
+	Gas      *hexutil.Uint64   `json:"gas"`
+	GasPrice *hexutil.Big      `json:"gasPrice"`
+	Value    *hexutil.Big      `json:"value"`
+	Data     hexutil.Bytes     `json:"data"`
+}
+
+func (args callArgs) toCallMsg() ether.CallMsg {
+	msg := ether.CallMsg{From: args.From, To: args.To, Data: args.Data}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	if args.GasPrice != nil {
+		msg.GasPrice = args.GasPrice.ToInt()
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	return msg
+}
+
+// overrideAccount replaces the account fields of the state an eth_call is run on, like geth does.
+type overrideAccount struct {
+	Nonce     *hexutil.Uint64                  `json:"nonce"`
+	Code      *hexutil.Bytes                   `json:"code"`
+	Balance   *hexutil.Big                     `json:"balance"`
+	State     *map[ethereum.Hash]ethereum.Hash `json:"state"`
+	StateDiff *map[ethereum.Hash]ethereum.Hash `json:"stateDiff"`
+}
+
+func (a overrideAccount) apply(addr ethereum.Address, statedb *state.StateDB) error {
+	if a.State != nil && a.StateDiff != nil {
+		return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+	}
+	if a.Nonce != nil {
+		statedb.SetNonce(addr, uint64(*a.Nonce))
+	}
+	if a.Code != nil {
+		statedb.SetCode(addr, *a.Code)
+	}
+	if a.Balance != nil {
+		statedb.SetBalance(addr, a.Balance.ToInt())
+	}
+	if a.State != nil {
+		statedb.SetStorage(addr, *a.State)
+	}
+	if a.StateDiff != nil {
+		for key, value := range *a.StateDiff {
+			statedb.SetState(addr, key, value)
+		}
+	}
+	return nil
+}
+
+// revertError carries the revert data of a call to the client, like geth does.
+type revertError struct {
+	error
+	data string
+}
+
+func newRevertError(data []byte) *revertError {
+	err := errors.New("execution reverted")
+	if reason, uerr := abi.UnpackRevert(data); uerr == nil {
+		err = fmt.Errorf("execution reverted: %v", reason)
+	}
+	return &revertError{error: err, data: hexutil.Encode(data)}
+}
+
+// ErrorCode returns the JSON-RPC error code of reverts.
+func (e *revertError) ErrorCode() int { return 3 }
+
+// ErrorData returns the hex encoded revert data.
+func (e *revertError) ErrorData() interface{} { return e.data }
+
+// block returns the block of number, pending and latest are the current block.
+func (api *ethAPI) block(number rpc.BlockNumber) (*types.Block, error) {
+	chain := api.backend.Blockchain()
+	if number == rpc.PendingBlockNumber || number == rpc.LatestBlockNumber {
+		return chain.CurrentBlock(), nil
+	}
+	block := chain.GetBlockByNumber(uint64(number.Int64()))
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found", number.Int64())
+	}
+	return block, nil
+}
+
+func (api *ethAPI) state(number rpc.BlockNumber) (*types.Block, *state.StateDB, error) {
+	block, err := api.block(number)
+	if err != nil {
+		return nil, nil, err
+	}
+	statedb, err := api.backend.Blockchain().StateAt(block.Root())
+	if err != nil {
+		return nil, nil, err
+	}
+	return block, statedb, nil
+}
+
+// ChainId returns the chain id of the simulated chain.
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.backend.Blockchain().Config().ChainID)
+}
+
+// BlockNumber returns the number of the latest block.
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.backend.Blockchain().CurrentBlock().NumberU64())
+}
+
+// GasPrice returns the suggested gas price.
+func (api *ethAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := api.backend.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+// GetBalance returns the balance of addr at block number.
+func (api *ethAPI) GetBalance(addr ethereum.Address, number rpc.BlockNumber) (*hexutil.Big, error) {
+	_, statedb, err := api.state(number)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(statedb.GetBalance(addr)), nil
+}
+
+// GetCode returns the code of addr at block number.
+func (api *ethAPI) GetCode(addr ethereum.Address, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	_, statedb, err := api.state(number)
+	if err != nil {
+		return nil, err
+	}
+	return statedb.GetCode(addr), nil
+}
+
+// GetTransactionCount returns the nonce of addr at block number.
+func (api *ethAPI) GetTransactionCount(ctx context.Context, addr ethereum.Address, number rpc.BlockNumber) (*hexutil.Uint64, error) {
+	if number == rpc.PendingBlockNumber {
+		nonce, err := api.backend.PendingNonceAt(ctx, addr)
+		return (*hexutil.Uint64)(&nonce), err
+	}
+	_, statedb, err := api.state(number)
+	if err != nil {
+		return nil, err
+	}
+	nonce := statedb.GetNonce(addr)
+	return (*hexutil.Uint64)(&nonce), nil
+}
+
+// Call runs args on the state of block number with the accounts of overrides replaced.
+func (api *ethAPI) Call(args callArgs, number rpc.BlockNumber, overrides *map[ethereum.Address]overrideAccount) (hexutil.Bytes, error) {
+	block, statedb, err := api.state(number)
+	if err != nil {
+		return nil, err
+	}
+	if overrides != nil {
+		for addr, account := range *overrides {
+			if err := account.apply(addr, statedb); err != nil {
+				return nil, err
+			}
+		}
+	}
+	result, err := api.execute(args.toCallMsg(), block, statedb)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Revert()) > 0 {
+		return nil, newRevertError(result.Revert())
+	}
+	return result.Return(), result.Err
+}
+
+func (api *ethAPI) execute(call ether.CallMsg, block *types.Block, statedb *state.StateDB) (*core.ExecutionResult, error) {
+	if call.GasPrice == nil {
+		call.GasPrice = big.NewInt(1)
+	}
+	if call.Gas == 0 {
+		call.Gas = 50000000
+	}
+	if call.Value == nil {
+		call.Value = new(big.Int)
+	}
+	statedb.SetBalance(call.From, math.MaxBig256)
+	msg := types.NewMessage(call.From, call.To, 0, call.Value, call.Gas, call.GasPrice, call.Data, false)
+	chain := api.backend.Blockchain()
+	evm := vm.NewEVM(core.NewEVMContext(msg, block.Header(), chain, nil), statedb, chain.Config(), vm.Config{})
+	return core.NewStateTransition(evm, msg, new(core.GasPool).AddGas(math.MaxUint64)).TransitionDb()
+}
+
+// EstimateGas estimates the gas args use on the pending state.
+func (api *ethAPI) EstimateGas(ctx context.Context, args callArgs) (hexutil.Uint64, error) {
+	gas, err := api.backend.EstimateGas(ctx, args.toCallMsg())
+	return hexutil.Uint64(gas), err
+}
+
+// SendRawTransaction mines the signed tx in input in a new block. The simulated backend panics on
+// txs that can't be included, so they are checked first.
+func (api *ethAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (ethereum.Hash, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(input, tx); err != nil {
+		return ethereum.Hash{}, err
+	}
+	if err := api.validateTx(ctx, tx); err != nil {
+		return ethereum.Hash{}, err
+	}
+	if err := api.backend.SendTransaction(ctx, tx); err != nil {
+		return ethereum.Hash{}, err
+	}
+	api.backend.Commit()
+	return tx.Hash(), nil
+}
+
+func (api *ethAPI) validateTx(ctx context.Context, tx *types.Transaction) error {
+	chain := api.backend.Blockchain()
+	sender, err := types.Sender(types.NewEIP155Signer(chain.Config().ChainID), tx)
+	if err != nil {
+		return core.ErrInvalidSender
+	}
+	nonce, err := api.backend.PendingNonceAt(ctx, sender)
+	if err != nil {
+		return err
+	}
+	switch {
+	case tx.Nonce() < nonce:
+		return core.ErrNonceTooLow
+	case tx.Nonce() > nonce:
+		return core.ErrNonceTooHigh
+	}
+	if tx.Gas() > chain.CurrentBlock().GasLimit() {
+		return core.ErrGasLimit
+	}
+	intrinsic, err := core.IntrinsicGas(tx.Data(), tx.To() == nil, true, true)
+	if err != nil {
+		return err
+	}
+	if tx.Gas() < intrinsic {
+		return core.ErrIntrinsicGas
+	}
+	balance, err := api.backend.BalanceAt(ctx, sender, nil)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(tx.Cost()) < 0 {
+		return core.ErrInsufficientFunds
+	}
+	return nil
+}
+
+// GetTransactionByHash returns the mined tx of hash with its block and sender, nil if there is no
+// such tx.
+func (api *ethAPI) GetTransactionByHash(ctx context.Context, hash ethereum.Hash) (map[string]interface{}, error) {
+	tx, _, err := api.backend.TransactionByHash(ctx, hash)
+	if err == ether.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := api.backend.TransactionReceipt(ctx, hash)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	sender, err := types.Sender(types.NewEIP155Signer(api.backend.Blockchain().Config().ChainID), tx)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]interface{}{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	result["from"] = sender
+	result["blockHash"] = receipt.BlockHash
+	result["blockNumber"] = (*hexutil.Big)(receipt.BlockNumber)
+	result["transactionIndex"] = hexutil.Uint64(receipt.TransactionIndex)
+	return result, nil
+}
+
+// GetTransactionReceipt returns the receipt of the tx of hash, nil if there is no such tx.
+func (api *ethAPI) GetTransactionReceipt(ctx context.Context, hash ethereum.Hash) (*types.Receipt, error) {
+	return api.backend.TransactionReceipt(ctx, hash)
+}
+
+// GetBlockByNumber returns the header of block number, nil if there is no such block. Txs are
+// never included.
+func (api *ethAPI) GetBlockByNumber(number rpc.BlockNumber, _ bool) (*types.Header, error) {
+	block, err := api.block(number)
+	if err != nil {
+		return nil, nil
+	}
+	return block.Header(), nil
+}
@@ -0,0 +1,138 @@
+// Package simulated runs the reserve contracts on go-ethereum's simulated backend and serves the
+// chain over an in-process JSON-RPC client, so the blockchain package and core can be tested
+// against contract bytecode without a live node.
+//
+// The contracts in contracts/ are ports of the KyberNetwork reserve, conversion rates and wrapper
+// contracts to solc 0.8, their ABIs are compatible with the ABIs of the blockchain package. The
+// bindings are generated with:
+//
+//	solc --optimize --evm-version istanbul --combined-json abi,bin contracts/*.sol > combined.json
+//	abigen --combined-json combined.json --pkg simulated
+//
+// keeping the ConversionRates, KyberReserve, Wrapper and TestToken contracts, one file each.
+package simulated
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	baseblockchain "github.com/KyberNetwork/reserve-data/common/blockchain"
+	"github.com/KyberNetwork/reserve-data/common/blockchain/nonce"
+)
+
+const (
+	// GasLimit is the gas limit of blocks of the simulated chain.
+	GasLimit = 10000000
+	// nodeURL is the URL the simulated chain is known by in node pools and broadcasters.
+	nodeURL = "simulated"
+)
+
+// Chain is a simulated chain mining a block for every sent tx.
+type Chain struct {
+	Backend *backends.SimulatedBackend
+	// Admin deploys the contracts and is funded with ETH at genesis.
+	Admin   *bind.TransactOpts
+	RPC     *rpc.Client
+	Client  *ethclient.Client
+	ChainID *big.Int
+	server  *rpc.Server
+}
+
+// NewChain starts a simulated chain serving JSON-RPC in process, it must be closed with Close.
+func NewChain(t testing.TB) *Chain {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	admin := bind.NewKeyedTransactor(key)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		admin.From: {Balance: common.EthToWei(1000000)},
+	}, GasLimit)
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &ethAPI{backend: backend}))
+	client := rpc.DialInProc(server)
+	return &Chain{
+		Backend: backend,
+		server:  server,
+		Admin:   admin,
+		RPC:     client,
+		Client:  ethclient.NewClient(client),
+		ChainID: backend.Blockchain().Config().ChainID,
+	}
+}
+
+// Close stops serving and the simulated backend.
+func (c *Chain) Close() {
+	c.RPC.Close()
+	c.server.Stop()
+	_ = c.Backend.Close()
+}
+
+// Mine commits the pending block and requires tx to succeed.
+func (c *Chain) Mine(t testing.TB, tx *types.Transaction, err error) {
+	require.NoError(t, err)
+	c.Backend.Commit()
+	receipt, err := c.Backend.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status, "tx %s failed", tx.Hash().Hex())
+}
+
+// Fund sends amount wei from the admin to addr.
+func (c *Chain) Fund(t testing.TB, addr ethereum.Address, amount *big.Int) {
+	ctx := context.Background()
+	nonce, err := c.Backend.PendingNonceAt(ctx, c.Admin.From)
+	require.NoError(t, err)
+	tx, err := c.Admin.Signer(types.NewEIP155Signer(c.ChainID), c.Admin.From,
+		types.NewTransaction(nonce, addr, amount, 21000, big.NewInt(1), nil))
+	require.NoError(t, err)
+	c.Mine(t, tx, c.Backend.SendTransaction(ctx, tx))
+}
+
+// NewOperator creates an operator with a new key funded with 100 ETH.
+func (c *Chain) NewOperator(t testing.TB) *baseblockchain.Operator {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := &Signer{key: key, chainID: c.ChainID}
+	c.Fund(t, signer.GetAddress(), common.EthToWei(100))
+	return baseblockchain.NewOperator(signer, nonce.NewTimeWindow(signer.GetAddress(), 0))
+}
+
+// NewBaseBlockchain creates a base blockchain with operators calling and broadcasting to the
+// simulated chain only.
+func (c *Chain) NewBaseBlockchain(operators map[string]*baseblockchain.Operator) *baseblockchain.BaseBlockchain {
+	node := &common.EthClient{Client: c.Client, URL: nodeURL, RPCClient: c.RPC}
+	return baseblockchain.NewBaseBlockchain(
+		c.RPC,
+		c.Client,
+		operators,
+		baseblockchain.NewBroadcaster(map[string]*ethclient.Client{nodeURL: c.Client}),
+		baseblockchain.NewContractCaller(baseblockchain.NewNodePool([]*common.EthClient{node}, baseblockchain.DefaultMaxBlockLag)),
+	)
+}
+
+// Signer signs txs with a private key for the simulated chain.
+type Signer struct {
+	key     *ecdsa.PrivateKey
+	chainID *big.Int
+}
+
+// GetAddress returns the address of the key.
+func (s *Signer) GetAddress() ethereum.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+// Sign signs tx with the key.
+func (s *Signer) Sign(tx *types.Transaction) (*types.Transaction, error) {
+	return types.SignTx(tx, types.NewEIP155Signer(s.chainID), s.key)
+}
@@ -0,0 +1,332 @@
+package simulated
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/blockchain"
+	"github.com/KyberNetwork/reserve-data/common"
+	baseblockchain "github.com/KyberNetwork/reserve-data/common/blockchain"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type testReserve struct {
+	chain   *Chain
+	reserve *Reserve
+	bc      *blockchain.Blockchain
+	pricing *baseblockchain.Operator
+	assets  []commonv3.Asset
+	reader  *AssetReader
+}
+
+// newTestReserve deploys a reserve listing KNC and USDC, with a blockchain using its own pricing and
+// deposit operators.
+func newTestReserve(t *testing.T) *testReserve {
+	chain := NewChain(t)
+	reserve := chain.DeployReserve(t)
+	assets := []commonv3.Asset{
+		{ID: 1, Symbol: "ETH", Address: ethereum.HexToAddress("0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"), Decimals: 18},
+		{ID: 2, Symbol: "KNC", Address: chain.DeployToken(t, "KNC", 18), Decimals: 18, SetRate: commonv3.ExchangeFeed},
+		{ID: 3, Symbol: "USDC", Address: chain.DeployToken(t, "USDC", 6), Decimals: 6, SetRate: commonv3.ExchangeFeed},
+	}
+	for _, asset := range assets[1:] {
+		reserve.ListToken(t, asset.Address)
+	}
+	pricing, deposit := chain.NewOperator(t), chain.NewOperator(t)
+	reserve.AddOperator(t, pricing.Address)
+	reserve.AddOperator(t, deposit.Address)
+	base := chain.NewBaseBlockchain(map[string]*baseblockchain.Operator{
+		baseblockchain.PricingOP: pricing,
+		baseblockchain.DepositOP: deposit,
+	})
+	reader := &AssetReader{Assets: assets}
+	bc, err := blockchain.NewBlockchain(base, &reserve.Addresses, reader)
+	require.NoError(t, err)
+	return &testReserve{chain: chain, reserve: reserve, bc: bc, pricing: pricing, assets: assets, reader: reader}
+}
+
+// listTokens lists n more tokens.
+func (r *testReserve) listTokens(t *testing.T, n int) {
+	for i := 0; i < n; i++ {
+		symbol := fmt.Sprintf("T%d", i)
+		asset := commonv3.Asset{
+			ID:       rtypes.AssetID(len(r.assets) + 1),
+			Symbol:   symbol,
+			Address:  r.chain.DeployToken(t, symbol, 18),
+			Decimals: 18,
+			SetRate:  commonv3.ExchangeFeed,
+		}
+		r.reserve.ListToken(t, asset.Address)
+		r.assets = append(r.assets, asset)
+	}
+	r.reader.Assets = r.assets
+}
+
+func (r *testReserve) tokens() []ethereum.Address {
+	var result []ethereum.Address
+	for _, asset := range r.assets[1:] {
+		result = append(result, asset.Address)
+	}
+	return result
+}
+
+func (r *testReserve) blockNumber(t *testing.T) uint64 {
+	header, err := r.chain.Client.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err)
+	return header.Number.Uint64()
+}
+
+func (r *testReserve) rates(buy, sell float64) ([]*big.Int, []*big.Int) {
+	var buys, sells []*big.Int
+	for range r.tokens() {
+		buys = append(buys, eth(buy))
+		sells = append(sells, eth(sell))
+	}
+	return buys, sells
+}
+
+// setRates sets buys and sells of the listed tokens and requires the tx to be mined.
+func (r *testReserve) setRates(t *testing.T, buys, sells []*big.Int) {
+	nonce, err := r.bc.GetNextNonce(baseblockchain.PricingOP)
+	require.NoError(t, err)
+	block := new(big.Int).SetUint64(r.blockNumber(t))
+	tx, err := r.bc.SetRates(baseblockchain.PricingOP, r.tokens(), buys, sells, block, nonce, big.NewInt(1))
+	require.NoError(t, err)
+	status, _, err := r.bc.TxStatus(tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, common.MiningStatusMined, status)
+}
+
+// requireRates requires getRate of the listed tokens to return buys and sells within 0.1%.
+func (r *testReserve) requireRates(t *testing.T, buys, sells []*big.Int) {
+	block := new(big.Int).SetUint64(r.blockNumber(t))
+	for i, token := range r.tokens() {
+		buy, err := r.bc.GetPrice(token, block, "buy", big.NewInt(0), 0)
+		require.NoError(t, err)
+		requireRate(t, buys[i], buy)
+		sell, err := r.bc.GetPrice(token, block, "sell", big.NewInt(0), 0)
+		require.NoError(t, err)
+		requireRate(t, sells[i], sell)
+	}
+}
+
+func requireRate(t *testing.T, expected, actual *big.Int) {
+	diff := new(big.Int).Abs(new(big.Int).Sub(expected, actual))
+	require.True(t, new(big.Int).Mul(diff, big.NewInt(1000)).Cmp(expected) <= 0,
+		"rate %s, want %s", actual, expected)
+}
+
+func eth(amount float64) *big.Int {
+	return common.FloatToBigInt(amount, 18)
+}
+
+func TestDeployNetworkProxy(t *testing.T) {
+	chain := NewChain(t)
+	defer chain.Close()
+
+	reserve := chain.DeployReserve(t)
+	code, err := chain.Client.CodeAt(context.Background(), reserve.Addresses.Proxy, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, code)
+	admin, err := reserve.Proxy.Admin(nil)
+	require.NoError(t, err)
+	require.Equal(t, chain.Admin.From, admin)
+
+	var operators []ethereum.Address
+	for i := 0; i < 2; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		operator := crypto.PubkeyToAddress(key.PublicKey)
+		tx, err := reserve.Proxy.AddOperator(chain.Admin, operator)
+		chain.Mine(t, tx, err)
+		operators = append(operators, operator)
+	}
+	registered, err := reserve.Proxy.GetOperators(nil)
+	require.NoError(t, err)
+	require.Equal(t, operators, registered)
+
+	tx, err := reserve.Proxy.RemoveOperator(chain.Admin, operators[0])
+	chain.Mine(t, tx, err)
+	registered, err = reserve.Proxy.GetOperators(nil)
+	require.NoError(t, err)
+	require.Equal(t, operators[1:], registered)
+}
+
+func TestLoadAndSetTokenIndices(t *testing.T) {
+	r := newTestReserve(t)
+	defer r.chain.Close()
+
+	_, err := r.bc.SetRates(baseblockchain.PricingOP, r.tokens(), []*big.Int{eth(500), eth(1)},
+		[]*big.Int{eth(0.002), eth(1)}, big.NewInt(1), big.NewInt(0), big.NewInt(1))
+	require.Error(t, err, "set rate must wait for token indices")
+
+	require.NoError(t, r.bc.LoadAndSetTokenIndices())
+	require.Equal(t, r.tokens(), r.bc.ListedTokens())
+	for _, token := range r.tokens() {
+		require.NoError(t, r.bc.CheckTokenIndices(token))
+	}
+}
+
+func TestSetRates(t *testing.T) {
+	r := newTestReserve(t)
+	defer r.chain.Close()
+	require.NoError(t, r.bc.LoadAndSetTokenIndices())
+
+	// tokens have no base rate, so the first rates are set with setBaseRate
+	buys := []*big.Int{eth(500), eth(250)}
+	sells := []*big.Int{eth(0.002), eth(0.004)}
+	r.setRates(t, buys, sells)
+	r.requireRates(t, buys, sells)
+
+	// a small change is written in compact data only
+	buys = []*big.Int{eth(502), eth(250)}
+	sells = []*big.Int{eth(0.0019), eth(0.004)}
+	r.setRates(t, buys, sells)
+	r.requireRates(t, buys, sells)
+
+	rates, err := r.bc.FetchRates(0, r.blockNumber(t))
+	require.NoError(t, err)
+	require.Len(t, rates.Data, 2)
+	entry := rates.Data[rtypes.AssetID(2)]
+	require.Equal(t, eth(500), entry.BaseBuy)
+	require.Equal(t, eth(0.002), entry.BaseSell)
+	require.Equal(t, int8(4), entry.CompactBuy)
+	require.Equal(t, int8(-50), entry.CompactSell)
+	require.Equal(t, r.blockNumber(t), entry.Block, "rates are set for the next block")
+
+	// a change out of the compact data range sets a new base rate
+	buys = []*big.Int{eth(800), eth(250)}
+	r.setRates(t, buys, sells)
+	r.requireRates(t, buys, sells)
+}
+
+func TestFetchBalanceData(t *testing.T) {
+	r := newTestReserve(t)
+	defer r.chain.Close()
+
+	r.reserve.Deposit(t, r.assets[0].Address, eth(10))
+	r.reserve.Deposit(t, r.assets[1].Address, eth(1000))
+	balances, err := r.bc.FetchBalanceData(r.reserve.Addresses.Reserve, 0)
+	require.NoError(t, err)
+	require.Len(t, balances, 3)
+	for id, expected := range map[rtypes.AssetID]*big.Int{1: eth(10), 2: eth(1000)} {
+		require.True(t, balances[id].Valid)
+		balance := big.Int(balances[id].Balance)
+		require.Equal(t, expected, &balance)
+	}
+	require.False(t, balances[3].Valid, "zero balance is invalid")
+}
+
+func TestSend(t *testing.T) {
+	r := newTestReserve(t)
+	defer r.chain.Close()
+
+	knc := r.assets[1]
+	r.reserve.Deposit(t, knc.Address, eth(1000))
+	dest := ethereum.HexToAddress("0x1000000000000000000000000000000000000001")
+	_, err := r.bc.Send(knc, eth(10), dest, nil, big.NewInt(1))
+	require.Error(t, err, "dest is not approved")
+
+	r.reserve.ApproveWithdrawAddress(t, knc.Address, dest)
+	tx, err := r.bc.Send(knc, eth(10), dest, nil, big.NewInt(1))
+	require.NoError(t, err)
+	status, _, err := r.bc.TxStatus(tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, common.MiningStatusMined, status)
+
+	token, err := NewTestToken(knc.Address, r.chain.Backend)
+	require.NoError(t, err)
+	balance, err := token.BalanceOf(nil, dest)
+	require.NoError(t, err)
+	require.Equal(t, eth(10), balance)
+	balance, err = token.BalanceOf(nil, r.reserve.Addresses.Reserve)
+	require.NoError(t, err)
+	require.Equal(t, eth(990), balance)
+}
+
+func TestSetRatesChangedBulks(t *testing.T) {
+	r := newTestReserve(t)
+	defer r.chain.Close()
+	// 14 tokens are stored in a bulk, the last token is alone in the second bulk
+	r.listTokens(t, 13)
+	require.NoError(t, r.bc.LoadAndSetTokenIndices())
+
+	buys, sells := r.rates(500, 0.002)
+	r.setRates(t, buys, sells)
+	r.requireRates(t, buys, sells)
+
+	buys[0] = eth(505)
+	block := new(big.Int).SetUint64(r.blockNumber(t) + 1)
+	plans, err := r.bc.PlanSetRates(baseblockchain.PricingOP, r.tokens(), buys, sells, block)
+	require.NoError(t, err)
+	require.Len(t, plans, 2)
+	require.Equal(t, blockchain.SetRatePlanChanged, plans[0].Name, "writing one bulk is cheaper")
+	require.Len(t, plans[0].Call.Indices, 1)
+	require.Equal(t, r.tokens()[14:], plans[0].Skipped)
+
+	r.setRates(t, buys, sells)
+	r.requireRates(t, buys, sells)
+}
+
+func TestSimulateCalls(t *testing.T) {
+	r := newTestReserve(t)
+	defer r.chain.Close()
+	require.NoError(t, r.bc.LoadAndSetTokenIndices())
+	buys, sells := r.rates(500, 0.002)
+	r.setRates(t, buys, sells)
+
+	ratesABI, err := abi.JSON(strings.NewReader(ConversionRatesABI))
+	require.NoError(t, err)
+	token := r.tokens()[0]
+	block := new(big.Int).SetUint64(r.blockNumber(t))
+	// compact buy of the first token in the first bulk is raised by 1%
+	setCompactData, err := ratesABI.Pack("setCompactData", [][14]byte{{10}}, [][14]byte{{}}, block, []*big.Int{big.NewInt(0)})
+	require.NoError(t, err)
+	getRate, err := ratesABI.Pack("getRate", token, block, true, big.NewInt(0))
+	require.NoError(t, err)
+	pricing := r.reserve.Addresses.Pricing
+
+	results, err := r.bc.SimulateCalls(baseblockchain.PricingOP,
+		[]ethereum.Address{pricing, pricing}, [][]byte{setCompactData, getRate})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, eth(505), new(big.Int).SetBytes(results[1]), "getRate sees the compact data set before it")
+	rate, err := r.bc.GetPrice(token, block, "buy", big.NewInt(0), 0)
+	require.NoError(t, err)
+	require.Equal(t, eth(500), rate, "simulation doesn't change the chain")
+
+	_, err = r.bc.SimulateCalls(baseblockchain.DepositOP, []ethereum.Address{pricing}, [][]byte{setCompactData})
+	require.NoError(t, err, "deposit operator is an operator of the conversion rates too")
+	tx, err := r.reserve.Rates.RemoveOperator(r.chain.Admin, r.pricing.Address)
+	r.chain.Mine(t, tx, err)
+	_, err = r.bc.SimulateCalls(baseblockchain.PricingOP, []ethereum.Address{pricing}, [][]byte{setCompactData})
+	require.Error(t, err, "only operators set compact data")
+}
+
+func TestGetStepFunctions(t *testing.T) {
+	r := newTestReserve(t)
+	defer r.chain.Close()
+	token := r.tokens()[0]
+	// step functions are set by operators
+	r.reserve.AddOperator(t, r.chain.Admin.From)
+	xBuy, yBuy := []*big.Int{eth(1), eth(10)}, []*big.Int{big.NewInt(-10), big.NewInt(-100)}
+	xSell, ySell := []*big.Int{eth(2)}, []*big.Int{big.NewInt(-20)}
+	tx, err := r.reserve.Rates.SetQtyStepFunction(r.chain.Admin, token, xBuy, yBuy, xSell, ySell)
+	r.chain.Mine(t, tx, err)
+
+	functions, err := r.bc.GetStepFunctions(token)
+	require.NoError(t, err)
+	require.Equal(t, commonv3.PricingStepFunction{X: xBuy, Y: yBuy}, functions.QtyBuy)
+	require.Equal(t, commonv3.PricingStepFunction{X: xSell, Y: ySell}, functions.QtySell)
+	require.Empty(t, functions.ImbalanceBuy.X)
+	require.Empty(t, functions.ImbalanceSell.Y)
+}
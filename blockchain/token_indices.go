@@ -0,0 +1,120 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+)
+
+// ErrTokenIndicesInconsistent is returned by set rate while token indices may not match the
+// listed tokens of the pricing contract.
+var ErrTokenIndicesInconsistent = errors.New("token indices are inconsistent with pricing contract")
+
+// WatchTokenIndices checks listed tokens of the pricing contract every interval and reloads
+// token indices when tokens are listed or delisted, it never returns.
+func (bc *Blockchain) WatchTokenIndices(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := bc.SyncTokenIndices(); err != nil {
+			bc.l.Errorw("failed to sync token indices", "err", err)
+		}
+	}
+}
+
+// SyncTokenIndices reloads token indices if listed tokens of the pricing contract changed
+// or the last load failed. Set rate is blocked from a change is detected until indices are reloaded.
+func (bc *Blockchain) SyncTokenIndices() error {
+	tokenAddrs, err := bc.getListedTokens()
+	if err != nil {
+		return err
+	}
+	bc.mu.RLock()
+	added, removed := diffTokens(bc.listedTokens, tokenAddrs)
+	lastErr := bc.tokenIndicesErr
+	bc.mu.RUnlock()
+	if len(added) == 0 && len(removed) == 0 && lastErr == nil {
+		return nil
+	}
+	if len(added) != 0 || len(removed) != 0 {
+		bc.l.Infow("listed tokens changed, reloading token indices", "added", added, "removed", removed)
+		bc.setTokenIndicesError(fmt.Errorf("listed tokens changed, added %v, removed %v", added, removed))
+	}
+	return bc.loadTokenIndices(tokenAddrs)
+}
+
+// loadTokenIndices loads indices of tokenAddrs from pricing contract and makes them the listed tokens.
+func (bc *Blockchain) loadTokenIndices(tokenAddrs []ethereum.Address) error {
+	bulkIndices, indicesInBulk, err := bc.GeneratedGetTokenIndicies(
+		bc.GetCallOpts(0),
+		bc.contractAddress.Pricing,
+		tokenAddrs,
+	)
+	if err != nil {
+		bc.setTokenIndicesError(err)
+		return err
+	}
+	indices := make(map[string]tbindex, len(tokenAddrs))
+	for i, tok := range tokenAddrs {
+		indices[tok.Hex()] = newTBIndex(
+			bulkIndices[i].Uint64(),
+			indicesInBulk[i].Uint64(),
+		)
+	}
+	bc.mu.Lock()
+	bc.listedTokens = tokenAddrs
+	bc.tokenIndices = indices
+	bc.tokenIndicesErr = nil
+	bc.mu.Unlock()
+	bc.l.Infof("Token indices: %+v", indices)
+	return nil
+}
+
+func (bc *Blockchain) setTokenIndicesError(err error) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	bc.tokenIndicesErr = err
+}
+
+// tokenIndicesOf returns the indices of tokens, or ErrTokenIndicesInconsistent if indices are
+// being reloaded or a token has no index.
+func (bc *Blockchain) tokenIndicesOf(tokens []ethereum.Address) (map[string]tbindex, error) {
+	bc.mu.RLock()
+	defer bc.mu.RUnlock()
+	if bc.tokenIndicesErr != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTokenIndicesInconsistent, bc.tokenIndicesErr)
+	}
+	result := make(map[string]tbindex, len(tokens))
+	for _, token := range tokens {
+		index, ok := bc.tokenIndices[token.Hex()]
+		if !ok {
+			return nil, fmt.Errorf("%w: token %s has no index", ErrTokenIndicesInconsistent, token.Hex())
+		}
+		result[token.Hex()] = index
+	}
+	return result, nil
+}
+
+// diffTokens returns tokens of current that are not in previous and tokens of previous that
+// are not in current.
+func diffTokens(previous, current []ethereum.Address) (added, removed []ethereum.Address) {
+	previousSet := make(map[ethereum.Address]struct{}, len(previous))
+	for _, token := range previous {
+		previousSet[token] = struct{}{}
+	}
+	currentSet := make(map[ethereum.Address]struct{}, len(current))
+	for _, token := range current {
+		currentSet[token] = struct{}{}
+		if _, ok := previousSet[token]; !ok {
+			added = append(added, token)
+		}
+	}
+	for _, token := range previous {
+		if _, ok := currentSet[token]; !ok {
+			removed = append(removed, token)
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,47 @@
+package blockchain
+
+import (
+	"errors"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDiffTokens(t *testing.T) {
+	var (
+		tokenA = ethereum.HexToAddress("0x14535eE720e329f66071B86486763Da4637034aE")
+		tokenB = ethereum.HexToAddress("0x24535eE720e329F66071b86486763da4637034AE")
+		tokenC = ethereum.HexToAddress("0x34535ee720e329f66071B86486763Da4637034aE")
+	)
+	added, removed := diffTokens([]ethereum.Address{tokenA, tokenB}, []ethereum.Address{tokenB, tokenA})
+	require.Empty(t, added)
+	require.Empty(t, removed)
+
+	added, removed = diffTokens([]ethereum.Address{tokenA, tokenB}, []ethereum.Address{tokenB, tokenC})
+	require.Equal(t, []ethereum.Address{tokenC}, added)
+	require.Equal(t, []ethereum.Address{tokenA}, removed)
+}
+
+func TestTokenIndicesOf(t *testing.T) {
+	var (
+		tokenA = ethereum.HexToAddress("0x14535eE720e329f66071B86486763Da4637034aE")
+		tokenB = ethereum.HexToAddress("0x24535eE720e329F66071b86486763da4637034AE")
+	)
+	bc := &Blockchain{
+		tokenIndices: map[string]tbindex{tokenA.Hex(): newTBIndex(1, 2)},
+		l:            zap.S(),
+	}
+
+	indices, err := bc.tokenIndicesOf([]ethereum.Address{tokenA})
+	require.NoError(t, err)
+	require.Equal(t, map[string]tbindex{tokenA.Hex(): newTBIndex(1, 2)}, indices)
+
+	_, err = bc.tokenIndicesOf([]ethereum.Address{tokenA, tokenB})
+	require.True(t, errors.Is(err, ErrTokenIndicesInconsistent))
+
+	bc.setTokenIndicesError(errors.New("listed tokens changed"))
+	_, err = bc.tokenIndicesOf([]ethereum.Address{tokenA})
+	require.True(t, errors.Is(err, ErrTokenIndicesInconsistent))
+}
@@ -12,13 +12,21 @@ import (
 	"github.com/KyberNetwork/reserve-data/common/archive"
 	"github.com/KyberNetwork/reserve-data/common/blockchain"
 	"github.com/KyberNetwork/reserve-data/core"
+	"github.com/KyberNetwork/reserve-data/core/risk"
+	"github.com/KyberNetwork/reserve-data/core/rotation"
+	"github.com/KyberNetwork/reserve-data/core/secmonitor"
 	"github.com/KyberNetwork/reserve-data/data"
+	"github.com/KyberNetwork/reserve-data/data/balancehistory"
 	"github.com/KyberNetwork/reserve-data/data/datapruner"
+	"github.com/KyberNetwork/reserve-data/data/export"
 	"github.com/KyberNetwork/reserve-data/data/fetcher"
 	"github.com/KyberNetwork/reserve-data/data/fetcher/httprunner"
+	"github.com/KyberNetwork/reserve-data/data/pricehistory"
 	"github.com/KyberNetwork/reserve-data/data/storage"
 	"github.com/KyberNetwork/reserve-data/exchange/binance"
 	"github.com/KyberNetwork/reserve-data/exchange/huobi"
+	apphttp "github.com/KyberNetwork/reserve-data/http"
+	"github.com/KyberNetwork/reserve-data/lib/eventbus"
 	storagev3 "github.com/KyberNetwork/reserve-data/reservesetting/storage"
 	"github.com/KyberNetwork/reserve-data/world"
 )
@@ -27,10 +35,20 @@ import (
 type Config struct {
 	ActivityStorage      core.ActivityStorage
 	DataStorage          data.Storage
+	IdempotencyStorage   apphttp.IdempotencyStorage
+	RotationStorage      rotation.Storage
+	SecurityStorage      secmonitor.Storage
+	RiskChecker          core.RiskChecker
 	DataGlobalStorage    data.GlobalStorage
 	FetcherStorage       fetcher.Storage
 	FetcherGlobalStorage fetcher.GlobalStorage
-	Archive              archive.Archive
+	Archives             []archive.Archive
+	Retention            common.RetentionConfig
+	ExportStorage        export.Storage
+	PriceHistoryStorage  pricehistory.Storage
+	BalanceHistory       balancehistory.Storage
+	EventBus             *eventbus.Bus
+	FetchDataExportDir   string
 
 	World                *world.TheWorld
 	FetcherRunner        fetcher.Runner
@@ -39,10 +57,25 @@ type Config struct {
 	Exchanges            []common.Exchange
 	BlockchainSigner     blockchain.Signer
 	DepositSigner        blockchain.Signer
+	// PricingAdminSigner is nil if no pricing admin keystore is configured.
+	PricingAdminSigner blockchain.Signer
+	// ReserveAdminSigner is nil if no reserve admin keystore is configured.
+	ReserveAdminSigner blockchain.Signer
+	// PricingRotationSigner and DepositRotationSigner are the keys operators are rotated to, they
+	// are nil if no rotation keystore is configured.
+	PricingRotationSigner blockchain.Signer
+	DepositRotationSigner blockchain.Signer
+	// FundingSigner is nil if no funding keystore is configured.
+	FundingSigner blockchain.Signer
+	// PricingStandbySigners are the signers of standby pricing operators.
+	PricingStandbySigners []blockchain.Signer
 
 	EthereumEndpoint        string
 	BackupEthereumEndpoints []string
 	Blockchain              *blockchain.BaseBlockchain
+	NodeReadQuorum          int
+
+	TokenIndicesCheckInterval time.Duration
 
 	SettingStorage    storagev3.Interface
 	ContractAddresses *common.ContractAddressConfiguration
@@ -67,6 +100,15 @@ func (c *Config) AddCoreConfig(cliCtx *cli.Context, rcf common.RawConfig, bi bin
 		l.Errorw("failed to create new data storage database", "err", err)
 		return err
 	}
+	if c.EventBus, err = NewEventBusFromContext(cliCtx); err != nil {
+		l.Errorw("failed to create event bus", "err", err)
+		return err
+	}
+	if err = validateGasConfig(rcf.GasConfig); err != nil {
+		l.Errorw("invalid gas config", "err", err)
+		return err
+	}
+	c.GasConfig = rcf.GasConfig
 
 	var fetcherRunner fetcher.Runner
 	var dataControllerRunner datapruner.StorageControllerRunner
@@ -89,13 +131,38 @@ func (c *Config) AddCoreConfig(cliCtx *cli.Context, rcf common.RawConfig, bi bin
 
 	c.ActivityStorage = dataStorage
 	c.DataStorage = dataStorage
+	c.IdempotencyStorage = dataStorage
+	c.RotationStorage = dataStorage
+	c.SecurityStorage = dataStorage
+	c.RiskChecker = risk.NewChecker(settingStore, dataStorage, dataStorage)
 	c.DataGlobalStorage = dataStorage
+	c.ExportStorage = dataStorage
+	c.PriceHistoryStorage = dataStorage
+	c.BalanceHistory = dataStorage
 	c.FetcherStorage = dataStorage
 	c.FetcherGlobalStorage = dataStorage
 	c.FetcherRunner = fetcherRunner
 	c.DataControllerRunner = dataControllerRunner
 	c.BlockchainSigner = blockchain.NewEthereumSigner(rcf.PricingKeystore, rcf.PricingPassphrase, chainID)
 	c.DepositSigner = blockchain.NewEthereumSigner(rcf.DepositKeystore, rcf.DepositPassphrase, chainID)
+	if rcf.PricingAdminKeystore != "" {
+		c.PricingAdminSigner = blockchain.NewEthereumSigner(rcf.PricingAdminKeystore, rcf.PricingAdminPassphrase, chainID)
+	}
+	if rcf.ReserveAdminKeystore != "" {
+		c.ReserveAdminSigner = blockchain.NewEthereumSigner(rcf.ReserveAdminKeystore, rcf.ReserveAdminPassphrase, chainID)
+	}
+	if rcf.PricingRotationKeystore != "" {
+		c.PricingRotationSigner = blockchain.NewEthereumSigner(rcf.PricingRotationKeystore, rcf.PricingRotationPassphrase, chainID)
+	}
+	if rcf.DepositRotationKeystore != "" {
+		c.DepositRotationSigner = blockchain.NewEthereumSigner(rcf.DepositRotationKeystore, rcf.DepositRotationPassphrase, chainID)
+	}
+	for _, keystore := range rcf.PricingStandbyKeystores {
+		c.PricingStandbySigners = append(c.PricingStandbySigners, blockchain.NewEthereumSigner(keystore.Path, keystore.Passphrase, chainID))
+	}
+	if rcf.FundingKeystore != "" {
+		c.FundingSigner = blockchain.NewEthereumSigner(rcf.FundingKeystore, rcf.FundingPassphrase, chainID)
+	}
 
 	// create Exchange pool
 	exchangePool, err := NewExchangePool(
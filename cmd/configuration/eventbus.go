@@ -0,0 +1,79 @@
+package configuration
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/KyberNetwork/reserve-data/lib/eventbus"
+)
+
+const (
+	eventNATSURLFlag           = "event-nats-url"
+	eventNATSSubjectPrefixFlag = "event-nats-subject-prefix"
+	eventWebhookURLFlag        = "event-webhook-url"
+	eventTypesFlag             = "event-types"
+
+	defaultEventNATSSubjectPrefix = "reserve."
+	eventWebhookTimeout           = 10 * time.Second
+)
+
+// NewEventBusCliFlags creates new cli flags for forwarding internal events.
+func NewEventBusCliFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:   eventNATSURLFlag,
+			Usage:  "NATS server to forward internal events to, e.g nats://localhost:4222, disabled if empty",
+			EnvVar: "EVENT_NATS_URL",
+		},
+		cli.StringFlag{
+			Name:   eventNATSSubjectPrefixFlag,
+			Usage:  "prefix of NATS subjects of forwarded events",
+			EnvVar: "EVENT_NATS_SUBJECT_PREFIX",
+			Value:  defaultEventNATSSubjectPrefix,
+		},
+		cli.StringSliceFlag{
+			Name:   eventWebhookURLFlag,
+			Usage:  "URL to post internal events to",
+			EnvVar: "EVENT_WEBHOOK_URLS",
+		},
+		cli.StringSliceFlag{
+			Name:   eventTypesFlag,
+			Usage:  "types of forwarded events, all types if empty",
+			EnvVar: "EVENT_TYPES",
+		},
+	}
+}
+
+// NewEventBusFromContext creates an event bus forwarding events to adapters configured by cli flags.
+func NewEventBusFromContext(c *cli.Context) (*eventbus.Bus, error) {
+	var types []eventbus.Type
+	for _, t := range c.StringSlice(eventTypesFlag) {
+		valid := false
+		for _, known := range eventbus.AllTypes() {
+			if eventbus.Type(t) == known {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid event type %s", t)
+		}
+		types = append(types, eventbus.Type(t))
+	}
+	bus := eventbus.New()
+	if natsURL := c.String(eventNATSURLFlag); natsURL != "" {
+		publisher, err := eventbus.NewNATSPublisher(natsURL)
+		if err != nil {
+			return nil, err
+		}
+		eventbus.Forward(bus, "nats", publisher, c.String(eventNATSSubjectPrefixFlag), types...)
+	}
+	for _, webhookURL := range c.StringSlice(eventWebhookURLFlag) {
+		publisher := eventbus.NewWebhookPublisher(webhookURL, &http.Client{Timeout: eventWebhookTimeout})
+		eventbus.Forward(bus, "webhook "+webhookURL, publisher, "", types...)
+	}
+	return bus, nil
+}
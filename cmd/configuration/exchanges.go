@@ -5,8 +5,7 @@ import (
 
 	"github.com/urfave/cli"
 
-	"github.com/KyberNetwork/reserve-data/common"
-	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
 
 const (
@@ -17,19 +16,24 @@ const (
 func NewExchangeCliFlag() cli.Flag {
 	return cli.StringSliceFlag{
 		Name:   exchangesFlag,
-		Usage:  "Enable an exchange for fetching order books, rebalancing purpose. By default all exchanges are disabled.",
+		Usage:  "Enable an exchange account by its name for fetching order books, rebalancing purpose. By default all exchanges are disabled.",
 		EnvVar: "KYBER_EXCHANGES",
 	}
 }
 
-// NewExchangesFromContext returns configured exchanges from cli context.
-func NewExchangesFromContext(c *cli.Context) ([]rtypes.ExchangeID, error) {
-	var exchanges []rtypes.ExchangeID
+// ExchangeGetter looks up exchange accounts defined in setting database.
+type ExchangeGetter interface {
+	GetExchangeByName(name string) (v3.Exchange, error)
+}
+
+// NewExchangesFromContext returns configured exchange accounts from cli context.
+func NewExchangesFromContext(c *cli.Context, eg ExchangeGetter) ([]v3.Exchange, error) {
+	var exchanges []v3.Exchange
 
 	for _, exchangeName := range c.GlobalStringSlice(exchangesFlag) {
-		exchange, ok := common.ValidExchangeNames[exchangeName]
-		if !ok {
-			return nil, fmt.Errorf("invalid exchange %v", exchangeName)
+		exchange, err := eg.GetExchangeByName(exchangeName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange %v: %s", exchangeName, err)
 		}
 		exchanges = append(exchanges, exchange)
 	}
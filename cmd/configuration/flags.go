@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/urfave/cli"
 	"go.uber.org/zap"
@@ -10,17 +11,23 @@ import (
 	"github.com/KyberNetwork/reserve-data/cmd/deployment"
 	"github.com/KyberNetwork/reserve-data/cmd/mode"
 	"github.com/KyberNetwork/reserve-data/common"
+	baseblockchain "github.com/KyberNetwork/reserve-data/common/blockchain"
 	"github.com/KyberNetwork/reserve-data/common/blockchain/nonce"
 	"github.com/KyberNetwork/reserve-data/common/gasinfo"
 	gaspricedataclient "github.com/KyberNetwork/reserve-data/common/gaspricedata-client"
 	"github.com/KyberNetwork/reserve-data/core"
+	"github.com/KyberNetwork/reserve-data/core/opbalance"
+	"github.com/KyberNetwork/reserve-data/core/rotation"
 	"github.com/KyberNetwork/reserve-data/data"
+	"github.com/KyberNetwork/reserve-data/data/balancehistory"
 	"github.com/KyberNetwork/reserve-data/data/fetcher"
+	"github.com/KyberNetwork/reserve-data/data/pricehistory"
 	"github.com/KyberNetwork/reserve-data/exchange/binance"
 	"github.com/KyberNetwork/reserve-data/exchange/huobi"
 	"github.com/KyberNetwork/reserve-data/lib/app"
 	"github.com/KyberNetwork/reserve-data/lib/migration"
 	"github.com/KyberNetwork/reserve-data/reservesetting/storage/postgres"
+	"github.com/KyberNetwork/reserve-data/reservesetting/webhook"
 )
 
 const (
@@ -98,10 +105,22 @@ func NewCliFlags() []cli.Flag {
 	flags = append(flags, NewPostgreSQLFlags(defaultDB)...)
 	flags = append(flags, app.NewSentryFlags()...)
 	flags = append(flags, migration.NewMigrationFolderPathFlag())
+	flags = append(flags, NewEventBusCliFlags()...)
 
 	return flags
 }
 
+// NewRestoreCliFlags returns flags of the command restoring archived data.
+func NewRestoreCliFlags() []cli.Flag {
+	flags := NewPostgreSQLFlags(defaultDB)
+	return append(flags, migration.NewMigrationFolderPathFlag())
+}
+
+// NewExportCliFlags returns database flags of the command exporting fetch data.
+func NewExportCliFlags() []cli.Flag {
+	return NewPostgreSQLFlags(defaultDB)
+}
+
 // CreateBlockchain create new blockchain object
 func CreateBlockchain(config *Config) (*blockchain.Blockchain, error) {
 	var (
@@ -119,11 +138,14 @@ func CreateBlockchain(config *Config) (*blockchain.Blockchain, error) {
 		return nil, err
 	}
 
+	bc.SetReadQuorum(config.NodeReadQuorum)
+
 	err = bc.LoadAndSetTokenIndices()
 	if err != nil {
 		l.Errorw("Can't load and set token indices", "err", err)
 		return nil, err
 	}
+	go bc.WatchTokenIndices(config.TokenIndicesCheckInterval)
 
 	return bc, nil
 }
@@ -152,26 +174,79 @@ func CreateDataCore(config *Config,
 	nonceDeposit := nonce.NewTimeWindow(config.DepositSigner.GetAddress(), 10000)
 	bc.RegisterPricingOperator(config.BlockchainSigner, nonceCorpus)
 	bc.RegisterDepositOperator(config.DepositSigner, nonceDeposit)
+	for _, signer := range config.PricingStandbySigners {
+		bc.RegisterPricingStandbyOperator(signer, nonce.NewTimeWindow(signer.GetAddress(), 2000))
+	}
+	if config.PricingAdminSigner != nil {
+		bc.RegisterPricingAdminOperator(config.PricingAdminSigner, nonce.NewTimeWindow(config.PricingAdminSigner.GetAddress(), 2000))
+	}
+	if config.ReserveAdminSigner != nil {
+		bc.RegisterReserveAdminOperator(config.ReserveAdminSigner, nonce.NewTimeWindow(config.ReserveAdminSigner.GetAddress(), 2000))
+	}
+	if config.FundingSigner != nil {
+		bc.RegisterFundingOperator(config.FundingSigner, nonce.NewTimeWindow(config.FundingSigner.GetAddress(), 2000))
+	}
 	dataFetcher.SetBlockchain(bc)
+	dataFetcher.SetEventBus(config.EventBus)
 
 	rData := data.NewReserveData(
 		config.DataStorage,
 		dataFetcher,
 		config.DataControllerRunner,
-		config.Archive,
+		config.Archives,
+		config.Retention,
 		config.DataGlobalStorage,
 		config.Exchanges,
 		config.SettingStorage,
+		pricehistory.NewHistory(config.PriceHistoryStorage, rcf.PriceHistoryDepth),
+		balancehistory.NewReconciler(config.BalanceHistory, config.SettingStorage, rcf.BalanceReconcileTolerance),
 	)
 
 	gasPriceLimiter := gasinfo.NewNetworkGasPriceLimiter(kyberNetworkProxy, rcf.GasConfig.FetchMaxGasCacheSeconds)
-	gasInfo := gasinfo.NewGasPriceInfo(gasPriceLimiter, rData, gaspricedataclient.New(httpClient, rcf.GasConfig.GasPriceURL))
+	gasClient := gaspricedataclient.New(httpClient, rcf.GasConfig.GasPriceURL)
+	gasInfo := gasinfo.NewGasPriceInfo(gasPriceLimiter, rData, gasClient)
+	gasInfo.SetAggregator(newGasAggregator(rcf.GasConfig, rData, gasClient, bc, httpClient))
 	gasinfo.SetGlobal(gasInfo)
-	rCore := core.NewReserveCore(bc, config.ActivityStorage, config.ContractAddresses, gasInfo)
+	rCore := core.NewReserveCore(bc, config.ActivityStorage, config.ContractAddresses, gasInfo, config.RiskChecker)
+	rCore.SetEventBus(config.EventBus)
+	rCore.SetGasPolicy(config.SettingStorage, config.DataStorage)
+	if rcf.PricingFailoverTimeout != 0 {
+		rCore.SetPricingFailoverTimeout(time.Duration(rcf.PricingFailoverTimeout))
+	}
+	webhook.NewDispatcher(config.SettingStorage, &http.Client{Timeout: eventWebhookTimeout}).Attach(config.EventBus)
 	dataFetcher.SetCore(rCore)
+	dataFetcher.SetOperatorBalanceMonitor(opbalance.NewMonitor(bc, rCore, config.DataStorage, rcf.OperatorTopUp))
 	return rData, rCore, gasInfo
 }
 
+// CreateOperatorRotator returns the rotator of operators to the rotation keys in config, operators
+// already rotated to them are registered with them. It must be called after the operators are
+// registered in CreateDataCore.
+func CreateOperatorRotator(config *Config, bc *blockchain.Blockchain, rCore *core.ReserveCore) (*rotation.Rotator, error) {
+	keys := map[string]rotation.Key{}
+	if signer := config.PricingRotationSigner; signer != nil {
+		keys[baseblockchain.PricingOP] = rotation.Key{
+			Signer: signer,
+			Register: func() {
+				bc.RegisterPricingOperator(signer, nonce.NewTimeWindow(signer.GetAddress(), 2000))
+			},
+		}
+	}
+	if signer := config.DepositRotationSigner; signer != nil {
+		keys[baseblockchain.DepositOP] = rotation.Key{
+			Signer: signer,
+			Register: func() {
+				bc.RegisterDepositOperator(signer, nonce.NewTimeWindow(signer.GetAddress(), 10000))
+			},
+		}
+	}
+	rotator := rotation.NewRotator(bc, config.RotationStorage, config.DataStorage, rCore, keys)
+	if err := rotator.Restore(); err != nil {
+		return nil, err
+	}
+	return rotator, nil
+}
+
 // NewConfigurationFromContext returns the Configuration object from cli context.
 func NewConfigurationFromContext(c *cli.Context, rcf common.RawConfig, store *postgres.Storage,
 	mainNode *common.EthClient, backupNodes []*common.EthClient) (*Config, error) {
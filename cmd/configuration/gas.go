@@ -0,0 +1,60 @@
+package configuration
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/KyberNetwork/reserve-data"
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/gasinfo"
+	gaspricedata "github.com/KyberNetwork/reserve-data/common/gaspricedata-client"
+	"github.com/KyberNetwork/reserve-data/common/gasstation"
+)
+
+const (
+	gasSourceGasStation = "gasstation"
+	gasSourceNode       = "node"
+
+	defaultGasPriceMaxAgeSeconds = 600
+)
+
+func gasAggregatorConfig(gc common.GasConfig) gasinfo.AggregatorConfig {
+	config := gasinfo.AggregatorConfig{
+		Strategy:     gc.Strategy,
+		Percentile:   gc.Percentile,
+		OutlierRatio: gc.OutlierRatio,
+		MaxAge:       time.Duration(gc.MaxAgeSeconds) * time.Second,
+	}
+	if config.Strategy == "" {
+		config.Strategy = gasinfo.StrategyPreferred
+	}
+	if gc.MaxAgeSeconds == 0 {
+		config.MaxAge = defaultGasPriceMaxAgeSeconds * time.Second
+	}
+	return config
+}
+
+func validateGasConfig(gc common.GasConfig) error {
+	for _, source := range gc.Sources {
+		if source != gasSourceGasStation && source != gasSourceNode {
+			return fmt.Errorf("invalid gas price source %s", source)
+		}
+	}
+	return gasAggregatorConfig(gc).Validate()
+}
+
+// newGasAggregator creates an aggregator of the gas price data service and the sources in gas config.
+func newGasAggregator(gc common.GasConfig, store reserve.GasConfig, gasClient gaspricedata.Client,
+	node gasinfo.Node, httpClient *http.Client) *gasinfo.Aggregator {
+	sources := []gasinfo.Source{gasinfo.NewGasPriceDataSource(gasClient)}
+	for _, source := range gc.Sources {
+		switch source {
+		case gasSourceGasStation:
+			sources = append(sources, gasinfo.NewGasStationSource(gasstation.New(httpClient, gc.GasStationAPIKey)))
+		case gasSourceNode:
+			sources = append(sources, gasinfo.NewNodeSource(node))
+		}
+	}
+	return gasinfo.NewAggregator(gasAggregatorConfig(gc), store, sources...)
+}
@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/urfave/cli"
@@ -16,6 +17,11 @@ import (
 	"github.com/KyberNetwork/reserve-data/world"
 )
 
+const (
+	defaultNodeHealthCheckInterval   = 15 * time.Second
+	defaultTokenIndicesCheckInterval = time.Minute
+)
+
 // GetConfig return config for core
 func GetConfig(
 	cliCtx *cli.Context,
@@ -40,23 +46,46 @@ func GetConfig(
 		bkClients[bn.URL] = bn.Client
 	}
 
+	maxBlockLag := rcf.Nodes.MaxBlockLag
+	if maxBlockLag == 0 {
+		maxBlockLag = blockchain.DefaultMaxBlockLag
+	}
+	healthCheckInterval := time.Duration(rcf.Nodes.HealthCheckInterval)
+	if healthCheckInterval == 0 {
+		healthCheckInterval = defaultNodeHealthCheckInterval
+	}
+	nodePool := blockchain.NewNodePool(callClients, maxBlockLag)
+	go nodePool.Run(healthCheckInterval)
+
 	bc := blockchain.NewBaseBlockchain(
 		mainNode.RPCClient, mainNode.Client, map[string]*blockchain.Operator{},
 		blockchain.NewBroadcaster(bkClients),
-		blockchain.NewContractCaller(callClients),
+		blockchain.NewContractCaller(nodePool),
 	)
 
-	s3archive := archive.NewS3Archive(rcf.AWSConfig)
+	tokenIndicesCheckInterval := time.Duration(rcf.TokenIndicesCheckInterval)
+	if tokenIndicesCheckInterval == 0 {
+		tokenIndicesCheckInterval = defaultTokenIndicesCheckInterval
+	}
+
+	retention := rcf.Retention
+	if retention == nil {
+		retention = common.DefaultRetention()
+	}
 	theWorld := world.NewTheWorld(rcf.WorldEndpoints)
 
 	config := &Config{
-		Blockchain:              bc,
-		EthereumEndpoint:        nodeConf.Main,
-		BackupEthereumEndpoints: nodeConf.Backup,
-		Archive:                 s3archive,
-		World:                   theWorld,
-		ContractAddresses:       contractAddressConf,
-		SettingStorage:          settingStorage,
+		Blockchain:                bc,
+		EthereumEndpoint:          nodeConf.Main,
+		BackupEthereumEndpoints:   nodeConf.Backup,
+		NodeReadQuorum:            rcf.Nodes.ReadQuorum,
+		TokenIndicesCheckInterval: tokenIndicesCheckInterval,
+		Archives:                  archive.NewArchives(rcf.AWSConfig, rcf.Archive),
+		Retention:                 retention,
+		FetchDataExportDir:        rcf.FetchDataExportDir,
+		World:                     theWorld,
+		ContractAddresses:         contractAddressConf,
+		SettingStorage:            settingStorage,
 	}
 	chainID, err := mainNode.ChainID(context.Background())
 	if err != nil {
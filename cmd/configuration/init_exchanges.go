@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	ethereum "github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli"
 	"go.uber.org/zap"
 
@@ -23,6 +24,7 @@ import (
 	huobiStorage "github.com/KyberNetwork/reserve-data/exchange/huobi/storage"
 	authhttp "github.com/KyberNetwork/reserve-data/lib/auth-http"
 	rtypes "github.com/KyberNetwork/reserve-data/lib/rtypes"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
 )
 
@@ -42,7 +44,7 @@ func updateTradingPairConf(
 	}
 	exInfo, err := ex.GetLiveExchangeInfos(pairs)
 	if err != nil {
-		l.Warnw("failed to get pair configuration for binance", "err", err.Error())
+		l.Warnw("failed to get pair configuration", "exchange", exchangeID.String(), "err", err.Error())
 		return
 	}
 
@@ -80,49 +82,127 @@ func updateTradingPairConf(
 	}
 }
 
-func updateDepositAddress(assetStorage storage.Interface, exchanges map[rtypes.ExchangeID]interface{}) {
-	var (
-		bin *exchange.Binance
-		hb  *exchange.Huobi
-		ok  bool
-	)
+// allowlistMessage is the message of the setting change proposing addresses of our own configuration.
+const allowlistMessage = "addresses core deposits and withdraws to are not in allowlist"
+
+// allowlistKey identifies an allowlist entry.
+type allowlistKey struct {
+	assetID     rtypes.AssetID
+	exchangeID  rtypes.ExchangeID
+	addressType string
+	address     ethereum.Address
+}
+
+// missingAllowedAddresses collects the addresses core sends assets to that are neither in the
+// allowlist nor in a pending allowlist setting change.
+type missingAllowedAddresses struct {
+	known   map[allowlistKey]bool
+	entries []v3.SettingChangeEntry
+}
+
+func newMissingAllowedAddresses(assetStorage storage.Interface) (*missingAllowedAddresses, error) {
+	allowlist, err := assetStorage.GetAddressAllowlist()
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[allowlistKey]bool, len(allowlist))
+	for _, a := range allowlist {
+		known[allowlistKey{assetID: a.AssetID, exchangeID: a.ExchangeID, addressType: a.Type, address: a.Address}] = true
+	}
+	pendings, err := assetStorage.GetSettingChanges(v3.ChangeCatalogAddressAllowlist, v3.ChangeStatusPending)
+	if err != nil && err != v3.ErrNotFound {
+		return nil, err
+	}
+	for _, p := range pendings {
+		for _, e := range p.ChangeList {
+			if a, ok := e.Data.(*v3.AddAllowedAddressEntry); ok {
+				known[allowlistKey{assetID: a.AssetID, exchangeID: a.ExchangeID, addressType: a.Type, address: a.Address}] = true
+			}
+		}
+	}
+	return &missingAllowedAddresses{known: known}, nil
+}
+
+func (m *missingAllowedAddresses) add(assetID rtypes.AssetID, exchangeID rtypes.ExchangeID,
+	addressType string, address ethereum.Address, description string) {
+	key := allowlistKey{assetID: assetID, exchangeID: exchangeID, addressType: addressType, address: address}
+	if m.known[key] {
+		return
+	}
+	m.known[key] = true
+	m.entries = append(m.entries, v3.SettingChangeEntry{
+		Type: v3.ChangeTypeAddAllowedAddress,
+		Data: &v3.AddAllowedAddressEntry{
+			AssetID:     assetID,
+			ExchangeID:  exchangeID,
+			Type:        addressType,
+			Address:     address,
+			Description: description,
+		},
+	})
+}
+
+// propose creates a pending allowlist setting change with the missing addresses, they are only
+// allowed once it is approved.
+func (m *missingAllowedAddresses) propose(assetStorage storage.Interface) error {
+	if len(m.entries) == 0 {
+		return nil
+	}
+	id, err := assetStorage.CreateSettingChange(v3.ChangeCatalogAddressAllowlist, v3.SettingChange{
+		ChangeList: m.entries,
+		Message:    allowlistMessage,
+	})
+	if err != nil {
+		return err
+	}
+	// test confirm, same as setting changes created through API.
+	if _, err := assetStorage.ConfirmSettingChange(id, false); err != nil {
+		if rErr := assetStorage.RejectSettingChange(id); rErr != nil {
+			zap.S().Errorw("failed to clean up with reject setting change", "err", rErr)
+		}
+		return err
+	}
+	zap.S().Infow("created address allowlist setting change", "id", id, "changes", len(m.entries))
+	return nil
+}
+
+// updateDepositAddress updates deposit addresses of transferable assets. Withdrawals to the
+// reserve and deposits to the intermediator that are not allowed yet are proposed in a pending
+// allowlist setting change.
+func updateDepositAddress(assetStorage storage.Interface, exchanges map[rtypes.ExchangeID]interface{}, reserve ethereum.Address) {
 	l := zap.S()
 	assets, err := assetStorage.GetTransferableAssets()
 	if err != nil {
 		l.Warnw("failed to get transferable assets", "err", err.Error())
 		return
 	}
+	missing, err := newMissingAllowedAddresses(assetStorage)
+	if err != nil {
+		l.Warnw("failed to get address allowlist", "err", err.Error())
+		return
+	}
 	for _, asset := range assets {
 		for _, ae := range asset.Exchanges {
-			switch ae.ExchangeID {
-			case rtypes.Binance, rtypes.Binance2:
+			missing.add(asset.ID, ae.ExchangeID, v3.AllowedAddressWithdraw, reserve, "reserve address")
+			switch ex := exchanges[ae.ExchangeID].(type) {
+			case *exchange.Binance:
 				l.Infow("updating deposit address for asset", "asset_id", asset.ID,
 					"exchange", ae.ExchangeID.String(), "symbol", ae.Symbol)
-				if bin, ok = exchanges[ae.ExchangeID].(*exchange.Binance); !ok {
-					l.Warnw("exchange does not exist", "exchange id", ae.ExchangeID)
-				}
-				depositAddress, ok := bin.Address(asset)
+				// Binance stores live deposit address itself if there is no stored address yet,
+				// a different live address is only reported.
+				depositAddress, ok := ex.Address(asset)
 				if !ok {
 					l.Warnw("failed to get deposit address for asset",
 						"asset_id", asset.ID,
-						"exchange", ae.ExchangeID.String(), "symbol", ae.Symbol, "err", err.Error())
+						"exchange", ae.ExchangeID.String(), "symbol", ae.Symbol)
 					continue
 				}
-				if err = assetStorage.UpdateDepositAddress(
-					asset.ID,
-					ae.ExchangeID,
-					depositAddress); err != nil {
-					l.Warnw("failed to update deposit address", "err", err)
-				}
-				l.Infow("updated deposit address", "address", depositAddress.Hex())
-			case rtypes.Huobi:
+				l.Infow("checked deposit address", "address", depositAddress.Hex())
+			case *exchange.Huobi:
 				l.Infow("updating deposit address for asset", "asset_id", asset.ID,
 					"exchange", ae.ExchangeID.String(),
 					"symbol", ae.Symbol)
-				if hb, ok = exchanges[ae.ExchangeID].(*exchange.Huobi); !ok {
-					l.Warnw("exchange does not exist", "exchange id", ae.ExchangeID)
-				}
-				depositAddress, ok := hb.Address(asset)
+				depositAddress, ok := ex.Address(asset)
 				if !ok {
 					l.Warnw("failed to get deposit address for asset",
 						"asset_id", asset.ID,
@@ -132,16 +212,23 @@ func updateDepositAddress(assetStorage storage.Interface, exchanges map[rtypes.E
 				}
 				err = assetStorage.UpdateDepositAddress(
 					asset.ID,
-					rtypes.Huobi,
+					ae.ExchangeID,
 					depositAddress)
 				if err != nil {
 					l.Warnw("assetStorage.UpdateDepositAddress", "err", err.Error())
 					continue
 				}
 				l.Infow("updated deposit address", "address", depositAddress.Hex())
+				// deposits to Huobi are sent to the intermediator, it is not a Huobi address
+				missing.add(asset.ID, ae.ExchangeID, v3.AllowedAddressDeposit, depositAddress, "intermediator address")
+			default:
+				l.Warnw("exchange does not exist", "exchange id", ae.ExchangeID)
 			}
 		}
 	}
+	if err := missing.propose(assetStorage); err != nil {
+		l.Warnw("failed to propose missing allowed addresses", "err", err)
+	}
 }
 
 func NewExchangePool(
@@ -156,13 +243,13 @@ func NewExchangePool(
 ) (*ExchangePool, error) {
 	exchanges := map[rtypes.ExchangeID]interface{}{}
 	var (
-		be      exchange.BinanceInterface
-		he      exchange.HuobiInterface
-		bin, hb common.Exchange
-		s       = zap.S()
+		be exchange.BinanceInterface
+		he exchange.HuobiInterface
+		hb common.Exchange
+		s  = zap.S()
 	)
 
-	enabledExchanges, err := NewExchangesFromContext(c)
+	enabledExchanges, err := NewExchangesFromContext(c, assetStorage)
 	if err != nil {
 		return nil, err
 	}
@@ -174,23 +261,23 @@ func NewExchangePool(
 	httpClient := &http.Client{Transport: exchange.NewTransportRateLimiter(&http.Client{Timeout: time.Second * 30})}
 	marketDataBaseURL := strings.TrimSuffix(rcf.MarketDataBaseURL, "/")
 	for _, exparam := range enabledExchanges {
-		switch exparam {
-		case rtypes.Binance, rtypes.Binance2:
-			accountID := rcf.BinanceAccountID
-			binanceSigner := binance.NewSigner(rcf.BinanceKey, rcf.BinanceSecret)
-			if exparam == rtypes.Binance2 {
-				accountID = rcf.BinanceAccount2ID
-				binanceSigner = binance.NewSigner(rcf.Binance2Key, rcf.Binance2Secret)
-			}
+		cred, ok := rcf.GetExchangeCredential(exparam.Credentials)
+		if !ok {
+			return nil, fmt.Errorf("no credentials %s for exchange %s", exparam.Credentials, exparam.Name)
+		}
+		switch exparam.Venue {
+		case v3.VenueBinance:
+			binanceSigner := binance.NewSigner(cred.Key, cred.Secret)
 			accountDataBaseURL := strings.TrimSuffix(rcf.AccountData.BaseURL, "/")
-			be = binance.NewBinanceEndpoint(binanceSigner, bi, dpl, httpClient, exparam,
-				marketDataBaseURL, accountDataBaseURL, accountID, authhttp.NewAuthHTTP(rcf.AccountData.AccessKey, rcf.AccountData.AccessSecret))
+			be = binance.NewBinanceEndpoint(binanceSigner, bi, dpl, httpClient, exparam.ID,
+				marketDataBaseURL, accountDataBaseURL, cred.AccountID, authhttp.NewAuthHTTP(rcf.AccountData.AccessKey, rcf.AccountData.AccessSecret))
 			binancestorage, err := binanceStorage.NewPostgresStorage(db)
 			if err != nil {
 				return nil, fmt.Errorf("cannot create Binance storage: (%s)", err.Error())
 			}
-			bin, err = exchange.NewBinance(
-				exparam,
+			bin, err := exchange.NewBinance(
+				exparam.ID,
+				exparam.Name,
 				be,
 				binancestorage,
 				assetStorage)
@@ -198,9 +285,13 @@ func NewExchangePool(
 				return nil, fmt.Errorf("cannot create exchange Binance: (%s)", err.Error())
 			}
 			exchanges[bin.ID()] = bin
-		case rtypes.Huobi:
-			huobiSigner := huobi.NewSigner(rcf.HoubiKey, rcf.HoubiSecret)
-			he = huobi.NewHuobiEndpoint(huobiSigner, hi, httpClient, marketDataBaseURL)
+		case v3.VenueHuobi:
+			// Huobi account runs its own intermediator and http server, only one of them is supported.
+			if hb != nil {
+				return nil, fmt.Errorf("only one Huobi account can be enabled, got %s and %s", hb.Name(), exparam.Name)
+			}
+			huobiSigner := huobi.NewSigner(cred.Key, cred.Secret)
+			he = huobi.NewHuobiEndpoint(huobiSigner, hi, httpClient, marketDataBaseURL, exparam.ID)
 			huobistorage, err := huobiStorage.NewPostgresStorage(db)
 			if err != nil {
 				return nil, fmt.Errorf("cannot create Binance storage: (%s)", err.Error())
@@ -208,6 +299,8 @@ func NewExchangePool(
 			intermediatorSigner := blockchaincommon.NewEthereumSigner(rcf.IntermediatorKeystore, rcf.IntermediatorPassphrase, chainID)
 			intermediatorNonce := nonce.NewTimeWindow(intermediatorSigner.GetAddress(), 10000)
 			hb, err = exchange.NewHuobi(
+				exparam.ID,
+				exparam.Name,
 				he,
 				blockchain,
 				intermediatorSigner,
@@ -219,18 +312,14 @@ func NewExchangePool(
 				return nil, fmt.Errorf("cannot create exchange Huobi: (%s)", err.Error())
 			}
 			exchanges[hb.ID()] = hb
+		default:
+			return nil, fmt.Errorf("venue %s of exchange %s is not supported", exparam.Venue, exparam.Name)
 		}
 	}
 
-	go updateDepositAddress(assetStorage, exchanges)
-	if bin, ok := exchanges[rtypes.Binance].(*exchange.Binance); ok {
-		go updateTradingPairConf(assetStorage, bin, bin.ID())
-	}
-	if bin2, ok := exchanges[rtypes.Binance2].(*exchange.Binance); ok {
-		go updateTradingPairConf(assetStorage, bin2, bin2.ID())
-	}
-	if hb != nil {
-		go updateTradingPairConf(assetStorage, hb, rtypes.Huobi)
+	go updateDepositAddress(assetStorage, exchanges, rcf.ContractAddresses.Reserve)
+	for id, ex := range exchanges {
+		go updateTradingPairConf(assetStorage, ex.(common.Exchange), id)
 	}
 	return &ExchangePool{
 		Exchanges: exchanges,
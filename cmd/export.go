@@ -0,0 +1,104 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/robfig/cron"
+	"github.com/urfave/cli"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/cmd/configuration"
+	"github.com/KyberNetwork/reserve-data/data/export"
+	"github.com/KyberNetwork/reserve-data/data/storage"
+	"github.com/KyberNetwork/reserve-data/reservesetting/storage/postgres"
+)
+
+const (
+	exportFromFlag   = "from"
+	exportToFlag     = "to"
+	exportOutputFlag = "output"
+	exportDateLayout = "2006-01-02"
+)
+
+func newExportCommand() cli.Command {
+	return cli.Command{
+		Name:  "export",
+		Usage: "export fetch data as CSV tables partitioned by day",
+		Flags: append(configuration.NewExportCliFlags(),
+			cli.StringFlag{
+				Name:  exportFromFlag,
+				Usage: "first day to export in YYYY-MM-DD, default yesterday",
+			},
+			cli.StringFlag{
+				Name:  exportToFlag,
+				Usage: "last day to export in YYYY-MM-DD, default same as from",
+			},
+			cli.StringFlag{
+				Name:  exportOutputFlag,
+				Usage: "directory to write exported tables",
+				Value: "export",
+			},
+		),
+		Action: exportFetchData,
+	}
+}
+
+func exportFetchData(c *cli.Context) error {
+	configuration.SetupLogging()
+	from := time.Now().UTC().AddDate(0, 0, -1).Truncate(24 * time.Hour)
+	if s := c.String(exportFromFlag); s != "" {
+		t, err := time.Parse(exportDateLayout, s)
+		if err != nil {
+			return err
+		}
+		from = t
+	}
+	to := from
+	if s := c.String(exportToFlag); s != "" {
+		t, err := time.Parse(exportDateLayout, s)
+		if err != nil {
+			return err
+		}
+		to = t
+	}
+	if to.Before(from) {
+		return errors.New("to must not be before from")
+	}
+	db, err := configuration.NewDBFromContext(c)
+	if err != nil {
+		return err
+	}
+	ps, err := storage.NewPostgresStorage(db)
+	if err != nil {
+		return err
+	}
+	sr, err := postgres.NewStorage(db)
+	if err != nil {
+		return err
+	}
+	exporter := export.NewExporter(ps, sr, c.String(exportOutputFlag))
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if err = exporter.ExportDay(day); err != nil {
+			return err
+		}
+		log.Printf("exported fetch data of %s", day.Format(exportDateLayout))
+	}
+	return nil
+}
+
+func scheduleFetchDataExport(s export.Storage, er export.ExchangeReader, dir string) error {
+	exporter := export.NewExporter(s, er, dir)
+	mc := cron.New()
+	err := mc.AddFunc("0 30 0 * * *", func() { // export yesterday after its last records are written
+		if err := exporter.ExportDay(time.Now().UTC().AddDate(0, 0, -1)); err != nil {
+			zap.S().Errorw("failed to export fetch data", "err", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	mc.Start()
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/KyberNetwork/reserve-data/cmd/deployment"
 	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/common/profiler"
+	"github.com/KyberNetwork/reserve-data/core/secmonitor"
 	"github.com/KyberNetwork/reserve-data/exchange/binance"
 	apphttp "github.com/KyberNetwork/reserve-data/http"
 	"github.com/KyberNetwork/reserve-data/lib/app"
@@ -35,6 +36,7 @@ func main() {
 
 	app.Flags = configuration.NewCliFlags()
 	app.Flags = append(app.Flags, profiler.NewCliFlags()...)
+	app.Commands = []cli.Command{newRestoreCommand(), newExportCommand()}
 
 	if err := app.Run(os.Args); err != nil {
 		log.Fatal(err)
@@ -123,6 +125,11 @@ func run(c *cli.Context) error {
 	dryRun := configuration.NewDryRunFromContext(c)
 
 	rData, rCore, gasInfo := configuration.CreateDataCore(conf, dpl, bc, kyberNetworkProxy, rcf, httpClient)
+	rotator, err := configuration.CreateOperatorRotator(conf, bc, rCore)
+	if err != nil {
+		l.Errorw("failed to create operator rotator", "err", err)
+		return err
+	}
 	if !dryRun {
 		if dpl != deployment.Simulation {
 			if err = rData.RunStorageController(); err != nil {
@@ -134,6 +141,13 @@ func run(c *cli.Context) error {
 			l.Errorw("failed to run data service", "err", err)
 			return err
 		}
+		go secmonitor.NewMonitor(bc, conf.SecurityStorage, rcf.SecurityMonitor).Run()
+		if conf.FetchDataExportDir != "" {
+			if err = scheduleFetchDataExport(conf.ExportStorage, conf.SettingStorage, conf.FetchDataExportDir); err != nil {
+				l.Errorw("failed to schedule fetch data export", "err", err)
+				return err
+			}
+		}
 	}
 
 	for _, ex := range conf.Exchanges {
@@ -154,6 +168,9 @@ func run(c *cli.Context) error {
 		conf.SettingStorage,
 		gasInfo,
 		binanceMainClient,
+		conf.IdempotencyStorage,
+		rotator,
+		conf.SecurityStorage,
 	)
 	if profiler.IsEnableProfilerFromContext(c) {
 		server.EnableProfiler()
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "gateway_audit_log";
+DROP FUNCTION IF EXISTS gateway_audit_log_append_only;
+DROP TABLE IF EXISTS "gateway_keys";
+DROP TABLE IF EXISTS "gateway_role_permissions";
+DROP TABLE IF EXISTS "gateway_roles";
@@ -0,0 +1,55 @@
+CREATE TABLE "gateway_roles"
+(
+    name    TEXT PRIMARY KEY,
+    created TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE "gateway_role_permissions"
+(
+    id     SERIAL PRIMARY KEY,
+    role   TEXT NOT NULL REFERENCES gateway_roles (name) ON DELETE CASCADE,
+    path   TEXT NOT NULL,
+    method TEXT NOT NULL,
+    UNIQUE (role, path, method)
+);
+
+-- role is not a foreign key as keys can be bound to built-in roles which are defined in code.
+CREATE TABLE "gateway_keys"
+(
+    id          TEXT PRIMARY KEY,
+    secret      TEXT        NOT NULL,
+    role        TEXT        NOT NULL,
+    assets      INT[]       NOT NULL DEFAULT '{}',
+    description TEXT        NOT NULL DEFAULT '',
+    created     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at  TIMESTAMPTZ,
+    revoked_at  TIMESTAMPTZ,
+    rotated_to  TEXT REFERENCES gateway_keys (id)
+);
+
+CREATE TABLE "gateway_audit_log"
+(
+    id        BIGSERIAL PRIMARY KEY,
+    time      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    key_id    TEXT        NOT NULL,
+    method    TEXT        NOT NULL,
+    route     TEXT        NOT NULL,
+    body_hash TEXT        NOT NULL,
+    status    INT         NOT NULL,
+    result    TEXT        NOT NULL
+);
+
+CREATE INDEX "gateway_audit_log_time_index" ON "gateway_audit_log" (time);
+
+CREATE OR REPLACE FUNCTION gateway_audit_log_append_only() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'gateway_audit_log is append-only';
+END
+$$ LANGUAGE PLPGSQL;
+
+CREATE TRIGGER "gateway_audit_log_append_only"
+    BEFORE UPDATE OR DELETE OR TRUNCATE
+    ON "gateway_audit_log"
+    FOR EACH STATEMENT
+EXECUTE PROCEDURE gateway_audit_log_append_only();
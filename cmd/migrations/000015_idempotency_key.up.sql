@@ -0,0 +1,12 @@
+CREATE TABLE "idempotency_key"
+(
+    key          TEXT PRIMARY KEY,
+    created      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    route        TEXT        NOT NULL,
+    request_hash TEXT        NOT NULL,
+    completed    BOOLEAN     NOT NULL DEFAULT FALSE,
+    status       INT,
+    response     BYTEA,
+    timepoint    BIGINT,
+    eid          TEXT
+);
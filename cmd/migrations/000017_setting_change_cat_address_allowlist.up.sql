@@ -0,0 +1 @@
+ALTER TYPE setting_change_cat ADD VALUE 'address_allowlist';
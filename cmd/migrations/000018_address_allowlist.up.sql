@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS "address_allowlist"
+(
+    id          SERIAL PRIMARY KEY,
+    asset_id    INT REFERENCES assets (id)    NOT NULL,
+    exchange_id INT REFERENCES exchanges (id) NOT NULL,
+    type        TEXT                          NOT NULL CHECK ( type IN ('deposit', 'withdraw') ),
+    address     TEXT                          NOT NULL,
+    description TEXT                          NOT NULL DEFAULT '',
+    created     TIMESTAMPTZ                   NOT NULL DEFAULT now(),
+    UNIQUE (asset_id, exchange_id, type, address)
+);
+
+-- deposit addresses currently in use are allowed, core proposes the reserve and intermediator addresses by setting change.
+INSERT INTO address_allowlist (asset_id, exchange_id, type, address, description)
+SELECT asset_id, exchange_id, 'deposit', deposit_address, 'deposit address in use when allowlist was created'
+FROM asset_exchanges
+WHERE deposit_address IS NOT NULL
+  AND deposit_address != ''
+  AND deposit_address != '0x0000000000000000000000000000000000000000'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,4 @@
+ALTER TABLE "exchanges"
+    DROP CONSTRAINT IF EXISTS venue_check,
+    DROP COLUMN IF EXISTS venue,
+    DROP COLUMN IF EXISTS credentials;
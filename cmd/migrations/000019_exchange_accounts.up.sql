@@ -0,0 +1,14 @@
+ALTER TABLE "exchanges"
+    ADD COLUMN IF NOT EXISTS venue       TEXT,
+    ADD COLUMN IF NOT EXISTS credentials TEXT;
+
+-- every exchange row is an account on a venue, existing accounts keep their name as credentials reference.
+UPDATE "exchanges" SET venue = 'binance' WHERE name IN ('binance', 'binance_2');
+UPDATE "exchanges" SET venue = 'huobi' WHERE name = 'huobi';
+UPDATE "exchanges" SET venue = name WHERE venue IS NULL;
+UPDATE "exchanges" SET credentials = name WHERE credentials IS NULL;
+
+ALTER TABLE "exchanges"
+    ALTER COLUMN venue SET NOT NULL,
+    ALTER COLUMN credentials SET NOT NULL,
+    ADD CONSTRAINT venue_check CHECK ( venue IN ('binance', 'huobi') );
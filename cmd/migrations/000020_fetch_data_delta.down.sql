@@ -0,0 +1,3 @@
+-- delta encoded rows can not be decoded without base
+DELETE FROM "fetch_data" WHERE base IS NOT NULL;
+ALTER TABLE "fetch_data" DROP COLUMN base;
@@ -0,0 +1,2 @@
+-- base is the created time of the keyframe of a delta encoded row, existing rows are keyframes
+ALTER TABLE "fetch_data" ADD COLUMN base TIMESTAMPTZ;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS "price_candles"
+(
+    resolution INTEGER     NOT NULL, -- in seconds
+    pair_id    INTEGER     NOT NULL,
+    bucket     TIMESTAMPTZ NOT NULL,
+    data       JSONB       NOT NULL,
+    PRIMARY KEY (resolution, pair_id, bucket)
+);
+CREATE INDEX IF NOT EXISTS "price_candles_bucket_index" ON "price_candles" (resolution, bucket);
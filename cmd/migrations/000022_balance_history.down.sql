@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "balance_reconciliation";
+DROP TABLE IF EXISTS "balance_history";
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS "balance_history"
+(
+    time        TIMESTAMPTZ      NOT NULL,
+    asset_id    INTEGER          NOT NULL,
+    exchange_id INTEGER          NOT NULL, -- 0 for reserve
+    balance     DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (time, asset_id, exchange_id)
+);
+CREATE INDEX IF NOT EXISTS "balance_history_asset_index" ON "balance_history" (asset_id, time);
+
+CREATE TABLE IF NOT EXISTS "balance_reconciliation"
+(
+    time     TIMESTAMPTZ NOT NULL,
+    asset_id INTEGER     NOT NULL,
+    flagged  BOOLEAN     NOT NULL,
+    data     JSONB       NOT NULL,
+    PRIMARY KEY (time, asset_id)
+);
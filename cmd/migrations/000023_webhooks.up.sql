@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS "webhook_subscriptions"
+(
+    id      SERIAL PRIMARY KEY,
+    url     TEXT        NOT NULL,
+    events  TEXT[]      NOT NULL,
+    key_id  TEXT        NOT NULL,
+    secret  TEXT        NOT NULL,
+    active  BOOLEAN     NOT NULL DEFAULT TRUE,
+    created TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries"
+(
+    id              BIGSERIAL PRIMARY KEY,
+    subscription_id INT REFERENCES webhook_subscriptions (id) ON DELETE CASCADE NOT NULL,
+    event_id        TEXT        NOT NULL,
+    event           TEXT        NOT NULL,
+    attempt         INT         NOT NULL,
+    status_code     INT         NOT NULL DEFAULT 0,
+    error           TEXT        NOT NULL DEFAULT '',
+    success         BOOLEAN     NOT NULL,
+    created         TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS "webhook_deliveries_created_idx" ON "webhook_deliveries" (created);
+
+CREATE TABLE IF NOT EXISTS "webhook_dead_letters"
+(
+    id              BIGSERIAL PRIMARY KEY,
+    subscription_id INT REFERENCES webhook_subscriptions (id) ON DELETE CASCADE NOT NULL,
+    event_id        TEXT        NOT NULL,
+    event           TEXT        NOT NULL,
+    payload         JSONB       NOT NULL,
+    attempts        INT         NOT NULL,
+    error           TEXT        NOT NULL DEFAULT '',
+    created         TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,2 @@
+-- postgres does not support removing a value from an enum type, the value is left in place.
+DELETE FROM setting_change WHERE cat = 'pricing_contract';
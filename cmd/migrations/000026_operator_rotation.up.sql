@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "operator_rotation"
+(
+    id          SERIAL PRIMARY KEY,
+    operator    TEXT        NOT NULL,
+    old_address TEXT        NOT NULL,
+    new_address TEXT        NOT NULL,
+    step        TEXT        NOT NULL,
+    error       TEXT        NOT NULL DEFAULT '',
+    add_tx      TEXT        NOT NULL DEFAULT '',
+    remove_tx   TEXT        NOT NULL DEFAULT '',
+    created     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated     TIMESTAMPTZ NOT NULL DEFAULT now()
+);
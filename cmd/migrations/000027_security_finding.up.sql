@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "security_finding"
+(
+    id        SERIAL PRIMARY KEY,
+    severity  TEXT        NOT NULL,
+    tx_hash   TEXT        NOT NULL DEFAULT '',
+    log_index INTEGER     NOT NULL DEFAULT 0,
+    data      JSONB       NOT NULL,
+    created   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "security_finding_event_idx" ON "security_finding" (tx_hash, log_index) WHERE tx_hash <> '';
+CREATE INDEX IF NOT EXISTS "security_finding_created_idx" ON "security_finding" (created);
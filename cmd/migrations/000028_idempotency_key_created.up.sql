@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS "idempotency_key_created_idx" ON "idempotency_key" (created);
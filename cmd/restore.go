@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"log"
+
+	"github.com/urfave/cli"
+
+	"github.com/KyberNetwork/reserve-data/cmd/configuration"
+	"github.com/KyberNetwork/reserve-data/data/storage"
+	"github.com/KyberNetwork/reserve-data/lib/migration"
+)
+
+func newRestoreCommand() cli.Command {
+	return cli.Command{
+		Name:      "restore",
+		Usage:     "re-import archived data files into database",
+		ArgsUsage: "<archive file>...",
+		Flags:     configuration.NewRestoreCliFlags(),
+		Action:    restore,
+	}
+}
+
+func restore(c *cli.Context) error {
+	configuration.SetupLogging()
+	if c.NArg() == 0 {
+		return errors.New("no archive file to restore")
+	}
+	db, err := configuration.NewDBFromContext(c)
+	if err != nil {
+		return err
+	}
+	if _, err = migration.RunMigrationUp(db.DB, migration.NewMigrationPathFromContext(c),
+		configuration.DatabaseNameFromContext(c)); err != nil {
+		return err
+	}
+	ps, err := storage.NewPostgresStorage(db)
+	if err != nil {
+		return err
+	}
+	for _, file := range c.Args() {
+		restored, err := ps.RestoreData(file)
+		if err != nil {
+			log.Printf("failed to restore archive file %s: %v", file, err)
+			return err
+		}
+		log.Printf("restored %d records from archive file %s", restored, file)
+	}
+	return nil
+}
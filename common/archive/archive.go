@@ -1,5 +1,27 @@
 package archive
 
+// Config lists destinations to archive obsolete files to in addition to the default S3 archive.
+type Config struct {
+	Local []LocalConfig `json:"local"`
+	S3    []AWSConfig   `json:"s3"`
+}
+
+// NewArchives returns an archive for every configured destination, the default S3 archive
+// is only used if its reserve data bucket is set.
+func NewArchives(defaultS3 AWSConfig, conf Config) []Archive {
+	var archives []Archive
+	if defaultS3.ExpiredReserveDataBucketName != "" {
+		archives = append(archives, NewS3Archive(defaultS3))
+	}
+	for _, s3Conf := range conf.S3 {
+		archives = append(archives, NewS3Archive(s3Conf))
+	}
+	for _, localConf := range conf.Local {
+		archives = append(archives, NewLocalArchive(localConf))
+	}
+	return archives
+}
+
 // Archive is used to store obsolete files.
 type Archive interface {
 	RemoveFile(bucketName string, destinationFolder string, filePath string) error
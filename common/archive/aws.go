@@ -13,6 +13,10 @@ type AWSConfig struct {
 	ExpiredStatDataBucketName    string `json:"aws_expired_stat_data_bucket_name"`
 	ExpiredReserveDataBucketName string `json:"aws_expired_reserve_data_bucket_name"`
 	LogBucketName                string `json:"aws_log_bucket_name"`
+	// Endpoint overrides AWS endpoint to use a S3 compatible storage like MinIO.
+	Endpoint string `json:"aws_endpoint"`
+	// ForcePathStyle addresses bucket in path instead of sub domain, most S3 compatible storages require it.
+	ForcePathStyle bool `json:"aws_force_path_style"`
 }
 
 func GetAWSconfigFromFile(path string) (AWSConfig, error) {
@@ -0,0 +1,90 @@
+package archive
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+)
+
+// LocalConfig is the configuration of archive on local filesystem, buckets are
+// directories under Dir.
+type LocalConfig struct {
+	Dir                   string `json:"dir"`
+	ExpiredReserveDataDir string `json:"expired_reserve_data_dir"`
+	LogDir                string `json:"log_dir"`
+}
+
+// LocalArchive stores archived files on local filesystem, e.g. a mounted network volume.
+type LocalArchive struct {
+	conf LocalConfig
+	l    *zap.SugaredLogger
+}
+
+// NewLocalArchive creates a new LocalArchive.
+func NewLocalArchive(conf LocalConfig) *LocalArchive {
+	return &LocalArchive{conf: conf, l: zap.S()}
+}
+
+func (a *LocalArchive) destination(bucketName, destinationFolder, filePath string) string {
+	return filepath.Join(a.conf.Dir, bucketName, destinationFolder, filepath.Base(filePath))
+}
+
+// UploadFile copies a local file into archive directory.
+func (a *LocalArchive) UploadFile(bucketName string, destinationFolder string, filePath string) error {
+	src, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := src.Close(); cErr != nil {
+			a.l.Errorw("File close error", "err", cErr)
+		}
+	}()
+	dstPath := a.destination(bucketName, destinationFolder, filePath)
+	if err = os.MkdirAll(filepath.Dir(dstPath), 0750); err != nil {
+		return err
+	}
+	dst, err := os.Create(dstPath)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		return fmt.Errorf("failed to copy %s to %s: %w", filePath, dstPath, err)
+	}
+	return dst.Close()
+}
+
+// RemoveFile removes an archived file.
+func (a *LocalArchive) RemoveFile(bucketName string, destinationFolder string, filePath string) error {
+	return os.Remove(a.destination(bucketName, destinationFolder, filePath))
+}
+
+// CheckFileIntergrity compares size of local file and archived one.
+func (a *LocalArchive) CheckFileIntergrity(bucketName string, destinationFolder string, filePath string) (bool, error) {
+	local, err := os.Stat(filePath)
+	if err != nil {
+		return false, err
+	}
+	archived, err := os.Stat(a.destination(bucketName, destinationFolder, filePath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return local.Size() == archived.Size(), nil
+}
+
+// GetReserveDataBucketName returns directory to store reserve data.
+func (a *LocalArchive) GetReserveDataBucketName() string {
+	return a.conf.ExpiredReserveDataDir
+}
+
+// GetLogBucketName returns directory to store logs.
+func (a *LocalArchive) GetLogBucketName() string {
+	return a.conf.LogDir
+}
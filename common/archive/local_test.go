@@ -0,0 +1,36 @@
+package archive
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "local_archive")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	filePath := filepath.Join(dir, "expired_data")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte("line\n"), 0600))
+
+	a := NewLocalArchive(LocalConfig{Dir: filepath.Join(dir, "archive"), ExpiredReserveDataDir: "reserve-data"})
+	bucket := a.GetReserveDataBucketName()
+	ok, err := a.CheckFileIntergrity(bucket, "expired-auth-data/", filePath)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, a.UploadFile(bucket, "expired-auth-data/", filePath))
+	ok, err = a.CheckFileIntergrity(bucket, "expired-auth-data/", filePath)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, a.RemoveFile(bucket, "expired-auth-data/", filePath))
+	_, err = os.Stat(filepath.Join(dir, "archive", "reserve-data", "expired-auth-data", "expired_data"))
+	require.True(t, os.IsNotExist(err))
+}
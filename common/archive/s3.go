@@ -104,10 +104,15 @@ func (a *S3Archive) GetLogBucketName() string {
 
 func NewS3Archive(conf AWSConfig) *S3Archive {
 	crdtl := credentials.NewStaticCredentials(conf.AccessKeyID, conf.SecretKey, conf.Token)
-	sess := session.Must(session.NewSession(&aws.Config{
-		Region:      aws.String(conf.Region),
-		Credentials: crdtl,
-	}))
+	awsConf := &aws.Config{
+		Region:           aws.String(conf.Region),
+		Credentials:      crdtl,
+		S3ForcePathStyle: aws.Bool(conf.ForcePathStyle),
+	}
+	if conf.Endpoint != "" {
+		awsConf.Endpoint = aws.String(conf.Endpoint)
+	}
+	sess := session.Must(session.NewSession(awsConf))
 	uploader := s3manager.NewUploader(sess)
 	svc := s3.New(sess)
 	archive := S3Archive{uploader: uploader,
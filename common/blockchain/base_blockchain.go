@@ -6,13 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
+	"sync"
 	"time"
 
 	ether "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -25,6 +28,9 @@ const (
 	zeroAddress string = "0x0000000000000000000000000000000000000000"
 )
 
+// ErrOperatorPaused is returned when a tx is sent from a paused operator.
+var ErrOperatorPaused = errors.New("operator is paused")
+
 // BaseBlockchain interact with the blockchain in a way that eases
 // other blockchain types in KyberNetwork.
 // It manages multiple operators (address, signer and nonce)
@@ -37,7 +43,9 @@ const (
 type BaseBlockchain struct {
 	client         *ethclient.Client
 	rpcClient      *rpc.Client
+	operatorsMu    sync.RWMutex
 	operators      map[string]*Operator
+	pausedOps      map[string]bool
 	broadcaster    *Broadcaster
 	contractCaller *ContractCaller
 	erc20abi       abi.ABI
@@ -45,6 +53,8 @@ type BaseBlockchain struct {
 }
 
 func (b *BaseBlockchain) OperatorAddresses() map[string]ethereum.Address {
+	b.operatorsMu.RLock()
+	defer b.operatorsMu.RUnlock()
 	result := map[string]ethereum.Address{}
 	for name, op := range b.operators {
 		result[name] = op.Address
@@ -53,6 +63,8 @@ func (b *BaseBlockchain) OperatorAddresses() map[string]ethereum.Address {
 }
 
 func (b *BaseBlockchain) MustRegisterOperator(name string, op *Operator) {
+	b.operatorsMu.Lock()
+	defer b.operatorsMu.Unlock()
 	//This shouldn't happen, each operator get registered only once.
 	if _, found := b.operators[name]; found {
 		panic(fmt.Sprintf("Operator name %s already exist", name))
@@ -60,15 +72,78 @@ func (b *BaseBlockchain) MustRegisterOperator(name string, op *Operator) {
 	b.operators[name] = op
 }
 
+// ReplaceOperator registers op as name, replacing the registered one, and resumes it if it was
+// paused. Txs built for the replaced operator must not be signed with the new one.
+func (b *BaseBlockchain) ReplaceOperator(name string, op *Operator) {
+	b.operatorsMu.Lock()
+	defer b.operatorsMu.Unlock()
+	b.operators[name] = op
+	delete(b.pausedOps, name)
+}
+
+// PauseOperator makes SignAndBroadcast fail with ErrOperatorPaused for txs from operator name,
+// until it is resumed or replaced.
+func (b *BaseBlockchain) PauseOperator(name string) {
+	b.operatorsMu.Lock()
+	defer b.operatorsMu.Unlock()
+	b.pausedOps[name] = true
+}
+
+// ResumeOperator allows txs from a paused operator again.
+func (b *BaseBlockchain) ResumeOperator(name string) {
+	b.operatorsMu.Lock()
+	defer b.operatorsMu.Unlock()
+	delete(b.pausedOps, name)
+}
+
+func (b *BaseBlockchain) isOperatorPaused(name string) bool {
+	b.operatorsMu.RLock()
+	defer b.operatorsMu.RUnlock()
+	return b.pausedOps[name]
+}
+
 func (b *BaseBlockchain) RecommendedGasPriceFromNode() (*big.Int, error) {
 	timeout, cancel := context.WithTimeout(context.Background(), 7*time.Second)
 	defer cancel()
 	return b.client.SuggestGasPrice(timeout)
 }
 
+// RecommendedGasPriceFromFeeHistory returns the base fee of the next block plus the median of
+// priority fees paid at given percentile in the last blocks, it fails on nodes without eth_feeHistory.
+func (b *BaseBlockchain) RecommendedGasPriceFromFeeHistory(blocks int, percentile float64) (*big.Int, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 7*time.Second)
+	defer cancel()
+	var result struct {
+		BaseFeePerGas []hexutil.Big   `json:"baseFeePerGas"`
+		Reward        [][]hexutil.Big `json:"reward"`
+	}
+	err := b.rpcClient.CallContext(timeout, &result, "eth_feeHistory", hexutil.Uint64(blocks), "latest", []float64{percentile})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.BaseFeePerGas) == 0 {
+		return nil, errors.New("empty fee history")
+	}
+	var rewards []*big.Int
+	for _, r := range result.Reward {
+		if len(r) > 0 {
+			rewards = append(rewards, r[0].ToInt())
+		}
+	}
+	// the last base fee is of the next block
+	price := new(big.Int).Set(result.BaseFeePerGas[len(result.BaseFeePerGas)-1].ToInt())
+	if len(rewards) > 0 {
+		sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+		price.Add(price, rewards[len(rewards)/2])
+	}
+	return price, nil
+}
+
 // MustGetOperator returns the operator if avail, panic if the operator can't be found
 func (b *BaseBlockchain) MustGetOperator(name string) *Operator {
+	b.operatorsMu.RLock()
 	op, found := b.operators[name]
+	b.operatorsMu.RUnlock()
 	if !found {
 		panic(fmt.Sprintf("operator %s is not found. you have to register it before using it", name))
 	}
@@ -83,6 +158,21 @@ func (b *BaseBlockchain) GetMinedNonce(operator string) (uint64, error) {
 	return nonce.Uint64(), err
 }
 
+// GetPendingNonce returns the nonce of operator including its pending txs, it equals the mined
+// nonce if the operator has no pending txs.
+func (b *BaseBlockchain) GetPendingNonce(operator string) (uint64, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return b.client.PendingNonceAt(timeout, b.MustGetOperator(operator).Address)
+}
+
+// GetETHBalance returns the ETH balance of addr in wei at the latest block.
+func (b *BaseBlockchain) GetETHBalance(addr ethereum.Address) (*big.Int, error) {
+	timeout, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return b.client.BalanceAt(timeout, addr, nil)
+}
+
 func (b *BaseBlockchain) GetNextNonce(operator string) (*big.Int, error) {
 	var nonce *big.Int
 	n := b.MustGetOperator(operator).NonceCorpus
@@ -101,6 +191,9 @@ func (b *BaseBlockchain) SignAndBroadcast(tx *types.Transaction, from string) (*
 	if tx == nil {
 		return nil, errors.New("nil tx is forbidden here")
 	}
+	if b.isOperatorPaused(from) {
+		return nil, fmt.Errorf("%s: %w", from, ErrOperatorPaused)
+	}
 	signedTx, err := signer.Sign(tx)
 	if err != nil {
 		return nil, err
@@ -153,11 +246,15 @@ func (b *BaseBlockchain) Call(timeOut time.Duration, opts CallOpts, contract *Co
 		code   []byte
 		output []byte
 	)
-	if opts.Block == nil || opts.Block.Cmp(ethereum.Big0) == 0 {
-		// calling in pending state
-		output, err = b.contractCaller.CallContract(msg, nil, timeOut)
+	var block *big.Int
+	if opts.Block != nil && opts.Block.Cmp(ethereum.Big0) != 0 {
+		block = opts.Block
+	}
+	if opts.Quorum > 1 {
+		output, err = b.contractCaller.QuorumCallContract(msg, block, timeOut, opts.Quorum)
 	} else {
-		output, err = b.contractCaller.CallContract(msg, opts.Block, timeOut)
+		// calling in pending state if block is nil
+		output, err = b.contractCaller.CallContract(msg, block, timeOut)
 	}
 	if err == nil && len(output) == 0 {
 		ctx := context.Background()
@@ -179,6 +276,22 @@ func (b *BaseBlockchain) Call(timeOut time.Duration, opts CallOpts, contract *Co
 	return contract.ABI.Unpack(result, method, output)
 }
 
+// PendingCall is like Call but executes against the pending block.
+func (b *BaseBlockchain) PendingCall(timeOut time.Duration, contract *Contract, result interface{}, method string, params ...interface{}) error {
+	input, err := contract.ABI.Pack(method, params...)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeOut)
+	defer cancel()
+	msg := ether.CallMsg{From: ethereum.HexToAddress(zeroAddress), To: &contract.Address, Data: input}
+	output, err := b.client.PendingCallContract(ctx, msg)
+	if err != nil {
+		return err
+	}
+	return contract.ABI.Unpack(result, method, output)
+}
+
 func (b *BaseBlockchain) BuildTx(context context.Context, opts TxOpts, contract *Contract, method string, params ...interface{}) (*types.Transaction, error) {
 	input, err := contract.ABI.Pack(method, params...)
 	if err != nil {
@@ -187,6 +300,32 @@ func (b *BaseBlockchain) BuildTx(context context.Context, opts TxOpts, contract
 	return b.transactTx(context, opts, contract.Address, input)
 }
 
+// EstimateTxGas estimates the gas used by calling method of contract from operator op in pending state.
+func (b *BaseBlockchain) EstimateTxGas(op string, contract *Contract, method string, params ...interface{}) (uint64, error) {
+	input, err := contract.ABI.Pack(method, params...)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	msg := ether.CallMsg{From: b.MustGetOperator(op).Address, To: &contract.Address, Data: input}
+	return b.client.EstimateGas(ctx, msg)
+}
+
+// SimulateTx executes calling method of contract from operator op with eth_call against the
+// pending block, it returns an error if the call reverts.
+func (b *BaseBlockchain) SimulateTx(op string, contract *Contract, method string, params ...interface{}) error {
+	input, err := contract.ABI.Pack(method, params...)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	msg := ether.CallMsg{From: b.MustGetOperator(op).Address, To: &contract.Address, Data: input}
+	_, err = b.client.PendingCallContract(ctx, msg)
+	return err
+}
+
 func (b *BaseBlockchain) transactTx(context context.Context, opts TxOpts, contract ethereum.Address, input []byte) (*types.Transaction, error) {
 	var err error
 	value := opts.Value
@@ -269,14 +408,51 @@ func (b *BaseBlockchain) GetLogs(param ether.FilterQuery) ([]types.Log, error) {
 	return result, err
 }
 
+// CurrentBlock returns the latest block of the healthiest node that answers.
 func (b *BaseBlockchain) CurrentBlock() (uint64, error) {
-	var blockno string
-	err := b.rpcClient.Call(&blockno, "eth_blockNumber")
-	if err != nil {
-		return 0, err
+	var err error
+	for _, node := range b.rpcNodes() {
+		var blockno string
+		start := time.Now()
+		err = node.RPCClient.Call(&blockno, "eth_blockNumber")
+		b.recordNode(node.URL, time.Since(start), err)
+		if err != nil {
+			b.l.Infow("FALLBACK: failed to get current block, trying next node", "node", node.URL, "err", err)
+			continue
+		}
+		return strconv.ParseUint(blockno, 0, 64)
+	}
+	return 0, err
+}
+
+// NodePool returns the pool of nodes used for calls, nil if there is none.
+func (b *BaseBlockchain) NodePool() *NodePool {
+	if b.contractCaller == nil {
+		return nil
+	}
+	return b.contractCaller.Pool()
+}
+
+// rpcNodes returns the nodes with an rpc client ordered by health, or the main node if there is none.
+func (b *BaseBlockchain) rpcNodes() []*common.EthClient {
+	var result []*common.EthClient
+	if pool := b.NodePool(); pool != nil {
+		for _, node := range pool.Nodes() {
+			if node.RPCClient != nil {
+				result = append(result, node)
+			}
+		}
+	}
+	if len(result) == 0 {
+		result = append(result, &common.EthClient{Client: b.client, RPCClient: b.rpcClient})
+	}
+	return result
+}
+
+func (b *BaseBlockchain) recordNode(url string, latency time.Duration, err error) {
+	if pool := b.NodePool(); pool != nil {
+		pool.Record(url, latency, err)
 	}
-	result, err := strconv.ParseUint(blockno, 0, 64)
-	return result, err
 }
 
 func (b *BaseBlockchain) PackERC20Data(method string, params ...interface{}) ([]byte, error) {
@@ -336,8 +512,12 @@ func (b *BaseBlockchain) BuildSendETHTx(opts TxOpts, to ethereum.Address) (*type
 }
 
 func (b *BaseBlockchain) TransactionByHash(ctx context.Context, hash ethereum.Hash) (tx *RPCTransaction, isPending bool, err error) {
+	return transactionByHash(ctx, b.rpcClient, hash)
+}
+
+func transactionByHash(ctx context.Context, rpcClient *rpc.Client, hash ethereum.Hash) (tx *RPCTransaction, isPending bool, err error) {
 	var json *RPCTransaction
-	err = b.rpcClient.CallContext(ctx, &json, "eth_getTransactionByHash", hash)
+	err = rpcClient.CallContext(ctx, &json, "eth_getTransactionByHash", hash)
 	if err != nil {
 		return nil, false, err
 	}
@@ -354,15 +534,34 @@ func (b *BaseBlockchain) TransactionByHash(ctx context.Context, hash ethereum.Ha
 	return json, json.BlockNumber().Cmp(ethereum.Big0) == 0, nil
 }
 
-// TxStatus check status of tx
+// TxStatus check status of tx, asking the next healthiest node on networking issues
 func (b *BaseBlockchain) TxStatus(hash ethereum.Hash) (string, uint64, error) {
+	var (
+		status string
+		block  uint64
+		err    error
+	)
+	for _, node := range b.rpcNodes() {
+		start := time.Now()
+		status, block, err = b.txStatus(node, hash)
+		b.recordNode(node.URL, time.Since(start), err)
+		if err == nil {
+			return status, block, nil
+		}
+		b.l.Infow("FALLBACK: failed to get tx status, trying next node", "node", node.URL, "hash", hash, "err", err)
+	}
+	return status, block, err
+}
+
+func (b *BaseBlockchain) txStatus(node *common.EthClient, hash ethereum.Hash) (string, uint64, error) {
 	var (
 		logger = b.l.With(
 			"hash", hash,
+			"node", node.URL,
 		)
 	)
 	option := context.Background()
-	tx, pending, err := b.TransactionByHash(option, hash)
+	tx, pending, err := transactionByHash(option, node.RPCClient, hash)
 	if err != nil {
 		if err == ether.NotFound {
 			// tx doesn't exist. it failed
@@ -376,7 +575,7 @@ func (b *BaseBlockchain) TxStatus(hash ethereum.Hash) (string, uint64, error) {
 		return common.MiningStatusPending, 0, nil
 	}
 	var receipt *types.Receipt
-	receipt, err = b.client.TransactionReceipt(option, hash)
+	receipt, err = node.TransactionReceipt(option, hash)
 	if err != nil {
 		if err == ether.NotFound {
 			logger.Warnw("tx is lost", "error", err)
@@ -430,6 +629,7 @@ func NewBaseBlockchain(
 		client:         client,
 		rpcClient:      rpcClient,
 		operators:      operators,
+		pausedOps:      map[string]bool{},
 		broadcaster:    broadcaster,
 		erc20abi:       packabi,
 		contractCaller: contractcaller,
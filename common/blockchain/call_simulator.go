@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// callSimulatorCode is the runtime code of the CallSimulator contract below, compiled with solc
+// 0.8.21 --optimize --evm-version istanbul --metadata-hash none. It runs calls in order from its
+// own address and returns their results, or reverts with the revert data of the first failed call.
+//
+//	contract CallSimulator {
+//	    function run(address[] calldata targets, bytes[] calldata data) external returns (bytes[] memory results) {
+//	        require(targets.length == data.length);
+//	        results = new bytes[](data.length);
+//	        for (uint256 i = 0; i < data.length; i++) {
+//	            (bool ok, bytes memory result) = targets[i].call(data[i]);
+//	            if (!ok) {
+//	                assembly {
+//	                    revert(add(result, 32), mload(result))
+//	                }
+//	            }
+//	            results[i] = result;
+//	        }
+//	    }
+//	}
+var callSimulatorCode = hexutil.MustDecode("0x" +
+	"608060405234801561001057600080fd5b506004361061002b5760003560e01c80634d618e3b14610030575b600080fd" +
+	"5b61004361003e366004610202565b610059565b604051610050919061026e565b60405180910390f35b606083821461" +
+	"006757600080fd5b8167ffffffffffffffff81111561008057610080610300565b604051908082528060200260200182" +
+	"0160405280156100b357816020015b606081526020019060019003908161009e5790505b50905060005b828110156101" +
+	"ad576000808787848181106100d6576100d6610316565b90506020020160208101906100eb919061032c565b60016001" +
+	"60a01b031686868581811061010657610106610316565b9050602002810190610118919061035c565b60405161012692" +
+	"91906103a3565b6000604051808303816000865af19150503d8060008114610163576040519150601f19603f3d011682" +
+	"016040523d82523d6000602084013e610168565b606091505b50915091508161017a57805160208201fd5b8084848151" +
+	"811061018d5761018d610316565b6020026020010181905250505080806101a5906103b3565b9150506100b9565b5094" +
+	"9350505050565b60008083601f8401126101c857600080fd5b50813567ffffffffffffffff8111156101e057600080fd" +
+	"5b6020830191508360208260051b85010111156101fb57600080fd5b9250929050565b60008060008060408587031215" +
+	"61021857600080fd5b843567ffffffffffffffff8082111561023057600080fd5b61023c888389016101b6565b909650" +
+	"9450602087013591508082111561025557600080fd5b50610262878288016101b6565b95989497509550505050565b60" +
+	"00602080830181845280855180835260408601915060408160051b87010192508387016000805b838110156102f25788" +
+	"8603603f1901855282518051808852835b818110156102cb578281018a01518982018b015289016102b0565b50878101" +
+	"8901849052601f01601f1916909601870195509386019391860191600101610296565b50939897505050505050505056" +
+	"5b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b600060" +
+	"20828403121561033e57600080fd5b81356001600160a01b038116811461035557600080fd5b9392505050565b600080" +
+	"8335601e1984360301811261037357600080fd5b83018035915067ffffffffffffffff82111561038e57600080fd5b60" +
+	"20019150368190038213156101fb57600080fd5b8183823760009101908152919050565b6000600182016103d357634e" +
+	"487b7160e01b600052601160045260246000fd5b506001019056fea164736f6c6343000815000a")
+
+const callSimulatorABI = `[{"inputs":[{"name":"targets","type":"address[]"},{"name":"data","type":"bytes[]"}],"name":"run","outputs":[{"name":"results","type":"bytes[]"}],"stateMutability":"nonpayable","type":"function"}]`
+
+// SimulateCalls runs the calls of data to targets in order against the pending block as if they
+// were sent by operator op, the calls see the state changes of the calls before them. It puts the
+// code of a call simulator at the address of op with an eth_call state override, so it requires a
+// node supporting state overrides. It returns the result of every call or the error of the first
+// reverted call.
+func (b *BaseBlockchain) SimulateCalls(op string, targets []ethereum.Address, data [][]byte) ([][]byte, error) {
+	simulator, err := abi.JSON(bytes.NewBufferString(callSimulatorABI))
+	if err != nil {
+		return nil, err
+	}
+	input, err := simulator.Pack("run", targets, data)
+	if err != nil {
+		return nil, err
+	}
+	addr := b.MustGetOperator(op).Address
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	var output hexutil.Bytes
+	err = b.rpcClient.CallContext(ctx, &output, "eth_call",
+		map[string]interface{}{"from": addr, "to": addr, "data": hexutil.Bytes(input)},
+		"pending",
+		map[ethereum.Address]interface{}{addr: map[string]interface{}{"code": hexutil.Bytes(callSimulatorCode)}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	var results [][]byte
+	if err := simulator.Unpack(&results, "run", output); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
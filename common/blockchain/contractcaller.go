@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	ether "github.com/ethereum/go-ethereum"
@@ -13,29 +14,40 @@ import (
 )
 
 type ContractCaller struct {
-	clients []*common.EthClient
-	l       *zap.SugaredLogger
+	pool *NodePool
+	l    *zap.SugaredLogger
 }
 
-func NewContractCaller(clients []*common.EthClient) *ContractCaller {
+// NewContractCaller creates a contract caller calling the nodes of pool, healthiest first.
+func NewContractCaller(pool *NodePool) *ContractCaller {
 	return &ContractCaller{
-		clients: clients,
-		l:       zap.S(),
+		pool: pool,
+		l:    zap.S(),
 	}
 }
 
+// Pool returns the node pool of the caller.
+func (c ContractCaller) Pool() *NodePool {
+	return c.pool
+}
+
+func (c ContractCaller) callNode(client *common.EthClient, msg ether.CallMsg, blockNo *big.Int, timeOut time.Duration) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeOut)
+	defer cancel()
+	start := time.Now()
+	output, err := client.CallContract(ctx, msg, blockNo)
+	c.pool.Record(client.URL, time.Since(start), err)
+	return output, err
+}
+
 func (c ContractCaller) CallContract(msg ether.CallMsg, blockNo *big.Int, timeOut time.Duration) ([]byte, error) {
 	type errInfo struct {
 		URL string
 		Err error
 	}
 	var errs []errInfo
-	for _, client := range c.clients {
-		output, err := func() ([]byte, error) {
-			ctx, cancel := context.WithTimeout(context.Background(), timeOut)
-			defer cancel()
-			return client.CallContract(ctx, msg, blockNo)
-		}()
+	for _, client := range c.pool.Nodes() {
+		output, err := c.callNode(client, msg, blockNo, timeOut)
 		if err != nil {
 			c.l.Infof("FALLBACK: Ether client %s done, getting err %v, trying next one...", client.URL, err)
 			errs = append(errs, errInfo{
@@ -48,3 +60,51 @@ func (c ContractCaller) CallContract(msg ether.CallMsg, blockNo *big.Int, timeOu
 	}
 	return nil, fmt.Errorf("failed to call contract, all clients failed: %v", errs)
 }
+
+// QuorumCallContract calls all nodes and returns the output once at least quorum nodes return
+// the same one. Nodes returning a different output are recorded as quorum mismatches.
+func (c ContractCaller) QuorumCallContract(msg ether.CallMsg, blockNo *big.Int, timeOut time.Duration, quorum int) ([]byte, error) {
+	nodes := c.pool.Nodes()
+	if quorum > len(nodes) {
+		return nil, fmt.Errorf("quorum %d is larger than number of nodes %d", quorum, len(nodes))
+	}
+	outputs := make([][]byte, len(nodes))
+	errs := make([]error, len(nodes))
+	wg := sync.WaitGroup{}
+	for i, client := range nodes {
+		wg.Add(1)
+		go func(i int, client *common.EthClient) {
+			defer wg.Done()
+			outputs[i], errs[i] = c.callNode(client, msg, blockNo, timeOut)
+		}(i, client)
+	}
+	wg.Wait()
+
+	votes := map[string]int{}
+	var (
+		best      string
+		failures  []string
+		bestVotes int
+	)
+	for i, output := range outputs {
+		if errs[i] != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", nodes[i].URL, errs[i]))
+			continue
+		}
+		votes[string(output)]++
+		if votes[string(output)] > bestVotes {
+			best, bestVotes = string(output), votes[string(output)]
+		}
+	}
+	for i, output := range outputs {
+		if errs[i] == nil && string(output) != best {
+			c.l.Warnw("node result differs from quorum", "node", nodes[i].URL, "block", blockNo)
+			c.pool.RecordQuorumMismatch(nodes[i].URL)
+		}
+	}
+	if bestVotes < quorum {
+		return nil, fmt.Errorf("no quorum of %d nodes, best result has %d votes of %d distinct results, failures: %v",
+			quorum, bestVotes, len(votes), failures)
+	}
+	return []byte(best), nil
+}
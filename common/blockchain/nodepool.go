@@ -0,0 +1,216 @@
+package blockchain
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+const (
+	// DefaultMaxBlockLag is the number of blocks a node may be behind the best node and still be healthy.
+	DefaultMaxBlockLag = 5
+
+	// healthSmoothing is the weight of the latest sample in the moving averages of latency and error rate.
+	healthSmoothing = 0.2
+	// maxHealthyErrorRate is the error rate from which a node is unhealthy.
+	maxHealthyErrorRate = 0.5
+	healthCheckTimeout  = 5 * time.Second
+)
+
+// NodeHealth is the health of an Ethereum node.
+type NodeHealth struct {
+	URL              string    `json:"url"`
+	Block            uint64    `json:"block"`
+	BlockLag         uint64    `json:"block_lag"`
+	LatencyMs        float64   `json:"latency_ms"`
+	ErrorRate        float64   `json:"error_rate"`
+	Requests         uint64    `json:"requests"`
+	Errors           uint64    `json:"errors"`
+	QuorumMismatches uint64    `json:"quorum_mismatches"`
+	Score            float64   `json:"score"`
+	Healthy          bool      `json:"healthy"`
+	LastError        string    `json:"last_error,omitempty"`
+	CheckedAt        time.Time `json:"checked_at"`
+}
+
+type node struct {
+	client *common.EthClient
+	health NodeHealth
+}
+
+// NodePool tracks block lag, latency and error rate of Ethereum nodes and orders them by health.
+// Nodes are healthy until their first check.
+type NodePool struct {
+	mu          sync.RWMutex
+	nodes       []*node
+	maxBlockLag uint64
+	l           *zap.SugaredLogger
+}
+
+// NewNodePool creates a pool of clients, the first client is preferred when nodes are equally healthy.
+func NewNodePool(clients []*common.EthClient, maxBlockLag uint64) *NodePool {
+	nodes := make([]*node, 0, len(clients))
+	for _, c := range clients {
+		nodes = append(nodes, &node{client: c, health: NodeHealth{URL: c.URL, Healthy: true}})
+	}
+	p := &NodePool{nodes: nodes, maxBlockLag: maxBlockLag, l: zap.S()}
+	p.mu.Lock()
+	p.updateScores()
+	p.mu.Unlock()
+	return p
+}
+
+// Run checks the health of nodes every interval, it never returns.
+func (p *NodePool) Run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.Check()
+		<-ticker.C
+	}
+}
+
+// Check queries the latest block of every node and updates their health.
+func (p *NodePool) Check() {
+	type result struct {
+		block   uint64
+		latency time.Duration
+		err     error
+	}
+	p.mu.RLock()
+	nodes := p.nodes
+	p.mu.RUnlock()
+	results := make([]result, len(nodes))
+	wg := sync.WaitGroup{}
+	for i, n := range nodes {
+		wg.Add(1)
+		go func(i int, client *common.EthClient) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+			defer cancel()
+			start := time.Now()
+			header, err := client.HeaderByNumber(ctx, nil)
+			results[i].latency = time.Since(start)
+			if err != nil {
+				results[i].err = err
+				return
+			}
+			results[i].block = header.Number.Uint64()
+		}(i, n.client)
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	for i, n := range nodes {
+		p.record(n, results[i].latency, results[i].err)
+		if results[i].err == nil {
+			n.health.Block = results[i].block
+		}
+		n.health.CheckedAt = now
+	}
+	p.updateScores()
+}
+
+// Nodes returns the clients ordered by health, healthiest first. Unhealthy nodes are kept at the
+// end so callers can still fall back to them.
+func (p *NodePool) Nodes() []*common.EthClient {
+	nodes := p.sorted()
+	result := make([]*common.EthClient, 0, len(nodes))
+	for _, n := range nodes {
+		result = append(result, n.client)
+	}
+	return result
+}
+
+// Record updates the health of node url with the result of a request.
+func (p *NodePool) Record(url string, latency time.Duration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, n := range p.nodes {
+		if n.client.URL == url {
+			p.record(n, latency, err)
+		}
+	}
+	p.updateScores()
+}
+
+// RecordQuorumMismatch counts a read of node url that disagreed with the quorum.
+func (p *NodePool) RecordQuorumMismatch(url string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, n := range p.nodes {
+		if n.client.URL == url {
+			n.health.QuorumMismatches++
+		}
+	}
+}
+
+// Health returns the health of all nodes, healthiest first.
+func (p *NodePool) Health() []NodeHealth {
+	nodes := p.sorted()
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	result := make([]NodeHealth, 0, len(nodes))
+	for _, n := range nodes {
+		result = append(result, n.health)
+	}
+	return result
+}
+
+// sorted returns the nodes ordered by health, nodes with the same health keep configured order.
+func (p *NodePool) sorted() []*node {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	nodes := make([]*node, len(p.nodes))
+	copy(nodes, p.nodes)
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].health.Healthy != nodes[j].health.Healthy {
+			return nodes[i].health.Healthy
+		}
+		return nodes[i].health.Score > nodes[j].health.Score
+	})
+	return nodes
+}
+
+func (p *NodePool) record(n *node, latency time.Duration, err error) {
+	failed := 0.0
+	n.health.Requests++
+	if err != nil {
+		failed = 1
+		n.health.Errors++
+		n.health.LastError = err.Error()
+	} else {
+		ms := float64(latency) / float64(time.Millisecond)
+		if n.health.LatencyMs == 0 {
+			n.health.LatencyMs = ms
+		} else {
+			n.health.LatencyMs += healthSmoothing * (ms - n.health.LatencyMs)
+		}
+	}
+	n.health.ErrorRate += healthSmoothing * (failed - n.health.ErrorRate)
+}
+
+// updateScores computes block lag, score and health of nodes, caller must hold the write lock.
+// A node loses 10 points per block of lag, 50 points at 100% error rate and 1 point per 100ms latency.
+func (p *NodePool) updateScores() {
+	var best uint64
+	for _, n := range p.nodes {
+		if n.health.Block > best {
+			best = n.health.Block
+		}
+	}
+	for _, n := range p.nodes {
+		n.health.BlockLag = best - n.health.Block
+		n.health.Score = math.Max(0,
+			100-10*float64(n.health.BlockLag)-50*n.health.ErrorRate-n.health.LatencyMs/100)
+		n.health.Healthy = n.health.BlockLag <= p.maxBlockLag && n.health.ErrorRate < maxHealthyErrorRate
+	}
+}
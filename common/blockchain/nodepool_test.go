@@ -0,0 +1,122 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ether "github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// newTestNode starts a JSON-RPC server answering eth_call with result and eth_getBlockByNumber with block.
+// The caller must close the returned server.
+func newTestNode(t *testing.T, result string, block uint64) (*common.EthClient, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		var res interface{}
+		switch req.Method {
+		case "eth_call":
+			res = result
+		case "eth_getBlockByNumber":
+			res = map[string]interface{}{
+				"number":           fmt.Sprintf("0x%x", block),
+				"parentHash":       "0x0000000000000000000000000000000000000000000000000000000000000000",
+				"sha3Uncles":       "0x0000000000000000000000000000000000000000000000000000000000000000",
+				"miner":            "0x0000000000000000000000000000000000000000",
+				"stateRoot":        "0x0000000000000000000000000000000000000000000000000000000000000000",
+				"transactionsRoot": "0x0000000000000000000000000000000000000000000000000000000000000000",
+				"receiptsRoot":     "0x0000000000000000000000000000000000000000000000000000000000000000",
+				"logsBloom":        "0x" + fmt.Sprintf("%0512x", 0),
+				"difficulty":       "0x0",
+				"gasLimit":         "0x0",
+				"gasUsed":          "0x0",
+				"timestamp":        "0x0",
+				"extraData":        "0x",
+				"mixHash":          "0x0000000000000000000000000000000000000000000000000000000000000000",
+				"nonce":            "0x0000000000000000",
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0", "id": req.ID, "result": res,
+		}))
+	}))
+	client, err := common.NewEthClient(server.URL)
+	require.NoError(t, err)
+	return client, server
+}
+
+func TestNodePool_Order(t *testing.T) {
+	a := &common.EthClient{URL: "a"}
+	b := &common.EthClient{URL: "b"}
+	c := &common.EthClient{URL: "c"}
+	pool := NewNodePool([]*common.EthClient{a, b, c}, DefaultMaxBlockLag)
+	require.Equal(t, []*common.EthClient{a, b, c}, pool.Nodes())
+
+	// a fails every request and becomes unhealthy, b is slower than c
+	for i := 0; i < 5; i++ {
+		pool.Record("a", time.Millisecond, errors.New("timeout"))
+		pool.Record("b", 500*time.Millisecond, nil)
+		pool.Record("c", 50*time.Millisecond, nil)
+	}
+	require.Equal(t, []*common.EthClient{c, b, a}, pool.Nodes())
+
+	health := pool.Health()
+	require.Equal(t, "a", health[2].URL)
+	require.False(t, health[2].Healthy)
+	require.Equal(t, uint64(5), health[2].Errors)
+	require.Equal(t, "timeout", health[2].LastError)
+	require.True(t, health[0].Healthy)
+}
+
+func TestNodePool_Check(t *testing.T) {
+	ahead, aheadServer := newTestNode(t, "0x", 110)
+	defer aheadServer.Close()
+	behind, behindServer := newTestNode(t, "0x", 100)
+	defer behindServer.Close()
+	pool := NewNodePool([]*common.EthClient{behind, ahead}, DefaultMaxBlockLag)
+	pool.Check()
+
+	health := pool.Health()
+	require.Equal(t, ahead.URL, health[0].URL)
+	require.Equal(t, uint64(110), health[0].Block)
+	require.True(t, health[0].Healthy)
+	require.Equal(t, behind.URL, health[1].URL)
+	require.Equal(t, uint64(10), health[1].BlockLag)
+	require.False(t, health[1].Healthy)
+}
+
+func TestContractCaller_QuorumCallContract(t *testing.T) {
+	var nodes []*common.EthClient
+	for _, result := range []string{"0x01", "0x02", "0x01"} {
+		node, server := newTestNode(t, result, 1)
+		defer server.Close()
+		nodes = append(nodes, node)
+	}
+	caller := NewContractCaller(NewNodePool(nodes, DefaultMaxBlockLag))
+
+	output, err := caller.QuorumCallContract(ether.CallMsg{}, nil, time.Second, 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, output)
+	for _, h := range caller.Pool().Health() {
+		if h.URL == nodes[1].URL {
+			require.Equal(t, uint64(1), h.QuorumMismatches)
+		} else {
+			require.Zero(t, h.QuorumMismatches)
+		}
+	}
+
+	_, err = caller.QuorumCallContract(ether.CallMsg{}, nil, time.Second, 3)
+	require.Error(t, err)
+}
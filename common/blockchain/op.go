@@ -1,12 +1,49 @@
 package blockchain
 
+import (
+	"fmt"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
 const (
 	// PricingOP the account using for pricing
 	PricingOP = "pricingOP"
 	// DepositOP the account using for deposit to exchange
 	DepositOP = "depositOP"
+	// PricingAdminOP the account using for step functions and control info of pricing contract
+	PricingAdminOP = "pricingAdminOP"
+	// ReserveAdminOP the account using for admin operations of reserve contract
+	ReserveAdminOP = "reserveAdminOP"
+	// FundingOP the account using for topping up ETH of other operators
+	FundingOP = "fundingOP"
 )
 
+// PricingStandbyOP returns the name of the i-th standby pricing operator, starting from 1. Set
+// rate fails over to standby operators when the txs of the pricing operator are stuck.
+func PricingStandbyOP(i int) string {
+	return fmt.Sprintf("%s_%d", PricingOP, i)
+}
+
+// ActivityOperator returns the operator which sent the tx of an activity. Activities recorded
+// without their operator were sent by the only operator of their action at that time.
+func ActivityOperator(activity common.ActivityRecord) string {
+	if activity.Result != nil && activity.Result.Operator != "" {
+		return activity.Result.Operator
+	}
+	switch activity.Action {
+	case common.ActionSetRate, common.ActionCancelSetRate:
+		return PricingOP
+	case common.ActionDeposit:
+		return DepositOP
+	case common.ActionReserveAdmin:
+		return ReserveAdminOP
+	case common.ActionPricingContract:
+		return PricingAdminOP
+	}
+	return ""
+}
+
 // MinedNoncePicker just an interface container shared function of core/blockchain and fetcher/blockchain interface
 type MinedNoncePicker interface {
 	GetMinedNonceWithOP(op string) (uint64, error)
@@ -81,3 +81,8 @@ func (s *senderFromServer) SignatureValues(tx *types.Transaction, sig []byte) (_
 	// This should never happen
 	panic("can't sign with senderFromServer")
 }
+
+// Data returns the input data of the tx.
+func (tx *RPCTransaction) Data() []byte {
+	return tx.tx.Data()
+}
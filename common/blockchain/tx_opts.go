@@ -14,5 +14,6 @@ type TxOpts struct {
 }
 
 type CallOpts struct {
-	Block *big.Int // Block number that the call is invoked at. Nil means calling in pending state
+	Block  *big.Int // Block number that the call is invoked at. Nil means calling in pending state
+	Quorum int      // Number of nodes that must return the same result (0 or 1 = first successful node)
 }
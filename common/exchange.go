@@ -10,16 +10,18 @@ import (
 	"github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
 
-// ValidExchangeNames returns all valid exchange names.
+// ValidExchangeNames returns names of built-in exchange accounts, other accounts are
+// defined in setting database.
 var ValidExchangeNames = map[string]rtypes.ExchangeID{
-	rtypes.Binance.String():  rtypes.Binance,
-	rtypes.Huobi.String():    rtypes.Huobi,
-	rtypes.Binance2.String(): rtypes.Binance2,
+	rtypes.Binance.String(): rtypes.Binance,
+	rtypes.Huobi.String():   rtypes.Huobi,
 }
 
 // Exchange represents a centralized exchange like Binance, Huobi...
 type Exchange interface {
 	ID() rtypes.ExchangeID
+	// Name returns the name of the exchange account in setting database.
+	Name() string
 	// Address return the deposit address of an asset and return true
 	// if token is supported in the exchange, otherwise return false.
 	// This function will prioritize live address from exchange above the current stored address.
@@ -39,6 +41,13 @@ type Exchange interface {
 	Transfer(fromAccount string, toAccount string, asset common.Asset, amount *big.Int) (string, error)
 }
 
+// AccountExchange is an exchange account which can transfer fund to other accounts on
+// the same venue by their account ids.
+type AccountExchange interface {
+	Exchange
+	AccountID() string
+}
+
 // LiveExchange interface
 // TODO: choose a better name as this interface for activity which does not affect
 //
@@ -55,16 +64,10 @@ var SupportedExchanges = map[rtypes.ExchangeID]Exchange{}
 
 // GetExchange return exchange by its name
 func GetExchange(name string) (Exchange, error) {
-	var (
-		ex Exchange
-	)
-	exchangeID, exist := ValidExchangeNames[name]
-	if !exist {
-		return ex, fmt.Errorf("exchange %s does not exist", name)
-	}
-	ex = SupportedExchanges[exchangeID]
-	if ex == nil {
-		return ex, fmt.Errorf("exchange %s is not supported", name)
+	for _, ex := range SupportedExchanges {
+		if ex.Name() == name {
+			return ex, nil
+		}
 	}
-	return ex, nil
+	return nil, fmt.Errorf("exchange %s is not supported", name)
 }
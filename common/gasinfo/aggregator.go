@@ -0,0 +1,251 @@
+package gasinfo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data"
+)
+
+// Strategies to choose a gas price from accepted quotes.
+const (
+	// StrategyMedian chooses the median of accepted quotes.
+	StrategyMedian = "median"
+	// StrategyPercentile chooses the configured percentile of accepted quotes.
+	StrategyPercentile = "percentile"
+	// StrategyPreferred chooses the quote of the preferred gas source, or the median of accepted quotes
+	// if the preferred source is missing, stale or an outlier.
+	StrategyPreferred = "preferred"
+)
+
+// Statuses of quotes in a decision.
+const (
+	QuoteAccepted = "accepted"
+	QuoteStale    = "stale"
+	QuoteOutlier  = "outlier"
+	QuoteInvalid  = "invalid"
+)
+
+const (
+	defaultMaxAge          = 600 * time.Second
+	defaultPreferredSource = "ethgasstation"
+	// minQuotesForOutliers is the least number of fresh quotes to detect outliers.
+	minQuotesForOutliers = 3
+)
+
+// ErrNoQuote is returned if no source reports an accepted gas price.
+var ErrNoQuote = errors.New("no accepted gas price quote")
+
+// AggregatorConfig is the configuration of an Aggregator.
+type AggregatorConfig struct {
+	Strategy string
+	// Percentile of accepted quotes chosen by percentile strategy, in [0, 100].
+	Percentile float64
+	// OutlierRatio is the max relative deviation of a quote from the median of fresh quotes, 0 keeps all quotes.
+	OutlierRatio float64
+	// MaxAge is the max age of a quote, quotes are not too old if it is 0.
+	MaxAge time.Duration
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c AggregatorConfig) Validate() error {
+	switch c.Strategy {
+	case StrategyMedian, StrategyPreferred:
+	case StrategyPercentile:
+		if c.Percentile < 0 || c.Percentile > 100 {
+			return fmt.Errorf("invalid gas price percentile %f", c.Percentile)
+		}
+	default:
+		return fmt.Errorf("invalid gas price strategy %s", c.Strategy)
+	}
+	if c.OutlierRatio < 0 {
+		return fmt.Errorf("invalid gas price outlier ratio %f", c.OutlierRatio)
+	}
+	return nil
+}
+
+// QuoteResult is a quote with its status in a decision.
+type QuoteResult struct {
+	Quote
+	Status string `json:"status"`
+}
+
+// Decision is a gas price chosen by an aggregator and why it was chosen.
+type Decision struct {
+	Value    float64 `json:"value"`
+	Strategy string  `json:"strategy"`
+	// Source is the source of value if it was taken from a single quote.
+	Source string        `json:"source,omitempty"`
+	Reason string        `json:"reason"`
+	Quotes []QuoteResult `json:"quotes"`
+	Errors []string      `json:"errors,omitempty"`
+	Time   time.Time     `json:"time"`
+}
+
+// Aggregator queries gas prices from several sources, drops stale quotes and outliers and chooses
+// a gas price from the remaining quotes by a strategy.
+type Aggregator struct {
+	config    AggregatorConfig
+	sources   []Source
+	gasConfig reserve.GasConfig
+	l         *zap.SugaredLogger
+}
+
+// NewAggregator creates a new Aggregator, gasConfig provides the preferred source of preferred strategy.
+func NewAggregator(config AggregatorConfig, gasConfig reserve.GasConfig, sources ...Source) *Aggregator {
+	return &Aggregator{
+		config:    config,
+		sources:   sources,
+		gasConfig: gasConfig,
+		l:         zap.S(),
+	}
+}
+
+func (a *Aggregator) quotes() ([]Quote, []string) {
+	var (
+		wg      sync.WaitGroup
+		results = make([][]Quote, len(a.sources))
+		errs    = make([]error, len(a.sources))
+	)
+	for i, source := range a.sources {
+		wg.Add(1)
+		go func(i int, source Source) {
+			defer wg.Done()
+			results[i], errs[i] = source.Quotes()
+		}(i, source)
+	}
+	wg.Wait()
+	var (
+		quotes    []Quote
+		errorMsgs []string
+	)
+	for i := range a.sources {
+		if errs[i] != nil {
+			errorMsgs = append(errorMsgs, errs[i].Error())
+			continue
+		}
+		quotes = append(quotes, results[i]...)
+	}
+	sort.Slice(quotes, func(i, j int) bool { return quotes[i].Source < quotes[j].Source })
+	return quotes, errorMsgs
+}
+
+// percentile returns the p-th percentile of sorted values by linear interpolation.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	if lower >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[lower] + (rank-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
+
+func sortedValues(quotes []QuoteResult, status string) []float64 {
+	var values []float64
+	for _, q := range quotes {
+		if q.Status == status {
+			values = append(values, q.Value)
+		}
+	}
+	sort.Float64s(values)
+	return values
+}
+
+func (a *Aggregator) classify(quotes []Quote, now time.Time) []QuoteResult {
+	results := make([]QuoteResult, 0, len(quotes))
+	for _, q := range quotes {
+		status := QuoteAccepted
+		switch {
+		case q.Value <= 0 || math.IsNaN(q.Value) || math.IsInf(q.Value, 0):
+			status = QuoteInvalid
+		case a.config.MaxAge > 0 && now.Sub(q.Timestamp) > a.config.MaxAge:
+			status = QuoteStale
+		}
+		results = append(results, QuoteResult{Quote: q, Status: status})
+	}
+	fresh := sortedValues(results, QuoteAccepted)
+	if a.config.OutlierRatio == 0 || len(fresh) < minQuotesForOutliers {
+		return results
+	}
+	median := percentile(fresh, 50)
+	for i := range results {
+		if results[i].Status == QuoteAccepted && math.Abs(results[i].Value-median)/median > a.config.OutlierRatio {
+			results[i].Status = QuoteOutlier
+		}
+	}
+	return results
+}
+
+func (a *Aggregator) preferredSource() string {
+	if a.gasConfig == nil {
+		return defaultPreferredSource
+	}
+	preferred, err := a.gasConfig.GetPreferGasSource()
+	if err != nil {
+		a.l.Errorw("failed to receive preferred gas source, use default", "default", defaultPreferredSource, "err", err)
+		return defaultPreferredSource
+	}
+	return preferred.Name
+}
+
+// GasPrice queries all sources and returns the chosen gas price in gwei with the reason.
+func (a *Aggregator) GasPrice() (Decision, error) {
+	quotes, errs := a.quotes()
+	d := Decision{
+		Strategy: a.config.Strategy,
+		Quotes:   a.classify(quotes, time.Now()),
+		Errors:   errs,
+		Time:     time.Now(),
+	}
+	accepted := sortedValues(d.Quotes, QuoteAccepted)
+	if len(accepted) == 0 {
+		d.Reason = "no accepted quote"
+		return d, ErrNoQuote
+	}
+	switch a.config.Strategy {
+	case StrategyMedian:
+		d.Value = percentile(accepted, 50)
+		d.Reason = fmt.Sprintf("median of %d accepted quotes", len(accepted))
+	case StrategyPercentile:
+		d.Value = percentile(accepted, a.config.Percentile)
+		d.Reason = fmt.Sprintf("percentile %g of %d accepted quotes", a.config.Percentile, len(accepted))
+	case StrategyPreferred:
+		preferred := a.preferredSource()
+		status := "missing"
+		for _, q := range d.Quotes {
+			if q.Source == preferred {
+				status = q.Status
+				if q.Status == QuoteAccepted {
+					d.Value = q.Value
+					d.Source = preferred
+					d.Reason = fmt.Sprintf("preferred source %s", preferred)
+				}
+				break
+			}
+		}
+		if d.Source == "" {
+			d.Value = percentile(accepted, 50)
+			d.Reason = fmt.Sprintf("preferred source %s is %s, fallback to median of %d accepted quotes",
+				preferred, status, len(accepted))
+		}
+	default:
+		return d, fmt.Errorf("invalid gas price strategy %s", a.config.Strategy)
+	}
+	if len(accepted) == 1 && d.Source == "" {
+		for _, q := range d.Quotes {
+			if q.Status == QuoteAccepted {
+				d.Source = q.Source
+			}
+		}
+	}
+	return d, nil
+}
@@ -0,0 +1,129 @@
+package gasinfo
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gaspricedata "github.com/KyberNetwork/reserve-data/common/gaspricedata-client"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type staticSource []Quote
+
+func (s staticSource) Quotes() ([]Quote, error) {
+	return s, nil
+}
+
+type failingSource struct{}
+
+func (failingSource) Quotes() ([]Quote, error) {
+	return nil, errors.New("source is down")
+}
+
+type preferredSource string
+
+func (p preferredSource) SetPreferGasSource(v3.PreferGasSource) error {
+	return nil
+}
+
+func (p preferredSource) GetPreferGasSource() (v3.PreferGasSource, error) {
+	return v3.PreferGasSource{Name: string(p)}, nil
+}
+
+func TestAggregator(t *testing.T) {
+	now := time.Now()
+	quotes := staticSource{
+		{Source: "a", Value: 100, Timestamp: now},
+		{Source: "b", Value: 110, Timestamp: now},
+		{Source: "c", Value: 120, Timestamp: now},
+		{Source: "d", Value: 400, Timestamp: now},
+		{Source: "e", Value: 105, Timestamp: now.Add(-time.Hour)},
+	}
+	config := AggregatorConfig{Strategy: StrategyMedian, OutlierRatio: 0.5, MaxAge: 10 * time.Minute}
+
+	d, err := NewAggregator(config, nil, quotes, failingSource{}).GasPrice()
+	require.NoError(t, err)
+	assert.Equal(t, 110.0, d.Value)
+	assert.Equal(t, []string{"source is down"}, d.Errors)
+	statuses := map[string]string{}
+	for _, q := range d.Quotes {
+		statuses[q.Source] = q.Status
+	}
+	assert.Equal(t, map[string]string{"a": QuoteAccepted, "b": QuoteAccepted, "c": QuoteAccepted,
+		"d": QuoteOutlier, "e": QuoteStale}, statuses)
+
+	config.Strategy = StrategyPercentile
+	config.Percentile = 75
+	d, err = NewAggregator(config, nil, quotes).GasPrice()
+	require.NoError(t, err)
+	assert.Equal(t, 115.0, d.Value)
+
+	config.Strategy = StrategyPreferred
+	d, err = NewAggregator(config, preferredSource("c"), quotes).GasPrice()
+	require.NoError(t, err)
+	assert.Equal(t, 120.0, d.Value)
+	assert.Equal(t, "c", d.Source)
+
+	d, err = NewAggregator(config, preferredSource("d"), quotes).GasPrice()
+	require.NoError(t, err)
+	assert.Equal(t, 110.0, d.Value)
+	assert.Equal(t, "preferred source d is outlier, fallback to median of 3 accepted quotes", d.Reason)
+
+	_, err = NewAggregator(config, preferredSource("a"), failingSource{}).GasPrice()
+	assert.Equal(t, ErrNoQuote, err)
+}
+
+func TestAggregatorConfigValidate(t *testing.T) {
+	assert.NoError(t, AggregatorConfig{Strategy: StrategyPreferred}.Validate())
+	assert.Error(t, AggregatorConfig{Strategy: "mean"}.Validate())
+	assert.Error(t, AggregatorConfig{Strategy: StrategyPercentile, Percentile: 101}.Validate())
+	assert.Error(t, AggregatorConfig{Strategy: StrategyMedian, OutlierRatio: -1}.Validate())
+}
+
+type staticGasClient gaspricedata.GasResult
+
+func (c staticGasClient) GetGas() (gaspricedata.GasResult, error) {
+	return gaspricedata.GasResult(c), nil
+}
+
+type staticNode struct {
+	feeHistoryErr error
+}
+
+func (n staticNode) RecommendedGasPriceFromNode() (*big.Int, error) {
+	return big.NewInt(90e9), nil
+}
+
+func (n staticNode) RecommendedGasPriceFromFeeHistory(int, float64) (*big.Int, error) {
+	return big.NewInt(95e9), n.feeHistoryErr
+}
+
+func TestGasPriceInfoDefault(t *testing.T) {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	client := staticGasClient{
+		"ethgasstation": {Value: gaspricedata.Data{Fast: 100}, Timestamp: now - 3600*1000},
+		"etherscan":     {Value: gaspricedata.Data{Fast: 110}, Timestamp: now},
+	}
+	info := NewGasPriceInfo(ConstGasPriceLimiter{}, preferredSource("etherscan"), client)
+	gas, err := info.GetCurrentGas()
+	require.NoError(t, err)
+	assert.Equal(t, 110.0, gas)
+
+	info = NewGasPriceInfo(ConstGasPriceLimiter{}, preferredSource("ethgasstation"), client)
+	info.SetAggregator(NewAggregator(AggregatorConfig{Strategy: StrategyPreferred, MaxAge: defaultMaxAge},
+		preferredSource("ethgasstation"), NewGasPriceDataSource(client), NewNodeSource(staticNode{})))
+	d, err := info.GasPriceDecision()
+	require.NoError(t, err)
+	assert.Equal(t, "preferred source ethgasstation is stale, fallback to median of 3 accepted quotes", d.Reason)
+	assert.Equal(t, 95.0, d.Value)
+
+	quotes, err := NewNodeSource(staticNode{feeHistoryErr: errors.New("method not found")}).Quotes()
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	assert.Equal(t, 90.0, quotes[0].Value)
+}
@@ -1,9 +1,7 @@
 package gasinfo
 
 import (
-	"fmt"
 	"sync"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -17,6 +15,8 @@ type GasPriceInfo struct {
 	storage   reserve.GasConfig
 	gasClient gaspricedata.Client
 	l         *zap.SugaredLogger
+
+	aggregator *Aggregator
 }
 
 var (
@@ -46,6 +46,8 @@ func NewGasPriceInfo(limiter GasPriceLimiter, store reserve.GasConfig, gasClient
 		storage:   store,
 		gasClient: gasClient,
 		l:         zap.S(),
+		aggregator: NewAggregator(AggregatorConfig{Strategy: StrategyPreferred, MaxAge: defaultMaxAge},
+			store, NewGasPriceDataSource(gasClient)),
 	}
 }
 
@@ -54,32 +56,26 @@ func (g *GasPriceInfo) MaxGas() (float64, error) {
 	return g.limiter.MaxGasPrice()
 }
 
-// GetCurrentGas return gas price from preferred source
+// SetAggregator replaces the aggregator choosing current gas price.
+func (g *GasPriceInfo) SetAggregator(aggregator *Aggregator) {
+	g.aggregator = aggregator
+}
+
+// GetCurrentGas return gas price in gwei chosen by the aggregator, by default it is the price of the
+// preferred source of the gas price data service with fallback to the median of other sources.
 func (g *GasPriceInfo) GetCurrentGas() (float64, error) {
-	selectedSource := "ethgasstation"
-	selected, err := g.storage.GetPreferGasSource()
-	if err == nil {
-		selectedSource = selected.Name
-	} else {
-		g.l.Errorw("failed to receive selected source, use default", "default", selectedSource)
-	}
-	allGas, err := g.gasClient.GetGas()
+	d, err := g.GasPriceDecision()
 	if err != nil {
+		g.l.Errorw("failed to get gas price", "reason", d.Reason, "errors", d.Errors, "err", err)
 		return 0, err
 	}
-	gas, ok := allGas[selectedSource]
-	if !ok {
-		g.l.Errorw("selected source not found in result", "source", selectedSource)
-		return 0, fmt.Errorf("selected gas source not found in result, source=%s", selectedSource)
-	}
-	g.l.Infow("got gas price", "source", selectedSource, "value", gas.Value)
-	gTime := time.Unix(gas.Timestamp/1000, 0)
-	gasUpdateSecondsRequire := 600.0
-	seconds := time.Since(gTime).Seconds()
-	if seconds > gasUpdateSecondsRequire {
-		return 0, fmt.Errorf("gas price need to be update, current_version %s which is %f seconds ago", gTime, seconds)
-	}
-	return gas.Value.Fast, nil
+	g.l.Infow("got gas price", "value", d.Value, "source", d.Source, "reason", d.Reason)
+	return d.Value, nil
+}
+
+// GasPriceDecision returns current gas price with the quotes of all sources and why it was chosen.
+func (g *GasPriceInfo) GasPriceDecision() (Decision, error) {
+	return g.aggregator.GasPrice()
 }
 
 // AllSourceGas return all supported source gas price
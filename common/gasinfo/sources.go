@@ -0,0 +1,105 @@
+package gasinfo
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	gaspricedata "github.com/KyberNetwork/reserve-data/common/gaspricedata-client"
+	"github.com/KyberNetwork/reserve-data/common/gasstation"
+)
+
+// Quote is a gas price in gwei reported by a source.
+type Quote struct {
+	Source    string    `json:"source"`
+	Value     float64   `json:"value"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Source reports gas prices, a source may report prices of several named sources.
+type Source interface {
+	Quotes() ([]Quote, error)
+}
+
+// GasPriceDataSource reports the fast gas price of all sources of the gas price data service.
+type GasPriceDataSource struct {
+	client gaspricedata.Client
+}
+
+// NewGasPriceDataSource creates a new GasPriceDataSource.
+func NewGasPriceDataSource(client gaspricedata.Client) *GasPriceDataSource {
+	return &GasPriceDataSource{client: client}
+}
+
+// Quotes implements Source.
+func (s *GasPriceDataSource) Quotes() ([]Quote, error) {
+	result, err := s.client.GetGas()
+	if err != nil {
+		return nil, err
+	}
+	quotes := make([]Quote, 0, len(result))
+	for name, data := range result {
+		quotes = append(quotes, Quote{
+			Source:    name,
+			Value:     data.Value.Fast,
+			Timestamp: common.MillisToTime(uint64(data.Timestamp)),
+		})
+	}
+	return quotes, nil
+}
+
+// GasStationSource reports the fast gas price of ethgasstation.
+type GasStationSource struct {
+	client *gasstation.Client
+}
+
+// NewGasStationSource creates a new GasStationSource.
+func NewGasStationSource(client *gasstation.Client) *GasStationSource {
+	return &GasStationSource{client: client}
+}
+
+// Quotes implements Source.
+func (s *GasStationSource) Quotes() ([]Quote, error) {
+	gas, err := s.client.ETHGas()
+	if err != nil {
+		return nil, err
+	}
+	// ethgasstation prices are in tenths of gwei.
+	return []Quote{{Source: "gasstation", Value: gas.Fast / 10, Timestamp: time.Now()}}, nil
+}
+
+// Node is an Ethereum node recommending gas prices.
+type Node interface {
+	RecommendedGasPriceFromNode() (*big.Int, error)
+	RecommendedGasPriceFromFeeHistory(blocks int, percentile float64) (*big.Int, error)
+}
+
+const (
+	feeHistoryBlocks     = 20
+	feeHistoryPercentile = 60
+)
+
+// NodeSource reports the gas price suggested by a node and the price estimated from its fee history,
+// the fee history price is skipped if the node does not support it.
+type NodeSource struct {
+	node Node
+}
+
+// NewNodeSource creates a new NodeSource.
+func NewNodeSource(node Node) *NodeSource {
+	return &NodeSource{node: node}
+}
+
+// Quotes implements Source.
+func (s *NodeSource) Quotes() ([]Quote, error) {
+	suggested, err := s.node.RecommendedGasPriceFromNode()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	quotes := []Quote{{Source: "node", Value: common.BigToFloat(suggested, 9), Timestamp: now}}
+	if feeHistory, err := s.node.RecommendedGasPriceFromFeeHistory(feeHistoryBlocks, feeHistoryPercentile); err == nil {
+		quotes = append(quotes, Quote{Source: "node_fee_history", Value: common.BigToFloat(feeHistory, 9), Timestamp: now})
+	}
+	return quotes, nil
+}
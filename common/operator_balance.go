@@ -0,0 +1,47 @@
+package common
+
+import (
+	ethereum "github.com/ethereum/go-ethereum/common"
+)
+
+// OperatorBalance is the ETH balance of an operator and how long it lasts at the gas burn rate
+// observed over the last day.
+type OperatorBalance struct {
+	Operator string           `json:"operator"`
+	Address  ethereum.Address `json:"address"`
+	// Balance is in ETH.
+	Balance float64 `json:"balance"`
+	// BurnPerDay is the ETH spent by the operator per day, 0 if no spending is observed yet.
+	BurnPerDay float64 `json:"burn_per_day"`
+	// HoursLeft is how long the balance lasts at BurnPerDay, 0 if BurnPerDay is 0.
+	HoursLeft float64 `json:"hours_left"`
+	// Threshold is the balance the operator is topped up below, 0 if it is not topped up.
+	Threshold float64 `json:"threshold"`
+	// Low is true if the balance is below Threshold or lasts less than the min hours left.
+	Low   bool   `json:"low"`
+	Error string `json:"error,omitempty"`
+}
+
+// OperatorThreshold is when and how much an operator is topped up.
+type OperatorThreshold struct {
+	// Threshold is the balance in ETH the operator is topped up below.
+	Threshold float64 `json:"threshold"`
+	// Target is the balance in ETH the operator is topped up to.
+	Target float64 `json:"target"`
+}
+
+// OperatorTopUpConfig configures monitoring ETH balances of operators and topping them up from
+// the funding wallet, or from the reserve if no funding wallet is configured. Operators are topped
+// up only if they have a threshold and both limits are set.
+type OperatorTopUpConfig struct {
+	// CheckInterval is how often balances of operators are checked. Default is 1m.
+	CheckInterval HumanDuration `json:"check_interval"`
+	// MinHoursLeft flags an operator as low if its balance lasts less than this. Default is 24.
+	MinHoursLeft float64 `json:"min_hours_left"`
+	// MaxPerTopUp is the max ETH sent by a top up.
+	MaxPerTopUp float64 `json:"max_per_top_up"`
+	// MaxDaily is the max ETH sent by top ups of all operators in the last 24 hours.
+	MaxDaily float64 `json:"max_daily"`
+	// Operators maps operator names to their thresholds.
+	Operators map[string]OperatorThreshold `json:"operators"`
+}
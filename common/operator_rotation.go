@@ -0,0 +1,41 @@
+package common
+
+import (
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+)
+
+// Steps of an operator rotation, in order. The step of a rotation is the next one to run.
+const (
+	// RotationStepAddOperator adds the new address as operator of the contract.
+	RotationStepAddOperator = "add_operator"
+	// RotationStepDrain pauses the old key and waits for its pending txs to be mined.
+	RotationStepDrain = "drain"
+	// RotationStepSwitch registers the new key as the operator so that txs are sent with it.
+	RotationStepSwitch = "switch"
+	// RotationStepRemoveOperator removes the old address from operators of the contract.
+	RotationStepRemoveOperator = "remove_operator"
+	// RotationStepDone means the rotation is finished.
+	RotationStepDone = "done"
+)
+
+// OperatorRotation is the rotation of the key of an operator, e.g pricingOP, to a new key.
+type OperatorRotation struct {
+	ID         uint64           `json:"id"`
+	Operator   string           `json:"operator"`
+	OldAddress ethereum.Address `json:"old_address"`
+	NewAddress ethereum.Address `json:"new_address"`
+	Step       string           `json:"step"`
+	// Error is the error of the last run of Step, the rotation is resumed from Step.
+	Error    string    `json:"error,omitempty"`
+	AddTx    string    `json:"add_operator_tx,omitempty"`
+	RemoveTx string    `json:"remove_operator_tx,omitempty"`
+	Created  time.Time `json:"created"`
+	Updated  time.Time `json:"updated"`
+}
+
+// Switched returns true if txs of the operator are sent with the new key.
+func (r OperatorRotation) Switched() bool {
+	return r.Step == RotationStepRemoveOperator || r.Step == RotationStepDone
+}
@@ -0,0 +1,47 @@
+package common
+
+import (
+	"fmt"
+	"time"
+)
+
+// PrunableData is a kind of history data which is archived and pruned from database
+// once it is older than its retention.
+type PrunableData string
+
+const (
+	// PrunableAuthData is auth data snapshots in fetch_data table.
+	PrunableAuthData PrunableData = "auth_data"
+	// PrunablePrice is order book prices in fetch_data table.
+	PrunablePrice PrunableData = "price"
+	// PrunableRate is reserve rates in fetch_data table.
+	PrunableRate PrunableData = "rate"
+	// PrunableActivity is completed activities in activity table.
+	PrunableActivity PrunableData = "activity"
+)
+
+// AllPrunableData returns all kinds of prunable data.
+func AllPrunableData() []PrunableData {
+	return []PrunableData{PrunableAuthData, PrunablePrice, PrunableRate, PrunableActivity}
+}
+
+// ValidPrunableData returns error if d is not a known kind of prunable data.
+func ValidPrunableData(d PrunableData) error {
+	for _, known := range AllPrunableData() {
+		if d == known {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown prunable data %s", d)
+}
+
+// RetentionConfig is how long each kind of data is kept in database, data without
+// retention is never pruned.
+type RetentionConfig map[PrunableData]HumanDuration
+
+// DefaultRetention keeps auth data for 10 days and everything else forever.
+func DefaultRetention() RetentionConfig {
+	return RetentionConfig{
+		PrunableAuthData: HumanDuration(10 * 24 * time.Hour),
+	}
+}
@@ -0,0 +1,86 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+)
+
+// Names of the contracts watched for admin activity.
+const (
+	ContractReserve = "reserve"
+	ContractPricing = "pricing"
+	ContractWrapper = "wrapper"
+)
+
+// Severities of security findings, from the most to the least severe.
+const (
+	SeverityCritical = "critical"
+	SeverityHigh     = "high"
+	SeverityMedium   = "medium"
+	SeverityLow      = "low"
+)
+
+// severities are all severities, from the most to the least severe.
+var severities = []string{SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow}
+
+// SeveritiesAtLeast returns the severities which are at least as severe as min, all severities if
+// min is empty.
+func SeveritiesAtLeast(min string) ([]string, error) {
+	if min == "" {
+		return severities, nil
+	}
+	for i, severity := range severities {
+		if severity == min {
+			return severities[:i+1], nil
+		}
+	}
+	return nil, fmt.Errorf("unknown severity %s", min)
+}
+
+// ContractEvent is an event of a reserve contract decoded with the contract ABI, with the sender
+// and method of the tx which emitted it.
+type ContractEvent struct {
+	Contract    string                 `json:"contract"`
+	Address     ethereum.Address       `json:"address"`
+	Event       string                 `json:"event"`
+	Params      map[string]interface{} `json:"params"`
+	BlockNumber uint64                 `json:"block_number"`
+	TxHash      ethereum.Hash          `json:"tx_hash"`
+	LogIndex    uint                   `json:"log_index"`
+	Sender      ethereum.Address       `json:"sender"`
+	// Method is the contract method called by the tx, empty if the tx calls another contract.
+	Method string `json:"method,omitempty"`
+	// DecodeError is the error of decoding the log with the contract ABI, Event is the topic of
+	// the log if it is not an event of the ABI.
+	DecodeError string `json:"decode_error,omitempty"`
+}
+
+// SecurityFinding is on-chain admin activity of a reserve contract which is not initiated by our
+// operators.
+type SecurityFinding struct {
+	ID          uint64                 `json:"id"`
+	Severity    string                 `json:"severity"`
+	Contract    string                 `json:"contract"`
+	Address     ethereum.Address       `json:"address"`
+	Event       string                 `json:"event"`
+	Description string                 `json:"description"`
+	Params      map[string]interface{} `json:"params,omitempty"`
+	BlockNumber uint64                 `json:"block_number"`
+	// TxHash is empty for findings which are not found from an event, e.g token listings.
+	TxHash   string           `json:"tx_hash,omitempty"`
+	LogIndex uint             `json:"log_index"`
+	Sender   ethereum.Address `json:"sender"`
+	Method   string           `json:"method,omitempty"`
+	Created  time.Time        `json:"created"`
+}
+
+// SecurityMonitorConfig configures the monitor of admin activity on reserve contracts.
+type SecurityMonitorConfig struct {
+	CheckInterval HumanDuration `json:"check_interval"`
+	// StartBlocks is how many blocks before the current block are checked on start.
+	StartBlocks uint64 `json:"start_blocks"`
+	// MaxBlocksPerCheck is the max number of blocks of logs requested at once.
+	MaxBlocksPerCheck uint64 `json:"max_blocks_per_check"`
+}
@@ -22,6 +22,11 @@ func (te TestExchange) ID() rtypes.ExchangeID {
 	return rtypes.Binance
 }
 
+// Name return exchange name
+func (te TestExchange) Name() string {
+	return rtypes.Binance.String()
+}
+
 //Address function for test
 func (te TestExchange) Address(asset common.Asset) (address ethereum.Address, supported bool) {
 	return ethereum.Address{}, true
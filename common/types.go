@@ -246,6 +246,13 @@ type ActivityRecord struct {
 	Timestamp      Timestamp       `json:"timestamp,omitempty"`
 }
 
+// ActivityStatusChange is a change of exchange or mining status of an activity.
+type ActivityStatusChange struct {
+	Activity               ActivityRecord `json:"activity"`
+	PreviousExchangeStatus string         `json:"previous_exchange_status"`
+	PreviousMiningStatus   string         `json:"previous_mining_status"`
+}
+
 // AssetRateTrigger keep result of calculate token rate trigger
 type AssetRateTrigger struct {
 	AssetID rtypes.AssetID `json:"asset_id"`
@@ -273,6 +280,15 @@ type ActivityParams struct {
 	Rate          float64              `json:"rate,omitempty"`
 	Triggers      []bool               `json:"triggers,omitempty"`
 	TradingPairID rtypes.TradingPairID `json:"trading_pair_id,omitempty"`
+	// MidPrice is the mid price of the trading pair on the exchange when the trade was checked.
+	MidPrice float64 `json:"mid_price,omitempty"`
+	// ReserveAdmin and PricingContract params
+	AdminOperation  string                 `json:"admin_operation,omitempty"`
+	SettingChangeID rtypes.SettingChangeID `json:"setting_change_id,omitempty"`
+	// AdminPosition is the position of the operation in the change list of the setting change.
+	AdminPosition int `json:"admin_position,omitempty"`
+	// OperatorTopUp params
+	Operator string `json:"operator,omitempty"`
 }
 
 // ActivityResult is result of an activity
@@ -281,6 +297,8 @@ type ActivityResult struct {
 	Nonce    uint64 `json:"nonce,omitempty"`
 	GasPrice string `json:"gasPrice,omitempty"`
 	Error    string `json:"error,omitempty"`
+	// Operator sent the tx, it is set only for actions which can be sent by more than one operator.
+	Operator string `json:"operator,omitempty"`
 	// ID of withdraw
 	ID string `json:"id,omitempty"`
 	// params of trade
@@ -331,7 +349,7 @@ func (ar ActivityRecord) IsBlockchainPending() bool {
 		// for withdraw ExchangeStatusFailed/ExchangeStatusCancelled mean will there's no tx => consider it's not a pending anymore.
 		return (ar.MiningStatus == "" || ar.MiningStatus == MiningStatusSubmitted) &&
 			ar.ExchangeStatus != ExchangeStatusFailed && ar.ExchangeStatus != ExchangeStatusCancelled
-	case ActionDeposit, ActionSetRate, ActionCancelSetRate:
+	case ActionDeposit, ActionSetRate, ActionCancelSetRate, ActionReserveAdmin, ActionPricingContract, ActionOperatorTopUp:
 		return ar.MiningStatus == "" || ar.MiningStatus == MiningStatusSubmitted
 	}
 	return true
@@ -354,7 +372,7 @@ func (ar ActivityRecord) IsPending() bool {
 		// mining fail confirm it's done, not sure we will ever see exchange failed here but no harmful
 	case ActionTrade:
 		return ar.ExchangeStatus == "" || ar.ExchangeStatus == ExchangeStatusSubmitted
-	case ActionSetRate, ActionCancelSetRate:
+	case ActionSetRate, ActionCancelSetRate, ActionReserveAdmin, ActionPricingContract, ActionOperatorTopUp:
 		return ar.MiningStatus == "" || ar.MiningStatus == MiningStatusSubmitted
 	}
 	return true
@@ -547,6 +565,7 @@ type AuthDataSnapshot struct {
 	ReserveBalances   map[rtypes.AssetID]BalanceEntry     `json:"reserve_balances,omitempty"`
 	PendingActivities []ActivityRecord                    `json:"pending_activities,omitempty"`
 	Block             uint64                              `json:"block,omitempty"`
+	OperatorBalances  []OperatorBalance                   `json:"operator_balances,omitempty"`
 }
 
 // deprecated
@@ -594,6 +613,7 @@ type PendingActivities struct {
 type AuthDataResponseV3 struct {
 	Balances          []AuthdataBalance `json:"balances"`
 	PendingActivities PendingActivities `json:"pending_activities"`
+	OperatorBalances  []OperatorBalance `json:"operator_balances"`
 	Version           Version           `json:"version"`
 }
 
@@ -753,6 +773,13 @@ type ExchangeEndpoints struct {
 type Nodes struct {
 	Main   string   `json:"main"`
 	Backup []string `json:"backup"`
+
+	// MaxBlockLag is the number of blocks a node may be behind the best node and still be healthy.
+	MaxBlockLag uint64 `json:"max_block_lag"`
+	// HealthCheckInterval is how often the latest block of every node is checked.
+	HealthCheckInterval HumanDuration `json:"health_check_interval"`
+	// ReadQuorum is the number of nodes that must agree on balance and rate fetches, 0 or 1 to disable.
+	ReadQuorum int `json:"read_quorum"`
 }
 
 // HumanDuration ...
@@ -785,11 +812,35 @@ type FetcherDelay struct {
 type GasConfig struct {
 	FetchMaxGasCacheSeconds int64  `json:"fetch_max_gas_cache_seconds"`
 	GasPriceURL             string `json:"gas_price_url"`
+
+	// Sources are the gas price sources besides the gas price data service: gasstation and node.
+	Sources          []string `json:"sources"`
+	GasStationAPIKey string   `json:"gas_station_api_key"`
+	// Strategy to choose gas price: preferred (default), median or percentile.
+	Strategy      string  `json:"strategy"`
+	Percentile    float64 `json:"percentile"`
+	OutlierRatio  float64 `json:"outlier_ratio"`
+	MaxAgeSeconds int64   `json:"max_age_seconds"`
+}
+
+// ExchangeCredential is the key pair and account data id of an exchange account.
+type ExchangeCredential struct {
+	AccountID string `json:"account_id"`
+	Key       string `json:"key"`
+	Secret    string `json:"secret"`
+}
+
+// Keystore is the path and passphrase of an account keystore.
+type Keystore struct {
+	Path       string `json:"path"`
+	Passphrase string `json:"passphrase"`
 }
 
 // RawConfig include all configs read from files
 type RawConfig struct {
 	AWSConfig         archive.AWSConfig `json:"aws_config"`
+	Archive           archive.Config    `json:"archive"`
+	Retention         RetentionConfig   `json:"retention"`
 	WorldEndpoints    WorldEndpoints    `json:"world_endpoints"`
 	ContractAddresses ContractAddresses `json:"contract_addresses"`
 	ExchangeEndpoints ExchangeEndpoints `json:"exchange_endpoints"`
@@ -799,19 +850,72 @@ type RawConfig struct {
 
 	HTTPAPIAddr string `json:"http_api_addr"`
 
+	// FetchDataExportDir is the directory fetch data of the previous day is exported to as CSV
+	// every day, leave empty to disable.
+	FetchDataExportDir string `json:"fetch_data_export_dir"`
+
+	// PriceHistoryDepth maps trading pairs to quantities their depth is sampled at in price history.
+	PriceHistoryDepth map[rtypes.TradingPairID][]float64 `json:"price_history_depth"`
+
+	// BalanceReconcileTolerance is the max balance change of an asset not explained by activities,
+	// relative to its balance, before it is flagged. Default is 0.005.
+	BalanceReconcileTolerance float64 `json:"balance_reconcile_tolerance"`
+
+	// TokenIndicesCheckInterval is how often listed tokens of the pricing contract are checked
+	// for changes that require reloading token indices. Default is 1m.
+	TokenIndicesCheckInterval HumanDuration `json:"token_indices_check_interval"`
+
+	// OperatorTopUp configures monitoring and topping up ETH balances of operators.
+	OperatorTopUp OperatorTopUpConfig `json:"operator_top_up"`
+
+	// SecurityMonitor configures the monitor of admin activity on the reserve contracts.
+	SecurityMonitor SecurityMonitorConfig `json:"security_monitor"`
+
+	// PricingFailoverTimeout is how long a set rate tx is pending before set rate fails over to a
+	// standby pricing operator. Default is 3m.
+	PricingFailoverTimeout HumanDuration `json:"pricing_failover_timeout"`
+
 	PricingKeystore   string `json:"keystore_path"`
 	PricingPassphrase string `json:"passphrase"`
 	DepositKeystore   string `json:"keystore_deposit_path"`
 	DepositPassphrase string `json:"passphrase_deposit"`
 
+	// PricingAdminKeystore is the keystore of the account setting step functions and control info
+	// of the pricing contract, leave empty to disable.
+	PricingAdminKeystore   string `json:"keystore_pricing_admin_path"`
+	PricingAdminPassphrase string `json:"passphrase_pricing_admin"`
+	// ReserveAdminKeystore is the keystore of the account sending admin operations of the reserve
+	// contract, leave empty to disable.
+	ReserveAdminKeystore   string `json:"keystore_reserve_admin_path"`
+	ReserveAdminPassphrase string `json:"passphrase_reserve_admin"`
+	// PricingRotationKeystore and DepositRotationKeystore are the keystores the pricing and
+	// deposit operators are rotated to, leave empty if no rotation is planned.
+	PricingRotationKeystore   string `json:"keystore_pricing_rotation_path"`
+	PricingRotationPassphrase string `json:"passphrase_pricing_rotation"`
+	DepositRotationKeystore   string `json:"keystore_deposit_rotation_path"`
+	DepositRotationPassphrase string `json:"passphrase_deposit_rotation"`
+	// PricingStandbyKeystores are the keystores of standby pricing operators in the order set rate
+	// fails over to them, they must be operators of the pricing contract.
+	PricingStandbyKeystores []Keystore `json:"keystore_pricing_standby"`
+	// FundingKeystore is the keystore of the wallet topping up ETH of operators, leave empty to
+	// top up from the reserve with the reserve admin.
+	FundingKeystore   string `json:"keystore_funding_path"`
+	FundingPassphrase string `json:"passphrase_funding"`
+
 	BinanceAccountID string `json:"binance_account_id"`
 	BinanceKey       string `json:"binance_key"`
 	BinanceSecret    string `json:"binance_secret"`
 
+	// Deprecated: use exchange_credentials, they are the credentials of binance_2 if it is not
+	// in exchange_credentials.
 	BinanceAccount2ID string `json:"binance_account_2_id"`
 	Binance2Key       string `json:"binance_2_key"`
 	Binance2Secret    string `json:"binance_2_secret"`
 
+	// ExchangeCredentials maps credentials reference of exchange accounts in setting database
+	// to their keys.
+	ExchangeCredentials map[string]ExchangeCredential `json:"exchange_credentials"`
+
 	BinanceAccountMainID string `json:"binance_account_main_id"`
 
 	HoubiKey    string `json:"huobi_key"`
@@ -829,6 +933,27 @@ type RawConfig struct {
 	} `json:"account_data"`
 }
 
+// legacyBinance2Credentials is the credentials reference of the second binance account which
+// used binance_2_* keys before exchange_credentials.
+const legacyBinance2Credentials = "binance_2"
+
+// GetExchangeCredential returns credential of an exchange account by its reference, built-in
+// accounts fall back to binance_*, binance_2_* and huobi_* keys.
+func (rc RawConfig) GetExchangeCredential(ref string) (ExchangeCredential, bool) {
+	if cred, ok := rc.ExchangeCredentials[ref]; ok {
+		return cred, true
+	}
+	switch ref {
+	case rtypes.Binance.String():
+		return ExchangeCredential{AccountID: rc.BinanceAccountID, Key: rc.BinanceKey, Secret: rc.BinanceSecret}, true
+	case legacyBinance2Credentials:
+		return ExchangeCredential{AccountID: rc.BinanceAccount2ID, Key: rc.Binance2Key, Secret: rc.Binance2Secret}, true
+	case rtypes.Huobi.String():
+		return ExchangeCredential{Key: rc.HoubiKey, Secret: rc.HoubiSecret}, true
+	}
+	return ExchangeCredential{}, false
+}
+
 // FeedProviderResponse ...
 type FeedProviderResponse struct {
 	Valid bool
@@ -836,3 +961,15 @@ type FeedProviderResponse struct {
 	Bid   float64 `json:"bid,string"`
 	Ask   float64 `json:"ask,string"`
 }
+
+// IdempotentRequest is a mutating request sent with an Idempotency-Key header, the outcome
+// of the request is stored to respond to retries of the same request.
+type IdempotentRequest struct {
+	Key         string
+	Route       string
+	RequestHash string
+	Completed   bool
+	Status      int
+	Response    []byte
+	ActivityID  *ActivityID
+}
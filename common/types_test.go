@@ -59,3 +59,21 @@ func TestJSONToActivityID(t *testing.T) {
 		t.Fatalf("Expected %v, got %v", expectedOutput, output)
 	}
 }
+
+func TestGetExchangeCredentialLegacyBinance2(t *testing.T) {
+	var rc RawConfig
+	input := `{"binance_account_2_id": "b2", "binance_2_key": "key2", "binance_2_secret": "secret2"}`
+	if err := json.Unmarshal([]byte(input), &rc); err != nil {
+		t.Fatalf("Expected unmarshal successfully but got error: %v", err)
+	}
+	expectedOutput := ExchangeCredential{AccountID: "b2", Key: "key2", Secret: "secret2"}
+	output, ok := rc.GetExchangeCredential("binance_2")
+	if !ok || output != expectedOutput {
+		t.Fatalf("Expected %v, got %v", expectedOutput, output)
+	}
+
+	rc.ExchangeCredentials = map[string]ExchangeCredential{"binance_2": {Key: "new"}}
+	if output, _ = rc.GetExchangeCredential("binance_2"); output.Key != "new" {
+		t.Fatalf("Expected exchange_credentials to override binance_2_* keys, got %v", output)
+	}
+}
@@ -17,6 +17,9 @@ const (
 	ActionWithdraw          = "withdraw"
 	ActionSetRate           = "set_rates"
 	ActionCancelSetRate     = "cancel_set_rates"
+	ActionReserveAdmin      = "reserve_admin"
+	ActionPricingContract   = "pricing_contract"
+	ActionOperatorTopUp     = "operator_top_up"
 )
 
 const (
@@ -1,7 +1,10 @@
 package core
 
 import (
+	"strconv"
+
 	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/eventbus"
 	"github.com/KyberNetwork/reserve-data/lib/rtypes"
 	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
@@ -20,11 +23,41 @@ type ActivityStorage interface {
 		isPending bool) error
 	HasPendingDeposit(token commonv3.Asset, exchange common.Exchange) (bool, error)
 
-	MaxPendingNonce(action string) (int64, error)
+	MaxPendingNonce(op string, action string) (int64, error)
+
+	GetActivity(exchange string, orderID string) (common.ActivityRecord, error)
+
+	// PendingActivityForAction return the first pending activity of an action sent by operator op
+	// and number of pending transactions with its nonce.
+	PendingActivityForAction(op string, minedNonce uint64, activityType string) (*common.ActivityRecord, uint64, error)
 
-	GetActivity(exchangeID rtypes.ExchangeID, orderID string) (common.ActivityRecord, error)
+	// GetSettingChangeActivities returns the activities of action sent for setting change id.
+	GetSettingChangeActivities(action string, id rtypes.SettingChangeID) ([]common.ActivityRecord, error)
+}
+
+// eventActivityStorage publishes an event for every recorded activity.
+type eventActivityStorage struct {
+	ActivityStorage
+	bus *eventbus.Bus
+}
 
-	// PendingActivityForAction return the last pending set rate and number of pending
-	// transactions.
-	PendingActivityForAction(minedNonce uint64, activityType string) (*common.ActivityRecord, uint64, error)
+func (s eventActivityStorage) Record(
+	action string,
+	id common.ActivityID,
+	destination string,
+	params common.ActivityParams,
+	result common.ActivityResult,
+	estatus string,
+	mstatus string,
+	timepoint uint64,
+	isPending bool) error {
+	if err := s.ActivityStorage.Record(action, id, destination, params, result, estatus, mstatus, timepoint, isPending); err != nil {
+		return err
+	}
+	record := common.NewActivityRecord(action, id, destination, params, result, estatus, mstatus, common.Timestamp(strconv.FormatUint(timepoint, 10)))
+	s.bus.Publish(eventbus.ActivityCreated, record)
+	if action == common.ActionSetRate && result.Tx != "" {
+		s.bus.Publish(eventbus.SetRateSubmitted, record)
+	}
+	return nil
 }
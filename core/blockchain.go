@@ -21,6 +21,7 @@ type Blockchain interface {
 		gasPrice *big.Int) (*types.Transaction, error)
 	TransferToSelf(op string, gasPrice *big.Int, nonce *big.Int) (*types.Transaction, error)
 	SetRates(
+		op string,
 		tokens []ethereum.Address,
 		buys []*big.Int,
 		sells []*big.Int,
@@ -28,9 +29,18 @@ type Blockchain interface {
 		nonce *big.Int,
 		gasPrice *big.Int) (*types.Transaction, error)
 	blockchain.MinedNoncePicker
+	ResetMinedNonce(op string)
 
 	BuildSendETHTx(opts blockchain.TxOpts, to ethereum.Address) (*types.Transaction, error)
 	GetDepositOPAddress() ethereum.Address
+	PricingOperators() []string
 	SignAndBroadcast(tx *types.Transaction, from string) (*types.Transaction, error)
 	SpeedupDeposit(tx ethereum.Hash, gasPrice *big.Int) (ethereum.Hash, error)
+	SendReserveAdmin(entry common.ReserveAdminEntry, asset common.Asset, gasPrice *big.Int) (*types.Transaction, error)
+	SetQtyStepFunction(fns common.PricingStepFunctions, gasPrice *big.Int) (*types.Transaction, error)
+	SetImbalanceStepFunction(fns common.PricingStepFunctions, gasPrice *big.Int) (*types.Transaction, error)
+	SetTokenControlInfo(info common.PricingControlInfo, gasPrice *big.Int) (*types.Transaction, error)
+	VerifyStepFunctions(fns common.PricingStepFunctions) error
+	VerifyTokenControlInfo(info common.PricingControlInfo) error
+	TopUpOperator(addr ethereum.Address, amount *big.Int, gasPrice *big.Int) (*types.Transaction, error)
 }
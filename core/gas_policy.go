@@ -0,0 +1,115 @@
+package core
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// GasPolicyStorage provides the set rate gas policy.
+type GasPolicyStorage interface {
+	GetSetRateGasPolicy() (commonv3.SetRateGasPolicy, error)
+}
+
+// RateStorage provides the latest rates fetched from the pricing contract.
+type RateStorage interface {
+	CurrentRateVersion(timepoint uint64) (common.Version, error)
+	GetRate(v common.Version) (common.AllRateEntry, error)
+}
+
+// setRateGasLimit is the gas decision of a set rate tx.
+type setRateGasLimit struct {
+	// urgency is the highest urgency of all assets in tx.
+	urgency float64
+	// multiplier is applied to the recommended gas price.
+	multiplier float64
+	// maxGasPrice (gwei) is the highest gas price the tx may pay, including replacements.
+	maxGasPrice float64
+}
+
+// SetGasPolicy makes set rate gas price depend on how stale the on-chain rates are,
+// without it set rate txs always use the recommended gas price bounded by max gas price.
+func (rc *ReserveCore) SetGasPolicy(policyStorage GasPolicyStorage, rateStorage RateStorage) {
+	rc.gasPolicyStorage = policyStorage
+	rc.rateStorage = rateStorage
+}
+
+// setRateGasLimit computes the gas decision for setting rates of tokens at block.
+func (rc *ReserveCore) setRateGasLimit(tokens []commonv3.Asset, buys, sells []*big.Int, block *big.Int, highBound float64) setRateGasLimit {
+	noPolicy := setRateGasLimit{urgency: 1, multiplier: 1, maxGasPrice: highBound}
+	if rc.gasPolicyStorage == nil || rc.rateStorage == nil {
+		return noPolicy
+	}
+	policy, err := rc.gasPolicyStorage.GetSetRateGasPolicy()
+	if err != nil {
+		if err != commonv3.ErrNotFound {
+			rc.l.Warnw("failed to get set rate gas policy, ignore policy", "err", err)
+		}
+		return noPolicy
+	}
+	version, err := rc.rateStorage.CurrentRateVersion(common.NowInMillis())
+	if err != nil {
+		rc.l.Warnw("failed to get current rate version, ignore set rate gas policy", "err", err)
+		return noPolicy
+	}
+	onchain, err := rc.rateStorage.GetRate(version)
+	if err != nil {
+		rc.l.Warnw("failed to get on-chain rates, ignore set rate gas policy", "err", err)
+		return noPolicy
+	}
+	return computeSetRateGasLimit(policy, onchain.Data, tokens, buys, sells, block.Uint64(), highBound)
+}
+
+// computeSetRateGasLimit returns the gas decision of the most urgent asset, an asset
+// without on-chain rate is always urgent.
+func computeSetRateGasLimit(policy commonv3.SetRateGasPolicy, onchain map[rtypes.AssetID]common.RateEntry,
+	tokens []commonv3.Asset, buys, sells []*big.Int, block uint64, highBound float64) setRateGasLimit {
+	if len(tokens) == 0 {
+		return setRateGasLimit{multiplier: 1, maxGasPrice: highBound}
+	}
+	result := setRateGasLimit{}
+	for i, token := range tokens {
+		assetPolicy := policy.AssetPolicy(token.ID)
+		urgency := math.Inf(1)
+		if entry, ok := onchain[token.ID]; ok {
+			deviation := math.Max(
+				rateDeviation(buys[i], entry.BaseBuy, entry.CompactBuy),
+				rateDeviation(sells[i], entry.BaseSell, entry.CompactSell),
+			)
+			var blocks uint64
+			if block > entry.Block {
+				blocks = block - entry.Block
+			}
+			urgency = assetPolicy.Urgency(deviation, blocks)
+		}
+		result.urgency = math.Max(result.urgency, urgency)
+		result.multiplier = math.Max(result.multiplier, assetPolicy.Multiplier(urgency))
+		result.maxGasPrice = math.Max(result.maxGasPrice, assetPolicy.MaxGasPrice(urgency, highBound))
+	}
+	return result
+}
+
+// rateDeviation returns the relative deviation of rate from the on-chain rate base*(1+compact/1000).
+func rateDeviation(rate, base *big.Int, compact int8) float64 {
+	current := new(big.Float).Mul(
+		new(big.Float).SetInt(base),
+		big.NewFloat(1+float64(compact)/1000),
+	)
+	target := new(big.Float).SetInt(rate)
+	if current.Sign() == 0 {
+		if target.Sign() == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	diff, _ := new(big.Float).Quo(new(big.Float).Sub(target, current), current).Float64()
+	return math.Abs(diff)
+}
+
+// initialGasPrice returns the gas price (gwei) of a new set rate tx from recommended gas price.
+func (l setRateGasLimit) initialGasPrice(recommended float64) float64 {
+	return math.Min(recommended*l.multiplier, l.maxGasPrice)
+}
@@ -0,0 +1,118 @@
+package core
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func TestComputeSetRateGasLimit(t *testing.T) {
+	const highBound = 100.0
+	policy := commonv3.SetRateGasPolicy{
+		Default: commonv3.AssetGasPolicy{
+			DeviationThreshold: 0.01,
+			BlocksThreshold:    100,
+			MinMultiplier:      0.8,
+			MaxMultiplier:      1.6,
+			TrivialMaxGasPrice: 20,
+		},
+		Assets: map[rtypes.AssetID]commonv3.AssetGasPolicy{
+			2: {
+				DeviationThreshold: 0.001,
+				BlocksThreshold:    10,
+				MinMultiplier:      1,
+				MaxMultiplier:      2,
+			},
+		},
+	}
+	require.NoError(t, policy.Validate())
+
+	rate := big.NewInt(1000000)
+	onchain := map[rtypes.AssetID]common.RateEntry{
+		1: common.NewRateEntry(big.NewInt(1000000), 0, big.NewInt(1000000), 0, 1000),
+		2: common.NewRateEntry(big.NewInt(1000000), 0, big.NewInt(1000000), 0, 1000),
+	}
+	asset1 := commonv3.Asset{ID: 1}
+	asset2 := commonv3.Asset{ID: 2}
+
+	tests := []struct {
+		name        string
+		assets      []commonv3.Asset
+		rates       []*big.Int
+		block       uint64
+		urgency     float64
+		multiplier  float64
+		maxGasPrice float64
+	}{
+		{
+			name:        "unchanged rate is trivial",
+			assets:      []commonv3.Asset{asset1},
+			rates:       []*big.Int{rate},
+			block:       1000,
+			urgency:     0,
+			multiplier:  0.8,
+			maxGasPrice: 20,
+		},
+		{
+			name:        "half stale by blocks",
+			assets:      []commonv3.Asset{asset1},
+			rates:       []*big.Int{rate},
+			block:       1050,
+			urgency:     0.5,
+			multiplier:  1.2,
+			maxGasPrice: 20,
+		},
+		{
+			name:        "deviation over threshold is urgent",
+			assets:      []commonv3.Asset{asset1},
+			rates:       []*big.Int{big.NewInt(1020000)},
+			block:       1000,
+			urgency:     2,
+			multiplier:  1.6,
+			maxGasPrice: highBound,
+		},
+		{
+			name:        "most urgent asset decides",
+			assets:      []commonv3.Asset{asset1, asset2},
+			rates:       []*big.Int{rate, rate},
+			block:       1010,
+			urgency:     1,
+			multiplier:  2,
+			maxGasPrice: highBound,
+		},
+		{
+			name:        "asset without on-chain rate is urgent",
+			assets:      []commonv3.Asset{{ID: 3}},
+			rates:       []*big.Int{rate},
+			block:       1000,
+			urgency:     math.Inf(1),
+			multiplier:  1.6,
+			maxGasPrice: highBound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			limit := computeSetRateGasLimit(policy, onchain, tc.assets, tc.rates, tc.rates, tc.block, highBound)
+			if math.IsInf(tc.urgency, 1) {
+				require.True(t, math.IsInf(limit.urgency, 1))
+			} else {
+				require.InDelta(t, tc.urgency, limit.urgency, 1e-9)
+			}
+			require.InDelta(t, tc.multiplier, limit.multiplier, 1e-9)
+			require.InDelta(t, tc.maxGasPrice, limit.maxGasPrice, 1e-9)
+		})
+	}
+}
+
+func TestRateDeviation(t *testing.T) {
+	require.InDelta(t, 0, rateDeviation(big.NewInt(1010), big.NewInt(1000), 10), 1e-9)
+	require.InDelta(t, 0.01, rateDeviation(big.NewInt(1010), big.NewInt(1000), 0), 1e-9)
+	require.InDelta(t, 0.01, rateDeviation(big.NewInt(990), big.NewInt(1000), 0), 1e-9)
+	require.InDelta(t, 0, rateDeviation(big.NewInt(0), big.NewInt(0), 0), 1e-9)
+}
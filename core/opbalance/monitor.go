@@ -0,0 +1,247 @@
+package opbalance
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+const (
+	defaultCheckInterval = time.Minute
+	defaultMinHoursLeft  = 24
+	// burnWindow is how long balance samples are kept to estimate the burn rate.
+	burnWindow = 24 * time.Hour
+	// minBurnDuration is the min time covered by samples before a burn rate is estimated.
+	minBurnDuration = 10 * time.Minute
+)
+
+// Blockchain returns operators and their ETH balances.
+type Blockchain interface {
+	OperatorAddresses() map[string]ethereum.Address
+	GetETHBalance(addr ethereum.Address) (*big.Int, error)
+}
+
+// Core sends top up txs and records them as activities.
+type Core interface {
+	TopUpOperator(op string, addr ethereum.Address, amount float64) (common.ActivityID, error)
+}
+
+// ActivityStorage returns activities to find pending top ups and the amount topped up in the last
+// day.
+type ActivityStorage interface {
+	GetPendingActivities() ([]common.ActivityRecord, error)
+	GetAllRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error)
+}
+
+type sample struct {
+	time    time.Time
+	balance float64
+}
+
+// Monitor checks ETH balances of operators, estimates their burn rate from the balance samples of
+// the last day and tops up operators which are low.
+type Monitor struct {
+	bc              Blockchain
+	core            Core
+	activityStorage ActivityStorage
+	config          common.OperatorTopUpConfig
+	now             func() time.Time
+	l               *zap.SugaredLogger
+
+	mu       sync.RWMutex
+	samples  map[ethereum.Address][]sample
+	balances []common.OperatorBalance
+}
+
+// NewMonitor creates a Monitor of all operators of bc.
+func NewMonitor(bc Blockchain, core Core, activityStorage ActivityStorage, config common.OperatorTopUpConfig) *Monitor {
+	if config.CheckInterval == 0 {
+		config.CheckInterval = common.HumanDuration(defaultCheckInterval)
+	}
+	if config.MinHoursLeft == 0 {
+		config.MinHoursLeft = defaultMinHoursLeft
+	}
+	return &Monitor{
+		bc:              bc,
+		core:            core,
+		activityStorage: activityStorage,
+		config:          config,
+		now:             time.Now,
+		l:               zap.S(),
+		samples:         map[ethereum.Address][]sample{},
+	}
+}
+
+// Run checks operators every check interval, it never returns.
+func (m *Monitor) Run() {
+	ticker := time.NewTicker(time.Duration(m.config.CheckInterval))
+	defer ticker.Stop()
+	for {
+		m.Check()
+		<-ticker.C
+	}
+}
+
+// OperatorBalances returns the balances of the last check ordered by operator.
+func (m *Monitor) OperatorBalances() []common.OperatorBalance {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]common.OperatorBalance(nil), m.balances...)
+}
+
+// Check fetches balances of operators and tops up the low ones.
+func (m *Monitor) Check() {
+	now := m.now()
+	operators := m.bc.OperatorAddresses()
+	balances := make([]common.OperatorBalance, 0, len(operators))
+	for op, addr := range operators {
+		balance := common.OperatorBalance{
+			Operator:  op,
+			Address:   addr,
+			Threshold: m.config.Operators[op].Threshold,
+		}
+		wei, err := m.bc.GetETHBalance(addr)
+		if err != nil {
+			m.l.Warnw("failed to get operator balance", "operator", op, "address", addr.Hex(), "err", err)
+			balance.Error = err.Error()
+		} else {
+			balance.Balance = common.BigToFloat(wei, 18)
+		}
+		balances = append(balances, balance)
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].Operator < balances[j].Operator
+	})
+
+	m.mu.Lock()
+	for addr := range m.samples {
+		if !containsAddress(operators, addr) {
+			delete(m.samples, addr)
+		}
+	}
+	for i := range balances {
+		balance := &balances[i]
+		if balance.Error != "" {
+			continue
+		}
+		addr := balance.Address
+		m.samples[addr] = addSample(m.samples[addr], sample{time: now, balance: balance.Balance})
+		balance.BurnPerDay = burnPerDay(m.samples[addr])
+		if balance.BurnPerDay > 0 {
+			balance.HoursLeft = balance.Balance / balance.BurnPerDay * 24
+		}
+		balance.Low = balance.Balance < balance.Threshold ||
+			(balance.BurnPerDay > 0 && balance.HoursLeft < m.config.MinHoursLeft)
+	}
+	m.balances = balances
+	m.mu.Unlock()
+
+	if err := m.topUp(balances); err != nil {
+		m.l.Errorw("failed to top up operators", "err", err)
+	}
+}
+
+// topUp tops up low operators with a threshold to their target, bounded by the limits.
+func (m *Monitor) topUp(balances []common.OperatorBalance) error {
+	if m.config.MaxPerTopUp <= 0 || m.config.MaxDaily <= 0 {
+		return nil
+	}
+	var low []common.OperatorBalance
+	for _, balance := range balances {
+		if _, ok := m.config.Operators[balance.Operator]; ok && balance.Low && balance.Error == "" {
+			low = append(low, balance)
+		}
+	}
+	if len(low) == 0 {
+		return nil
+	}
+	pendings, err := m.activityStorage.GetPendingActivities()
+	if err != nil {
+		return err
+	}
+	now := common.TimeToMillis(m.now())
+	records, err := m.activityStorage.GetAllRecords(now-uint64(burnWindow/time.Millisecond), now)
+	if err != nil {
+		return err
+	}
+	toppedUp := 0.0
+	for _, record := range records {
+		if record.Action == common.ActionOperatorTopUp && record.Params != nil && record.MiningStatus != common.MiningStatusFailed {
+			toppedUp += record.Params.Amount
+		}
+	}
+	for _, balance := range low {
+		if hasPendingTopUp(pendings, balance.Operator) {
+			m.l.Infow("operator top up is pending", "operator", balance.Operator)
+			continue
+		}
+		amount := math.Min(m.config.Operators[balance.Operator].Target-balance.Balance, m.config.MaxPerTopUp)
+		amount = math.Min(amount, m.config.MaxDaily-toppedUp)
+		if amount <= 0 {
+			m.l.Warnw("operator is low but can not be topped up", "operator", balance.Operator,
+				"balance", balance.Balance, "topped_up", toppedUp, "max_daily", m.config.MaxDaily)
+			continue
+		}
+		if _, err := m.core.TopUpOperator(balance.Operator, balance.Address, amount); err != nil {
+			m.l.Errorw("failed to top up operator", "operator", balance.Operator, "amount", amount, "err", err)
+			continue
+		}
+		toppedUp += amount
+	}
+	return nil
+}
+
+func hasPendingTopUp(pendings []common.ActivityRecord, op string) bool {
+	for _, activity := range pendings {
+		if activity.Action == common.ActionOperatorTopUp && activity.Params != nil && activity.Params.Operator == op &&
+			activity.IsPending() {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(operators map[string]ethereum.Address, addr ethereum.Address) bool {
+	for _, a := range operators {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// addSample appends s to samples and drops samples older than the burn window.
+func addSample(samples []sample, s sample) []sample {
+	samples = append(samples, s)
+	i := 0
+	for i < len(samples) && s.time.Sub(samples[i].time) > burnWindow {
+		i++
+	}
+	return samples[i:]
+}
+
+// burnPerDay returns the ETH spent per day in samples, increases of the balance by top ups or
+// other transfers are not counted.
+func burnPerDay(samples []sample) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+	duration := samples[len(samples)-1].time.Sub(samples[0].time)
+	if duration < minBurnDuration {
+		return 0
+	}
+	burnt := 0.0
+	for i := 1; i < len(samples); i++ {
+		if d := samples[i-1].balance - samples[i].balance; d > 0 {
+			burnt += d
+		}
+	}
+	return burnt * float64(24*time.Hour) / float64(duration)
+}
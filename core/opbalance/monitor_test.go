@@ -0,0 +1,136 @@
+package opbalance
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+var (
+	pricingAddress = ethereum.HexToAddress("0x1111111111111111111111111111111111111111")
+	depositAddress = ethereum.HexToAddress("0x2222222222222222222222222222222222222222")
+)
+
+type testBlockchain map[ethereum.Address]float64
+
+func (b testBlockchain) OperatorAddresses() map[string]ethereum.Address {
+	return map[string]ethereum.Address{
+		blockchain.PricingOP: pricingAddress,
+		blockchain.DepositOP: depositAddress,
+	}
+}
+
+func (b testBlockchain) GetETHBalance(addr ethereum.Address) (*big.Int, error) {
+	return common.EthToWei(b[addr]), nil
+}
+
+type topUp struct {
+	op     string
+	amount float64
+}
+
+type testCore struct {
+	topUps []topUp
+}
+
+func (c *testCore) TopUpOperator(op string, addr ethereum.Address, amount float64) (common.ActivityID, error) {
+	c.topUps = append(c.topUps, topUp{op: op, amount: amount})
+	return common.ActivityID{}, nil
+}
+
+type testActivityStorage struct {
+	pendings []common.ActivityRecord
+	records  []common.ActivityRecord
+}
+
+func (s testActivityStorage) GetPendingActivities() ([]common.ActivityRecord, error) {
+	return s.pendings, nil
+}
+
+func (s testActivityStorage) GetAllRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error) {
+	return s.records, nil
+}
+
+func TestMonitorBurnRate(t *testing.T) {
+	bc := testBlockchain{pricingAddress: 2, depositAddress: 1}
+	m := NewMonitor(bc, &testCore{}, testActivityStorage{}, common.OperatorTopUpConfig{})
+	now := time.Unix(1600000000, 0)
+	m.now = func() time.Time { return now }
+
+	m.Check()
+	balances := m.OperatorBalances()
+	require.Len(t, balances, 2)
+	require.Equal(t, blockchain.DepositOP, balances[0].Operator)
+	require.Zero(t, balances[1].BurnPerDay)
+	require.False(t, balances[1].Low)
+
+	// 0.25 ETH burnt in 6 hours, the top up in between is not counted
+	now = now.Add(3 * time.Hour)
+	bc[pricingAddress] = 1.875
+	m.Check()
+	now = now.Add(time.Hour)
+	bc[pricingAddress] = 2.875
+	m.Check()
+	now = now.Add(2 * time.Hour)
+	bc[pricingAddress] = 2.75
+	m.Check()
+
+	balances = m.OperatorBalances()
+	require.Equal(t, blockchain.PricingOP, balances[1].Operator)
+	require.InDelta(t, 1, balances[1].BurnPerDay, 1e-9)
+	require.InDelta(t, 66, balances[1].HoursLeft, 1e-9)
+	require.False(t, balances[1].Low)
+
+	m.config.MinHoursLeft = 72
+	m.Check()
+	require.True(t, m.OperatorBalances()[1].Low)
+}
+
+func TestMonitorTopUp(t *testing.T) {
+	bc := testBlockchain{pricingAddress: 0.1, depositAddress: 0.2}
+	config := common.OperatorTopUpConfig{
+		MaxPerTopUp: 1,
+		MaxDaily:    1.5,
+		Operators: map[string]common.OperatorThreshold{
+			blockchain.PricingOP: {Threshold: 0.5, Target: 2},
+			blockchain.DepositOP: {Threshold: 0.5, Target: 1},
+		},
+	}
+	core := &testCore{}
+	activities := testActivityStorage{
+		records: []common.ActivityRecord{
+			{Action: common.ActionOperatorTopUp, Params: &common.ActivityParams{Amount: 0.3}, MiningStatus: common.MiningStatusMined},
+			{Action: common.ActionOperatorTopUp, Params: &common.ActivityParams{Amount: 5}, MiningStatus: common.MiningStatusFailed},
+		},
+	}
+	m := NewMonitor(bc, core, activities, config)
+	m.Check()
+	require.Equal(t, []topUp{{op: blockchain.DepositOP, amount: 0.8}, {op: blockchain.PricingOP, amount: 0.4}}, roundTopUps(core.topUps))
+
+	core.topUps = nil
+	activities.pendings = []common.ActivityRecord{
+		{Action: common.ActionOperatorTopUp, Params: &common.ActivityParams{Operator: blockchain.PricingOP}, MiningStatus: common.MiningStatusSubmitted},
+	}
+	activities.records = nil
+	m.activityStorage = activities
+	m.Check()
+	require.Equal(t, []topUp{{op: blockchain.DepositOP, amount: 0.8}}, roundTopUps(core.topUps))
+
+	core.topUps = nil
+	m.config.MaxDaily = 0
+	m.Check()
+	require.Empty(t, core.topUps)
+}
+
+func roundTopUps(topUps []topUp) []topUp {
+	for i := range topUps {
+		topUps[i].amount = float64(int64(topUps[i].amount*1e6+0.5)) / 1e6
+	}
+	return topUps
+}
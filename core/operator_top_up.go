@@ -0,0 +1,65 @@
+package core
+
+import (
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// TopUpOperator sends amount ETH to the address of operator op and records it as an activity.
+func (rc *ReserveCore) TopUpOperator(op string, addr ethereum.Address, amount float64) (common.ActivityID, error) {
+	if amount <= 0 {
+		return common.ActivityID{}, fmt.Errorf("invalid top up amount %v", amount)
+	}
+	recommendedPrice, err := rc.gasPriceInfo.GetCurrentGas()
+	if err != nil {
+		return common.ActivityID{}, fmt.Errorf("failed to get gas: %w", err)
+	}
+	highBoundGasPrice := rc.maxGasPrice()
+	if recommendedPrice == 0 || recommendedPrice > highBoundGasPrice {
+		return common.ActivityID{}, fmt.Errorf("gasprice invalid, current price %v, highbound %v", recommendedPrice, highBoundGasPrice)
+	}
+
+	var (
+		txhex        = ethereum.Hash{}.Hex()
+		txprice      = "0"
+		miningStatus string
+		txNonce      = uint64(0)
+		errResult    = ""
+	)
+	tx, err := rc.blockchain.TopUpOperator(addr, common.EthToWei(amount), common.GweiToWei(recommendedPrice))
+	if err != nil {
+		rc.l.Errorw("failed to send operator top up tx", "operator", op, "err", err)
+		miningStatus = common.MiningStatusFailed
+		errResult = err.Error()
+	} else {
+		miningStatus = common.MiningStatusSubmitted
+		txhex = tx.Hash().Hex()
+		txNonce = tx.Nonce()
+		txprice = tx.GasPrice().Text(10)
+	}
+	uid := timebasedID(txhex)
+	sErr := rc.activityStorage.Record(
+		common.ActionOperatorTopUp,
+		uid,
+		"blockchain",
+		common.ActivityParams{
+			Amount:   amount,
+			Operator: op,
+		},
+		common.ActivityResult{
+			Tx:       txhex,
+			Nonce:    txNonce,
+			GasPrice: txprice,
+			Error:    errResult,
+		},
+		"",
+		miningStatus,
+		common.NowInMillis(),
+		true,
+	)
+	rc.l.Infow("sent operator top up tx", "operator", op, "address", addr.Hex(), "amount", amount, "tx", txhex)
+	return uid, common.CombineActivityStorageErrs(err, sErr)
+}
@@ -0,0 +1,161 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// ErrPricingContractTxPending is returned while a pricing contract tx of a setting change is not
+// mined yet, the values are verified on chain once it is.
+var ErrPricingContractTxPending = errors.New("pricing contract tx is not mined yet")
+
+// pricingContractTx is a tx sending an operation of a pricing contract change.
+type pricingContractTx struct {
+	operation string
+	send      func(gasPrice *big.Int) (*types.Transaction, error)
+}
+
+// SetStepFunctions sets the step functions of asset at position of the change list of setting
+// change id in the pricing contract. See sendPricingContractTxs for how txs are sent and verified.
+func (rc *ReserveCore) SetStepFunctions(id rtypes.SettingChangeID, position int, asset commonv3.Asset, fns commonv3.PricingStepFunctions) ([]common.ActivityID, error) {
+	return rc.sendPricingContractTxs(id, position, asset, []pricingContractTx{
+		{
+			operation: commonv3.PricingContractSetQtyStepFunction,
+			send: func(gasPrice *big.Int) (*types.Transaction, error) {
+				return rc.blockchain.SetQtyStepFunction(fns, gasPrice)
+			},
+		},
+		{
+			operation: commonv3.PricingContractSetImbalanceStepFunction,
+			send: func(gasPrice *big.Int) (*types.Transaction, error) {
+				return rc.blockchain.SetImbalanceStepFunction(fns, gasPrice)
+			},
+		},
+	}, func() error {
+		return rc.blockchain.VerifyStepFunctions(fns)
+	})
+}
+
+// SetTokenControlInfo sets the control info of asset at position of the change list of setting
+// change id in the pricing contract. See sendPricingContractTxs for how txs are sent and verified.
+func (rc *ReserveCore) SetTokenControlInfo(id rtypes.SettingChangeID, position int, asset commonv3.Asset, info commonv3.PricingControlInfo) ([]common.ActivityID, error) {
+	return rc.sendPricingContractTxs(id, position, asset, []pricingContractTx{
+		{
+			operation: commonv3.PricingContractSetTokenControlInfo,
+			send: func(gasPrice *big.Int) (*types.Transaction, error) {
+				return rc.blockchain.SetTokenControlInfo(info, gasPrice)
+			},
+		},
+	}, func() error {
+		return rc.blockchain.VerifyTokenControlInfo(info)
+	})
+}
+
+// sendPricingContractTxs sends txs with the pricing admin operator and records each of them as an
+// activity of position in setting change id. A tx already sent is not sent again, one whose
+// mining failed is. It returns ErrPricingContractTxPending until all txs are mined, then the error
+// of verify which reads the values back from the pricing contract.
+func (rc *ReserveCore) sendPricingContractTxs(id rtypes.SettingChangeID, position int, asset commonv3.Asset,
+	txs []pricingContractTx, verify func() error) ([]common.ActivityID, error) {
+	activities, err := rc.activityStorage.GetSettingChangeActivities(common.ActionPricingContract, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pricing contract activities: %w", err)
+	}
+	var (
+		ids     []common.ActivityID
+		pending bool
+	)
+	for _, tx := range txs {
+		sent, ok := sentPricingContractTx(activities, position, tx.operation)
+		if ok {
+			ids = append(ids, sent.ID)
+			if sent.MiningStatus != common.MiningStatusMined {
+				pending = true
+			}
+			continue
+		}
+		activityID, err := rc.sendPricingContractTx(id, position, asset, tx)
+		if err != nil {
+			return ids, err
+		}
+		ids = append(ids, activityID)
+		pending = true
+	}
+	if pending {
+		return ids, ErrPricingContractTxPending
+	}
+	return ids, verify()
+}
+
+// sentPricingContractTx returns the activity of the tx of operation at position which is not failed.
+func sentPricingContractTx(activities []common.ActivityRecord, position int, operation string) (common.ActivityRecord, bool) {
+	for _, activity := range activities {
+		if activity.Params != nil && activity.Params.AdminPosition == position &&
+			activity.Params.AdminOperation == operation && activity.MiningStatus != common.MiningStatusFailed {
+			return activity, true
+		}
+	}
+	return common.ActivityRecord{}, false
+}
+
+func (rc *ReserveCore) sendPricingContractTx(id rtypes.SettingChangeID, position int, asset commonv3.Asset, ptx pricingContractTx) (common.ActivityID, error) {
+	recommendedPrice, err := rc.gasPriceInfo.GetCurrentGas()
+	if err != nil {
+		return common.ActivityID{}, fmt.Errorf("failed to get gas: %w", err)
+	}
+	highBoundGasPrice := rc.maxGasPrice()
+	if recommendedPrice == 0 || recommendedPrice > highBoundGasPrice {
+		return common.ActivityID{}, fmt.Errorf("gasprice invalid, current price %v, highbound %v", recommendedPrice, highBoundGasPrice)
+	}
+
+	var (
+		txhex        = ethereum.Hash{}.Hex()
+		txprice      = "0"
+		miningStatus string
+		txNonce      = uint64(0)
+		errResult    = ""
+	)
+	tx, err := ptx.send(common.GweiToWei(recommendedPrice))
+	if err != nil {
+		rc.l.Errorw("failed to send pricing contract tx", "operation", ptx.operation, "err", err)
+		miningStatus = common.MiningStatusFailed
+		errResult = err.Error()
+	} else {
+		miningStatus = common.MiningStatusSubmitted
+		txhex = tx.Hash().Hex()
+		txNonce = tx.Nonce()
+		txprice = tx.GasPrice().Text(10)
+	}
+	uid := timebasedID(txhex)
+	sErr := rc.activityStorage.Record(
+		common.ActionPricingContract,
+		uid,
+		"blockchain",
+		common.ActivityParams{
+			Asset:           asset.ID,
+			AdminOperation:  ptx.operation,
+			SettingChangeID: id,
+			AdminPosition:   position,
+		},
+		common.ActivityResult{
+			Tx:       txhex,
+			Nonce:    txNonce,
+			GasPrice: txprice,
+			Error:    errResult,
+		},
+		"",
+		miningStatus,
+		common.NowInMillis(),
+		true,
+	)
+	rc.l.Infow("sent pricing contract tx", "setting_change_id", id, "operation", ptx.operation, "tx", txhex)
+	return uid, common.CombineActivityStorageErrs(err, sErr)
+}
@@ -0,0 +1,115 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// defaultPricingFailoverTimeout is how long a set rate tx is pending before set rate fails over
+// to a standby pricing operator by default.
+const defaultPricingFailoverTimeout = 3 * time.Minute
+
+// SetPricingFailoverTimeout sets how long a set rate tx is pending before set rate fails over to a
+// standby pricing operator.
+func (rc *ReserveCore) SetPricingFailoverTimeout(timeout time.Duration) {
+	rc.pricingFailoverTimeout = timeout
+}
+
+func (rc *ReserveCore) isPricingOperator(op string) bool {
+	for _, pricingOP := range rc.blockchain.PricingOperators() {
+		if op == pricingOP {
+			return true
+		}
+	}
+	return false
+}
+
+// pricingOperatorState is the pending set rate of a pricing operator.
+type pricingOperatorState struct {
+	op         string
+	minedNonce uint64
+	// oldNonce is nil if the operator has no pending set rate.
+	oldNonce  *big.Int
+	initPrice *big.Int
+	count     uint64
+	// since is when the first tx with oldNonce was sent.
+	since uint64
+}
+
+func (s pricingOperatorState) stuck(now uint64, timeout time.Duration) bool {
+	return s.oldNonce != nil && now > s.since && now-s.since > uint64(timeout/time.Millisecond)
+}
+
+// pricingOperatorStates returns the pending set rate of every pricing operator, the pricing
+// operator first.
+func (rc *ReserveCore) pricingOperatorStates() ([]pricingOperatorState, error) {
+	var states []pricingOperatorState
+	for _, op := range rc.blockchain.PricingOperators() {
+		minedNonce, err := rc.blockchain.GetMinedNonceWithOP(op)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get mined nonce of set rate operator %s (%s)", op, err.Error())
+		}
+		state := pricingOperatorState{op: op, minedNonce: minedNonce}
+		act, count, err := rc.activityStorage.PendingActivityForAction(op, minedNonce, common.ActionSetRate)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't check pending set rate tx pool (%s). Please try later", err.Error())
+		}
+		if act != nil {
+			gasPrice, err := strconv.ParseUint(act.Result.GasPrice, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			state.oldNonce = new(big.Int).SetUint64(act.Result.Nonce)
+			state.initPrice = new(big.Int).SetUint64(gasPrice)
+			state.count = count
+			state.since = act.Timestamp.Millis()
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// pickPricingOperator returns the index of the operator to send set rate with. The contract keeps
+// the rates of the last mined tx whatever its block, so set rate is sent by one operator at a
+// time: its pending tx is replaced until it is stuck for timeout, then set rate fails over to the
+// first operator without a pending set rate. The pricing operator is used if all are stuck.
+func pickPricingOperator(states []pricingOperatorState, now uint64, timeout time.Duration) int {
+	for i, state := range states {
+		if state.oldNonce != nil && !state.stuck(now, timeout) {
+			return i
+		}
+	}
+	for i, state := range states {
+		if state.oldNonce == nil {
+			return i
+		}
+	}
+	return 0
+}
+
+// cancelStaleSetRates cancels pending set rates of pricing operators other than op as they must not
+// be mined after the set rate of op. A cancel is replaced only once it is stuck too.
+func (rc *ReserveCore) cancelStaleSetRates(states []pricingOperatorState, op string) {
+	now := common.NowInMillis()
+	for _, state := range states {
+		if state.op == op || state.oldNonce == nil {
+			continue
+		}
+		act, _, err := rc.activityStorage.PendingActivityForAction(state.op, state.minedNonce, common.ActionCancelSetRate)
+		if err != nil {
+			rc.l.Errorw("failed to check pending cancel set rate", "op", state.op, "err", err)
+			continue
+		}
+		if act != nil && now <= act.Timestamp.Millis()+uint64(rc.pricingFailoverTimeout/time.Millisecond) {
+			continue
+		}
+		rc.l.Infow("cancel stale set rate", "op", state.op, "nonce", state.oldNonce, "set_rate_op", op)
+		if _, err := rc.cancelSetRate(state.op); err != nil {
+			rc.l.Errorw("failed to cancel stale set rate", "op", state.op, "err", err)
+		}
+	}
+}
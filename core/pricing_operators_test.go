@@ -0,0 +1,58 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+func TestPickPricingOperator(t *testing.T) {
+	const (
+		now     = uint64(10 * 60 * 1000)
+		timeout = 3 * time.Minute
+	)
+	idle := func(op string) pricingOperatorState {
+		return pricingOperatorState{op: op}
+	}
+	pending := func(op string, age time.Duration) pricingOperatorState {
+		return pricingOperatorState{op: op, oldNonce: big.NewInt(1), since: now - uint64(age/time.Millisecond)}
+	}
+	standby0 := blockchain.PricingStandbyOP(0)
+	standby1 := blockchain.PricingStandbyOP(1)
+
+	tests := []struct {
+		name     string
+		states   []pricingOperatorState
+		expected int
+	}{
+		{
+			name:     "no pending set rate uses the pricing operator",
+			states:   []pricingOperatorState{idle(blockchain.PricingOP), idle(standby0)},
+			expected: 0,
+		},
+		{
+			name:     "pending set rate is replaced",
+			states:   []pricingOperatorState{idle(blockchain.PricingOP), pending(standby0, time.Minute)},
+			expected: 1,
+		},
+		{
+			name:     "stuck set rate fails over to the first idle operator",
+			states:   []pricingOperatorState{pending(blockchain.PricingOP, 5*time.Minute), pending(standby0, 5*time.Minute), idle(standby1)},
+			expected: 2,
+		},
+		{
+			name:     "all stuck uses the pricing operator",
+			states:   []pricingOperatorState{pending(blockchain.PricingOP, 5*time.Minute), pending(standby0, 4*time.Minute)},
+			expected: 0,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, pickPricingOperator(tc.states, now, timeout))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// ReserveAdmin sends an admin operation of the reserve contract at position of the change list of
+// setting change id with the reserve admin operator and records it as an activity. An operation
+// whose tx is already sent is not sent again, the activity of the sent tx is returned.
+func (rc *ReserveCore) ReserveAdmin(id rtypes.SettingChangeID, position int, entry commonv3.ReserveAdminEntry, asset commonv3.Asset) (common.ActivityID, error) {
+	if err := entry.Validate(); err != nil {
+		return common.ActivityID{}, err
+	}
+	activities, err := rc.activityStorage.GetSettingChangeActivities(common.ActionReserveAdmin, id)
+	if err != nil {
+		return common.ActivityID{}, fmt.Errorf("failed to get reserve admin activities: %w", err)
+	}
+	for _, activity := range activities {
+		if activity.Params != nil && activity.Params.AdminPosition == position &&
+			activity.MiningStatus != common.MiningStatusFailed {
+			rc.l.Infow("reserve admin tx is already sent", "setting_change_id", id, "position", position,
+				"activity_id", activity.ID)
+			return activity.ID, nil
+		}
+	}
+	recommendedPrice, err := rc.gasPriceInfo.GetCurrentGas()
+	if err != nil {
+		return common.ActivityID{}, fmt.Errorf("failed to get gas: %w", err)
+	}
+	highBoundGasPrice := rc.maxGasPrice()
+	if recommendedPrice == 0 || recommendedPrice > highBoundGasPrice {
+		return common.ActivityID{}, fmt.Errorf("gasprice invalid, current price %v, highbound %v", recommendedPrice, highBoundGasPrice)
+	}
+
+	var (
+		txhex        = ethereum.Hash{}.Hex()
+		txprice      = "0"
+		miningStatus string
+		txNonce      = uint64(0)
+		errResult    = ""
+	)
+	tx, err := rc.blockchain.SendReserveAdmin(entry, asset, common.GweiToWei(recommendedPrice))
+	if err != nil {
+		rc.l.Errorw("failed to send reserve admin tx", "operation", entry.Operation, "err", err)
+		miningStatus = common.MiningStatusFailed
+		errResult = err.Error()
+	} else {
+		miningStatus = common.MiningStatusSubmitted
+		txhex = tx.Hash().Hex()
+		txNonce = tx.Nonce()
+		txprice = tx.GasPrice().Text(10)
+	}
+	uid := timebasedID(txhex)
+	sErr := rc.activityStorage.Record(
+		common.ActionReserveAdmin,
+		uid,
+		"blockchain",
+		common.ActivityParams{
+			Asset:           entry.AssetID,
+			Amount:          entry.Amount,
+			AdminOperation:  entry.Operation,
+			SettingChangeID: id,
+			AdminPosition:   position,
+		},
+		common.ActivityResult{
+			Tx:       txhex,
+			Nonce:    txNonce,
+			GasPrice: txprice,
+			Error:    errResult,
+		},
+		"",
+		miningStatus,
+		common.NowInMillis(),
+		true,
+	)
+	rc.l.Infow("sent reserve admin tx", "setting_change_id", id, "operation", entry.Operation, "tx", txhex)
+	return uid, common.CombineActivityStorageErrs(err, sErr)
+}
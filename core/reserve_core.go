@@ -18,34 +18,73 @@ import (
 	"github.com/KyberNetwork/reserve-data/common/gasinfo"
 	"github.com/KyberNetwork/reserve-data/data/storage"
 	"github.com/KyberNetwork/reserve-data/lib/caller"
+	"github.com/KyberNetwork/reserve-data/lib/eventbus"
 	"github.com/KyberNetwork/reserve-data/lib/rtypes"
 	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
 
+// RiskChecker checks trades, withdrawals and deposits against risk limits before they are sent.
+type RiskChecker interface {
+	CheckTrade(exchangeID rtypes.ExchangeID, tradeType string, pair commonv3.TradingPairSymbols, rate, amount float64) (float64, error)
+	CheckWithdraw(exchangeID rtypes.ExchangeID, asset commonv3.Asset, amount float64, address ethereum.Address) error
+	CheckDeposit(exchangeID rtypes.ExchangeID, asset commonv3.Asset, amount float64, address ethereum.Address) error
+}
+
 // ReserveCore instance
 type ReserveCore struct {
 	blockchain      Blockchain
 	activityStorage ActivityStorage
+	riskChecker     RiskChecker
 	addressConf     *common.ContractAddressConfiguration
 	l               *zap.SugaredLogger
 	gasPriceInfo    *gasinfo.GasPriceInfo
+
+	gasPolicyStorage GasPolicyStorage
+	rateStorage      RateStorage
+
+	// pricingFailoverTimeout is how long a set rate tx is pending before set rate fails over to a
+	// standby pricing operator.
+	pricingFailoverTimeout time.Duration
+
 	// nonce value will be use in deposit transaction
 	depositNonce int64
 }
 
-// NewReserveCore return reserve core
+// NewReserveCore return reserve core, risk checks are skipped if riskChecker is nil.
 func NewReserveCore(
 	blockchain Blockchain,
 	storage ActivityStorage,
 	addressConf *common.ContractAddressConfiguration,
-	gasPriceInfo *gasinfo.GasPriceInfo) *ReserveCore {
+	gasPriceInfo *gasinfo.GasPriceInfo,
+	riskChecker RiskChecker) *ReserveCore {
 	return &ReserveCore{
 		blockchain:      blockchain,
 		activityStorage: storage,
+		riskChecker:     riskChecker,
 		addressConf:     addressConf,
 		l:               zap.S(),
 		gasPriceInfo:    gasPriceInfo,
+
+		pricingFailoverTimeout: defaultPricingFailoverTimeout,
+	}
+}
+
+// ResetOperatorNonce drops the nonces kept for the next tx of op, the cached mined nonce of every
+// operator and the deposit nonce of the deposit operator. It must be called when the key of op is
+// switched as the nonces belong to the old key.
+func (rc *ReserveCore) ResetOperatorNonce(op string) {
+	rc.blockchain.ResetMinedNonce(op)
+	if op == blockchain.DepositOP {
+		rc.depositNonce = 0
+	}
+}
+
+// SetEventBus publishes an event to bus for every recorded activity.
+func (rc *ReserveCore) SetEventBus(bus *eventbus.Bus) {
+	if bus == nil {
+		return
 	}
+	rc.activityStorage = eventActivityStorage{ActivityStorage: rc.activityStorage, bus: bus}
 }
 
 func timebasedID(id string) common.ActivityID {
@@ -64,9 +103,9 @@ func (rc *ReserveCore) CancelOrders(orders []common.RequestOrder, exchange commo
 	)
 	result := make(map[string]common.CancelOrderResult)
 	for _, order := range orders {
-		_, err := rc.activityStorage.GetActivity(exchange.ID(), order.ID)
+		_, err := rc.activityStorage.GetActivity(exchange.Name(), order.ID)
 		if err != nil && err != storage.ErrorNotFound {
-			logger.Warnw("failed to get order", "order id", order.ID, "exchange", exchange.ID().String(), "error", err)
+			logger.Warnw("failed to get order", "order id", order.ID, "exchange", exchange.Name(), "error", err)
 			result[order.ID] = common.CancelOrderResult{
 				Success: false,
 				Error:   err.Error(),
@@ -97,6 +136,7 @@ func (rc *ReserveCore) Trade(
 	var (
 		err       error
 		isPending bool = true
+		midPrice  float64
 	)
 
 	timepoint := common.NowInMillis()
@@ -104,7 +144,7 @@ func (rc *ReserveCore) Trade(
 		uid := timebasedID(id)
 		rc.l.Infof(
 			"Core ----------> %s on %s: base: %s, quote: %s, rate: %s, amount: %s, timestamp: %d ==> Result: id: %s, done: %s, remaining: %s, finished: %t, error: %v",
-			tradeType, exchange.ID().String(), pair.BaseSymbol, pair.QuoteSymbol,
+			tradeType, exchange.Name(), pair.BaseSymbol, pair.QuoteSymbol,
 			strconv.FormatFloat(rate, 'f', -1, 64),
 			strconv.FormatFloat(amount, 'f', -1, 64), timepoint,
 			uid,
@@ -132,7 +172,7 @@ func (rc *ReserveCore) Trade(
 		return rc.activityStorage.Record(
 			common.ActionTrade,
 			uid,
-			exchange.ID().String(),
+			exchange.Name(),
 			common.ActivityParams{
 				Exchange:      exchange.ID(),
 				Type:          tradeType,
@@ -142,6 +182,7 @@ func (rc *ReserveCore) Trade(
 				Amount:        amount,
 				Timepoint:     timepoint,
 				TradingPairID: pair.ID,
+				MidPrice:      midPrice,
 			},
 			activityResult,
 			status,
@@ -151,7 +192,10 @@ func (rc *ReserveCore) Trade(
 		)
 	}
 
-	if err = sanityCheckTrading(pair, rate, amount); err != nil {
+	if err = sanityCheckTrading(pair, rate, amount); err == nil {
+		midPrice, err = rc.checkTradeRisk(exchange, tradeType, pair, rate, amount)
+	}
+	if err != nil {
 		if sErr := recordActivity("", common.ExchangeStatusFailed, 0, 0, false, err); sErr != nil {
 			rc.l.Warnw("failed to save activity record", "err", sErr)
 			return common.ActivityID{}, 0, 0, false, common.CombineActivityStorageErrs(err, sErr)
@@ -183,10 +227,10 @@ func (rc *ReserveCore) Trade(
 // TransferToSelf utility func to override nonce, this trigger manual in case core can't resolve account nonce automatically.
 func (rc *ReserveCore) TransferToSelf(op string, nonce uint64, recommendedPrice float64) (*types.Transaction, error) {
 	var action string
-	switch op {
-	case blockchain.DepositOP:
+	switch {
+	case op == blockchain.DepositOP:
 		action = common.ActionDeposit
-	case blockchain.PricingOP:
+	case rc.isPricingOperator(op):
 		action = common.ActionSetRate
 	default:
 		return nil, fmt.Errorf("op %s is invalid", op)
@@ -231,7 +275,7 @@ func (rc *ReserveCore) validateNonceInRange(op string, nonce uint64, action stri
 		return fmt.Errorf("override nonce must greater than minedNonce %v", minedNonce)
 	}
 
-	maxPendingNonce, err := rc.activityStorage.MaxPendingNonce(action)
+	maxPendingNonce, err := rc.activityStorage.MaxPendingNonce(op, action)
 	if err != nil || maxPendingNonce == 0 {
 		maxPendingNonce = int64(minedNonce)
 	}
@@ -260,7 +304,7 @@ func (rc *ReserveCore) Deposit(
 		uid := uidGenerator(txhex)
 		rc.l.Infof(
 			"Core ----------> Deposit to %s: token: %s, amount: %s, timestamp: %d ==> Result: tx: %s, error: %v",
-			exchange.ID().String(), asset.Symbol, amount.Text(10), timepoint, txhex, err,
+			exchange.Name(), asset.Symbol, amount.Text(10), timepoint, txhex, err,
 		)
 
 		activityResult := common.ActivityResult{
@@ -278,7 +322,7 @@ func (rc *ReserveCore) Deposit(
 		return rc.activityStorage.Record(
 			common.ActionDeposit,
 			uid,
-			exchange.ID().String(),
+			exchange.Name(),
 			common.ActivityParams{
 				Exchange:  exchange.ID(),
 				Asset:     asset.ID,
@@ -305,7 +349,7 @@ func (rc *ReserveCore) Deposit(
 	if tx.GasPrice() != nil {
 		gasPrice = tx.GasPrice().String()
 	}
-	rc.l.Infow("deposit_initialized", "exchange", exchange.ID().String(), "asset",
+	rc.l.Infow("deposit_initialized", "exchange", exchange.Name(), "asset",
 		asset.Address.String(), "name", asset.Name, "amount", amount.String(), "nonce", tx.Nonce(), "gas_price", gasPrice)
 
 	sErr := recordActivity(
@@ -350,6 +394,9 @@ func (rc *ReserveCore) doDeposit(exchange common.Exchange, asset commonv3.Asset,
 	if err = sanityCheckAmount(exchange, asset, amount); err != nil {
 		return nil, err
 	}
+	if err = rc.checkDepositRisk(exchange, asset, amount, address); err != nil {
+		return nil, err
+	}
 	// if there is a pending deposit tx, we replace it
 	var (
 		initPrice     *big.Int
@@ -362,7 +409,7 @@ func (rc *ReserveCore) doDeposit(exchange common.Exchange, asset commonv3.Asset,
 	}
 	rc.l.Debugw("mined nonce for deposit account", "nonce", minedNonce)
 	if rc.depositNonce == 0 {
-		maxPendingNonce, err := rc.activityStorage.MaxPendingNonce(common.ActionDeposit)
+		maxPendingNonce, err := rc.activityStorage.MaxPendingNonce(blockchain.DepositOP, common.ActionDeposit)
 		if err != nil || maxPendingNonce == 0 {
 			selectedNonce = int64(minedNonce)
 		} else {
@@ -380,7 +427,7 @@ func (rc *ReserveCore) doDeposit(exchange common.Exchange, asset commonv3.Asset,
 	// we can't handle this situation at this time.
 	oldNonce   *big.Int
 	count      uint64
-	oldNonce, initPrice, count, err = rc.pendingActionInfo(minedNonce, common.ActionDeposit)
+	oldNonce, initPrice, count, err = rc.pendingActionInfo(blockchain.DepositOP, minedNonce, common.ActionDeposit)
 	rc.l.Infof("old nonce: %v, init price: %v, count: %d, err: %+v", oldNonce, initPrice, count, err)
 	if err != nil {
 		return tx, fmt.Errorf("couldn't check pending deposit tx pool (%+v). Please try later", err)
@@ -428,7 +475,7 @@ func (rc *ReserveCore) Withdraw(exchange common.Exchange, asset commonv3.Asset,
 	activityRecord := func(id, status string, err error) error {
 		uid := timebasedID(id)
 		rc.l.Infof("Core ----------> Withdraw from %s: asset: %d, amount: %s, timestamp: %d ==> Result: id: %s, error: %s",
-			exchange.ID().String(), asset.ID, amount.Text(10), timepoint, id, err,
+			exchange.Name(), asset.ID, amount.Text(10), timepoint, id, err,
 		)
 		acitivityResult := common.ActivityResult{
 			ID: id,
@@ -444,7 +491,7 @@ func (rc *ReserveCore) Withdraw(exchange common.Exchange, asset commonv3.Asset,
 		return rc.activityStorage.Record(
 			common.ActionWithdraw,
 			uid,
-			exchange.ID().String(),
+			exchange.Name(),
 			common.ActivityParams{
 				Exchange:  exchange.ID(),
 				Asset:     asset.ID,
@@ -461,7 +508,7 @@ func (rc *ReserveCore) Withdraw(exchange common.Exchange, asset commonv3.Asset,
 
 	_, supported := exchange.Address(asset)
 	if !supported {
-		err = fmt.Errorf("exchange %s doesn't support asset %d", exchange.ID().String(), asset.ID)
+		err = fmt.Errorf("exchange %s doesn't support asset %d", exchange.Name(), asset.ID)
 		sErr := activityRecord("", common.ExchangeStatusFailed, err)
 		if sErr != nil {
 			rc.l.Warnw("failed to store activity record", "err", sErr)
@@ -469,7 +516,11 @@ func (rc *ReserveCore) Withdraw(exchange common.Exchange, asset commonv3.Asset,
 		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
 	}
 
-	if err = sanityCheckAmount(exchange, asset, amount); err != nil {
+	reserveAddr := rc.addressConf.Reserve
+	if err = sanityCheckAmount(exchange, asset, amount); err == nil {
+		err = rc.checkWithdrawRisk(exchange, asset, amount, reserveAddr)
+	}
+	if err != nil {
 		sErr := activityRecord("", common.ExchangeStatusFailed, err)
 		if sErr != nil {
 			rc.l.Warnw("failed to store activity record", "err", sErr)
@@ -477,8 +528,6 @@ func (rc *ReserveCore) Withdraw(exchange common.Exchange, asset commonv3.Asset,
 		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
 	}
 
-	reserveAddr := rc.addressConf.Reserve
-
 	id, err := exchange.Withdraw(asset, amount, reserveAddr)
 	if err != nil {
 		rc.l.Errorw("init withdraw failed", "err", err, "asset", asset.Address.String(),
@@ -513,8 +562,8 @@ func calculateNewGasPrice(initPrice *big.Int, count uint64, highBoundGasPrice fl
 }
 
 // return: old nonce, init price, step, error
-func (rc *ReserveCore) pendingActionInfo(minedNonce uint64, activityType string) (*big.Int, *big.Int, uint64, error) {
-	act, count, err := rc.activityStorage.PendingActivityForAction(minedNonce, activityType)
+func (rc *ReserveCore) pendingActionInfo(op string, minedNonce uint64, activityType string) (*big.Int, *big.Int, uint64, error) {
+	act, count, err := rc.activityStorage.PendingActivityForAction(op, minedNonce, activityType)
 	if err != nil {
 		return nil, nil, 0, err
 	}
@@ -544,24 +593,45 @@ func requireSameLength(tokens []commonv3.Asset, buys, sells, afpMids []*big.Int)
 	return nil
 }
 
-// CancelSetRate create and send a tx with higher gas price to cancel all pending set rate tx
+// CancelSetRate create and send a tx with higher gas price to cancel all pending set rate tx of
+// every pricing operator, it returns the activity of the first cancel tx.
 func (rc *ReserveCore) CancelSetRate() (common.ActivityID, error) {
-	minedNonce, err := rc.blockchain.GetMinedNonceWithOP(blockchain.PricingOP)
+	var result common.ActivityID
+	for _, op := range rc.blockchain.PricingOperators() {
+		id, err := rc.cancelSetRate(op)
+		if err != nil {
+			return result, err
+		}
+		if result == (common.ActivityID{}) {
+			result = id
+		}
+	}
+	return result, nil
+}
+
+// cancelSetRate cancels pending set rate tx of pricing operator op, it does nothing if op has no
+// pending set rate.
+func (rc *ReserveCore) cancelSetRate(op string) (common.ActivityID, error) {
+	minedNonce, err := rc.blockchain.GetMinedNonceWithOP(op)
 	if err != nil {
-		return common.ActivityID{}, fmt.Errorf("couldn't get mined nonce of set rate operator (%+v)", err)
+		return common.ActivityID{}, fmt.Errorf("couldn't get mined nonce of set rate operator %s (%+v)", op, err)
 	}
-	oldNonce, initPrice, count, err := rc.pendingActionInfo(minedNonce, common.ActionCancelSetRate)
+	oldNonce, initPrice, count, err := rc.pendingActionInfo(op, minedNonce, common.ActionCancelSetRate)
 	if err != nil || oldNonce == nil { // if there's no pending cancel setrate exist, we use nonce from actionSetRate
-		oldNonce, initPrice, count, err = rc.pendingActionInfo(minedNonce, common.ActionSetRate)
+		oldNonce, initPrice, count, err = rc.pendingActionInfo(op, minedNonce, common.ActionSetRate)
 	}
-	if err != nil || oldNonce == nil {
-		rc.l.Errorw("failed to find pending setRate to cancel", "err", err)
+	if err != nil {
+		rc.l.Errorw("failed to find pending setRate to cancel", "op", op, "err", err)
 		return common.ActivityID{}, err
 	}
+	if oldNonce == nil {
+		rc.l.Infow("no pending setRate to cancel", "op", op)
+		return common.ActivityID{}, nil
+	}
 	highBoundGasPrice := rc.maxGasPrice()
 	newPrice := calculateNewGasPrice(initPrice, count, highBoundGasPrice)
 
-	rc.l.Infow("cancel setRate tx with info", "newPrice", newPrice.String(), "highBoundGasPrice", highBoundGasPrice,
+	rc.l.Infow("cancel setRate tx with info", "op", op, "newPrice", newPrice.String(), "highBoundGasPrice", highBoundGasPrice,
 		"count", count, "nonce", oldNonce.String())
 
 	tx, err := rc.blockchain.BuildSendETHTx(blockchain.TxOpts{
@@ -582,7 +652,7 @@ func (rc *ReserveCore) CancelSetRate() (common.ActivityID, error) {
 		errResult    = ""
 	)
 
-	btx, err := rc.blockchain.SignAndBroadcast(tx, blockchain.PricingOP)
+	btx, err := rc.blockchain.SignAndBroadcast(tx, op)
 	if err != nil {
 		rc.l.Errorw("failed to sign and broadcast tx", "err", err)
 		miningStatus = common.MiningStatusFailed
@@ -599,6 +669,7 @@ func (rc *ReserveCore) CancelSetRate() (common.ActivityID, error) {
 		Nonce:    txNonce,
 		GasPrice: txprice,
 		Error:    errResult,
+		Operator: op,
 	}
 	sErr := rc.activityStorage.Record(
 		common.ActionCancelSetRate,
@@ -612,87 +683,90 @@ func (rc *ReserveCore) CancelSetRate() (common.ActivityID, error) {
 		true,
 	)
 
-	rc.l.Infow("sent cancel setRate tx", "tx", btx.Hash().String(), "gasPrice",
+	rc.l.Infow("sent cancel setRate tx", "op", op, "tx", txhex, "gasPrice",
 		newPrice.String(), "nonce", oldNonce.String())
 
 	return uid, common.CombineActivityStorageErrs(err, sErr)
 }
 
-// GetSetRateResult return result of set rate action
+// GetSetRateResult return result of set rate action and the pricing operator sending it
 func (rc *ReserveCore) GetSetRateResult(tokens []commonv3.Asset,
 	buys, sells, afpMids []*big.Int,
-	block *big.Int) (*types.Transaction, error) {
+	block *big.Int) (*types.Transaction, string, error) {
 	var (
 		tx  *types.Transaction
 		err error
 	)
 	err = requireSameLength(tokens, buys, sells, afpMids)
 	if err != nil {
-		return tx, err
+		return tx, "", err
 	}
 	if err = sanityCheck(buys, afpMids, sells, rc.l); err != nil {
-		return tx, err
+		return tx, "", err
 	}
 	var tokenAddrs []ethereum.Address
 	for _, token := range tokens {
 		tokenAddrs = append(tokenAddrs, token.Address)
 	}
 	// if there is a pending set rate tx, we replace it
-	var (
-		oldNonce   *big.Int
-		initPrice  *big.Int
-		minedNonce uint64
-		count      uint64
-	)
 	highBoundGasPrice := rc.maxGasPrice()
-	minedNonce, err = rc.blockchain.GetMinedNonceWithOP(blockchain.PricingOP)
+	states, err := rc.pricingOperatorStates()
 	if err != nil {
-		return tx, fmt.Errorf("couldn't get mined nonce of set rate operator (%s)", err.Error())
-	}
-	oldNonce, initPrice, count, err = rc.pendingActionInfo(minedNonce, common.ActionSetRate)
-	rc.l.Infof("old nonce: %v, init price: %v, count: %d, err: %v", oldNonce, initPrice, count, err)
-	if err != nil {
-		return tx, fmt.Errorf("couldn't check pending set rate tx pool (%s). Please try later", err.Error())
-	}
-	if oldNonce != nil {
-		newPrice := calculateNewGasPrice(initPrice, count, highBoundGasPrice)
+		return tx, "", err
+	}
+	state := states[pickPricingOperator(states, common.NowInMillis(), rc.pricingFailoverTimeout)]
+	op := state.op
+	rc.l.Infof("op: %s, old nonce: %v, init price: %v, count: %d", op, state.oldNonce, state.initPrice, state.count)
+	gasLimit := rc.setRateGasLimit(tokens, buys, sells, block, highBoundGasPrice)
+	rc.l.Infow("set rate gas limit", "urgency", gasLimit.urgency, "multiplier", gasLimit.multiplier,
+		"maxGasPrice", gasLimit.maxGasPrice)
+	if state.oldNonce != nil {
+		// a replacement must not be cheaper than the pending tx
+		maxGasPrice := math.Max(gasLimit.maxGasPrice, common.BigToFloat(state.initPrice, 9))
+		newPrice := calculateNewGasPrice(state.initPrice, state.count, maxGasPrice)
 		tx, err = rc.blockchain.SetRates(
+			op,
 			tokenAddrs, buys, sells, block,
-			oldNonce,
+			state.oldNonce,
 			newPrice,
 		)
 		if err != nil {
-			rc.l.Errorw("Trying to replace old tx failed", "err", err)
-			return tx, err
+			rc.l.Errorw("Trying to replace old tx failed", "op", op, "err", err)
+			return tx, op, err
 		}
 		rc.l.Infof("Trying to replace old tx with new price: %s, tx: %s, init price: %s, count: %d",
 			newPrice.String(),
 			tx.Hash().Hex(),
-			initPrice.String(),
-			count,
+			state.initPrice.String(),
+			state.count,
 		)
-		return tx, err
+		rc.cancelStaleSetRates(states, op)
+		return tx, op, err
 	}
 
 	recommendedPrice, err := rc.gasPriceInfo.GetCurrentGas()
 	if err != nil {
 		rc.l.Errorw("failed to get gas price, use default", "err", err)
-		return nil, fmt.Errorf("setrate failed to get gas price %w", err)
+		return nil, op, fmt.Errorf("setrate failed to get gas price %w", err)
 	}
 	if recommendedPrice == 0 || recommendedPrice > highBoundGasPrice {
 		rc.l.Errorw("failed to get gas price", "err", err, "gas",
 			recommendedPrice, "highBound", highBoundGasPrice)
-		return nil, fmt.Errorf("setrate failed to query gas price got value %v highBound %v",
+		return nil, op, fmt.Errorf("setrate failed to query gas price got value %v highBound %v",
 			recommendedPrice, highBoundGasPrice)
 	}
-	initPrice = common.GweiToWei(recommendedPrice)
-	rc.l.Infof("initial set rate tx, init price: %s", initPrice.String())
+	initPrice := common.GweiToWei(gasLimit.initialGasPrice(recommendedPrice))
+	rc.l.Infof("initial set rate tx, op: %s, recommended price: %v, init price: %s", op, recommendedPrice, initPrice.String())
 	tx, err = rc.blockchain.SetRates(
+		op,
 		tokenAddrs, buys, sells, block,
-		big.NewInt(int64(minedNonce)),
+		big.NewInt(int64(state.minedNonce)),
 		initPrice,
 	)
-	return tx, err
+	if err == nil {
+		rc.cancelStaleSetRates(states, op)
+	}
+	return tx, op, err
 }
 
 // SetRates to reserve
@@ -707,7 +781,7 @@ func (rc *ReserveCore) SetRates(assets []commonv3.Asset, buys, sells []*big.Int,
 		miningStatus string
 	)
 
-	tx, err = rc.GetSetRateResult(assets, buys, sells, afpMids, block)
+	tx, op, err := rc.GetSetRateResult(assets, buys, sells, afpMids, block)
 	if err != nil {
 		rc.l.Errorw("failed to get set rate result", "err", err)
 		miningStatus = common.MiningStatusFailed
@@ -727,6 +801,7 @@ func (rc *ReserveCore) SetRates(assets []commonv3.Asset, buys, sells []*big.Int,
 		Nonce:    txnonce,
 		GasPrice: txprice,
 		Error:    "",
+		Operator: op,
 	}
 	if err != nil {
 		activityResult.Error = err.Error()
@@ -801,7 +876,7 @@ func (rc *ReserveCore) SpeedupDeposit(act common.ActivityRecord) (*big.Int, erro
 	return gasPrice, rc.activityStorage.Record(
 		common.ActionDeposit,
 		replaceUID(act.ID, hash.Hex()),
-		act.Params.Exchange.String(),
+		act.Destination,
 		*act.Params,
 		activityResult,
 		"",
@@ -868,6 +943,43 @@ func sanityCheckAmount(exchange common.Exchange, asset commonv3.Asset, amount *b
 	return nil
 }
 
+// checkTradeRisk returns the mid price the trade is checked against, it is recorded with the trade.
+func (rc *ReserveCore) checkTradeRisk(exchange common.Exchange, tradeType string, pair commonv3.TradingPairSymbols, rate, amount float64) (float64, error) {
+	if rc.riskChecker == nil {
+		return 0, nil
+	}
+	mid, err := rc.riskChecker.CheckTrade(exchange.ID(), tradeType, pair, rate, amount)
+	if err != nil {
+		rc.l.Warnw("trade rejected by risk check", "exchange", exchange.Name(), "pair", pair.ID,
+			"type", tradeType, "rate", rate, "amount", amount, "err", err)
+	}
+	return mid, err
+}
+
+func (rc *ReserveCore) checkWithdrawRisk(exchange common.Exchange, asset commonv3.Asset, amount *big.Int, address ethereum.Address) error {
+	if rc.riskChecker == nil {
+		return nil
+	}
+	err := rc.riskChecker.CheckWithdraw(exchange.ID(), asset, common.BigToFloat(amount, int64(asset.Decimals)), address)
+	if err != nil {
+		rc.l.Warnw("withdraw rejected by risk check", "exchange", exchange.Name(), "asset", asset.ID,
+			"amount", amount.String(), "address", address.Hex(), "err", err)
+	}
+	return err
+}
+
+func (rc *ReserveCore) checkDepositRisk(exchange common.Exchange, asset commonv3.Asset, amount *big.Int, address ethereum.Address) error {
+	if rc.riskChecker == nil {
+		return nil
+	}
+	err := rc.riskChecker.CheckDeposit(exchange.ID(), asset, common.BigToFloat(amount, int64(asset.Decimals)), address)
+	if err != nil {
+		rc.l.Warnw("deposit rejected by risk check", "exchange", exchange.Name(), "asset", asset.ID,
+			"amount", amount.String(), "address", address.Hex(), "err", err)
+	}
+	return err
+}
+
 func calculateRate(theDividend, divisor *big.Float) *big.Float {
 	div := big.NewFloat(0)
 	div.Quo(theDividend, divisor)
@@ -27,6 +27,10 @@ func (te testExchange) ID() rtypes.ExchangeID {
 	return rtypes.Binance
 }
 
+func (te testExchange) Name() string {
+	return rtypes.Binance.String()
+}
+
 func (te testExchange) Address(_ commonv3.Asset) (address ethereum.Address, supported bool) {
 	return ethereum.Address{}, true
 }
@@ -74,6 +78,34 @@ func (tbc testBlockchain) SpeedupDeposit(tx ethereum.Hash, gasPrice *big.Int) (e
 	panic("implement me")
 }
 
+func (tbc testBlockchain) SendReserveAdmin(entry commonv3.ReserveAdminEntry, asset commonv3.Asset, gasPrice *big.Int) (*types.Transaction, error) {
+	return nil, errors.New("not supported")
+}
+
+func (tbc testBlockchain) SetQtyStepFunction(fns commonv3.PricingStepFunctions, gasPrice *big.Int) (*types.Transaction, error) {
+	return nil, errors.New("not supported")
+}
+
+func (tbc testBlockchain) SetImbalanceStepFunction(fns commonv3.PricingStepFunctions, gasPrice *big.Int) (*types.Transaction, error) {
+	return nil, errors.New("not supported")
+}
+
+func (tbc testBlockchain) SetTokenControlInfo(info commonv3.PricingControlInfo, gasPrice *big.Int) (*types.Transaction, error) {
+	return nil, errors.New("not supported")
+}
+
+func (tbc testBlockchain) VerifyStepFunctions(fns commonv3.PricingStepFunctions) error {
+	return nil
+}
+
+func (tbc testBlockchain) VerifyTokenControlInfo(info commonv3.PricingControlInfo) error {
+	return errors.New("control info differs on chain")
+}
+
+func (tbc testBlockchain) TopUpOperator(addr ethereum.Address, amount *big.Int, gasPrice *big.Int) (*types.Transaction, error) {
+	return nil, errors.New("not supported")
+}
+
 func (tbc testBlockchain) BuildSendETHTx(opts blockchain.TxOpts, to ethereum.Address) (*types.Transaction, error) {
 	return nil, errors.New("not supported")
 }
@@ -82,6 +114,10 @@ func (tbc testBlockchain) GetDepositOPAddress() ethereum.Address {
 	return ethereum.Address{}
 }
 
+func (tbc testBlockchain) PricingOperators() []string {
+	return []string{blockchain.PricingOP}
+}
+
 func (tbc testBlockchain) SignAndBroadcast(tx *types.Transaction, from string) (*types.Transaction, error) {
 	return nil, errors.New("not supported")
 }
@@ -103,6 +139,7 @@ func (tbc testBlockchain) Send(
 }
 
 func (tbc testBlockchain) SetRates(
+	op string,
 	tokens []ethereum.Address,
 	buys []*big.Int,
 	sells []*big.Int,
@@ -127,11 +164,51 @@ func (tbc testBlockchain) GetMinedNonceWithOP(string) (uint64, error) {
 	return 0, nil
 }
 
+func (tbc testBlockchain) ResetMinedNonce(string) {
+}
+
+// nonceBlockchain is a testBlockchain recording the nonces of sent deposits.
+type nonceBlockchain struct {
+	testBlockchain
+	mined  uint64
+	sent   []uint64
+	resets []string
+}
+
+func (b *nonceBlockchain) GetMinedNonceWithOP(string) (uint64, error) {
+	return b.mined, nil
+}
+
+func (b *nonceBlockchain) ResetMinedNonce(op string) {
+	b.resets = append(b.resets, op)
+}
+
+func (b *nonceBlockchain) Send(
+	asset commonv3.Asset,
+	amount *big.Int,
+	address ethereum.Address,
+	nonce *big.Int,
+	gasPrice *big.Int) (*types.Transaction, error) {
+	b.sent = append(b.sent, nonce.Uint64())
+	return b.testBlockchain.Send(asset, amount, address, nonce, gasPrice)
+}
+
 type testActivityStorage struct {
-	PendingDeposit bool
+	PendingDeposit          bool
+	SettingChangeActivities []common.ActivityRecord
 }
 
-func (tas testActivityStorage) MaxPendingNonce(action string) (int64, error) {
+func (tas testActivityStorage) GetSettingChangeActivities(action string, id rtypes.SettingChangeID) ([]common.ActivityRecord, error) {
+	var activities []common.ActivityRecord
+	for _, activity := range tas.SettingChangeActivities {
+		if activity.Action == action {
+			activities = append(activities, activity)
+		}
+	}
+	return activities, nil
+}
+
+func (tas testActivityStorage) MaxPendingNonce(op string, action string) (int64, error) {
 	return 0, nil
 }
 
@@ -148,16 +225,16 @@ func (tas testActivityStorage) Record(
 	return nil
 }
 
-func (tas testActivityStorage) GetActivity(exchangeID rtypes.ExchangeID, id string) (common.ActivityRecord, error) {
+func (tas testActivityStorage) GetActivity(exchange string, id string) (common.ActivityRecord, error) {
 	return common.ActivityRecord{}, nil
 }
 
-func (tas testActivityStorage) PendingActivityForAction(minedNonce uint64, activityType string) (*common.ActivityRecord, uint64, error) {
+func (tas testActivityStorage) PendingActivityForAction(op string, minedNonce uint64, activityType string) (*common.ActivityRecord, uint64, error) {
 	return nil, 0, nil
 }
 
 func (tas testActivityStorage) HasPendingDeposit(token commonv3.Asset, exchange common.Exchange) (bool, error) {
-	if token.Symbol == "OMG" && exchange.ID().String() == "binance" {
+	if token.Symbol == "OMG" && exchange.Name() == "binance" {
 		return tas.PendingDeposit, nil
 	}
 	return false, nil
@@ -166,8 +243,8 @@ func (tas testActivityStorage) HasPendingDeposit(token commonv3.Asset, exchange
 func getTestCore(hasPendingDeposit bool) *ReserveCore {
 	addressSetting := &common.ContractAddressConfiguration{}
 
-	return NewReserveCore(testBlockchain{}, testActivityStorage{hasPendingDeposit}, addressSetting,
-		gasinfo.NewGasPriceInfo(&gasinfo.ConstGasPriceLimiter{}, &ExampleGasConfig{}, &ExampleGasClient{}))
+	return NewReserveCore(testBlockchain{}, testActivityStorage{PendingDeposit: hasPendingDeposit}, addressSetting,
+		gasinfo.NewGasPriceInfo(&gasinfo.ConstGasPriceLimiter{}, &ExampleGasConfig{}, &ExampleGasClient{}), nil)
 }
 
 type ExampleGasConfig struct {
@@ -272,3 +349,99 @@ func TestCalculateNewGasPrice(t *testing.T) {
 		prevPrice = newPrice
 	}
 }
+
+func TestReserveAdminSkipsSentOperation(t *testing.T) {
+	sent := common.ActivityID{Timepoint: 1, EID: "sent"}
+	core := getTestCore(false)
+	core.activityStorage = testActivityStorage{SettingChangeActivities: []common.ActivityRecord{
+		{ID: sent, Action: common.ActionReserveAdmin, Params: &common.ActivityParams{SettingChangeID: 1, AdminPosition: 0},
+			MiningStatus: common.MiningStatusMined},
+		{ID: common.ActivityID{Timepoint: 2, EID: "failed"}, Action: common.ActionReserveAdmin,
+			Params: &common.ActivityParams{SettingChangeID: 1, AdminPosition: 1}, MiningStatus: common.MiningStatusFailed},
+	}}
+	entry := commonv3.ReserveAdminEntry{Operation: commonv3.ReserveAdminDisableTrade}
+
+	id, err := core.ReserveAdmin(1, 0, entry, commonv3.Asset{})
+	if err != nil || id != sent {
+		t.Fatalf("expected sent operation %v to be skipped, got %v, err: %v", sent, id, err)
+	}
+
+	// the failed operation is sent again, sending is not supported by the test blockchain
+	if _, err = core.ReserveAdmin(1, 1, entry, commonv3.Asset{}); err == nil {
+		t.Fatalf("expected failed operation to be sent again")
+	}
+}
+
+func TestPricingContractSkipsSentTxs(t *testing.T) {
+	activity := func(eid string, position int, operation, status string) common.ActivityRecord {
+		return common.ActivityRecord{
+			ID:           common.ActivityID{Timepoint: 1, EID: eid},
+			Action:       common.ActionPricingContract,
+			Params:       &common.ActivityParams{SettingChangeID: 1, AdminPosition: position, AdminOperation: operation},
+			MiningStatus: status,
+		}
+	}
+	core := getTestCore(false)
+	core.activityStorage = testActivityStorage{SettingChangeActivities: []common.ActivityRecord{
+		activity("qty", 0, commonv3.PricingContractSetQtyStepFunction, common.MiningStatusMined),
+		activity("imbalance", 0, commonv3.PricingContractSetImbalanceStepFunction, common.MiningStatusSubmitted),
+		activity("qty-mined", 1, commonv3.PricingContractSetQtyStepFunction, common.MiningStatusMined),
+		activity("imbalance-mined", 1, commonv3.PricingContractSetImbalanceStepFunction, common.MiningStatusMined),
+		activity("control-info", 2, commonv3.PricingContractSetTokenControlInfo, common.MiningStatusMined),
+		activity("failed", 3, commonv3.PricingContractSetTokenControlInfo, common.MiningStatusFailed),
+	}}
+
+	ids, err := core.SetStepFunctions(1, 0, commonv3.Asset{}, commonv3.PricingStepFunctions{})
+	if !errors.Is(err, ErrPricingContractTxPending) || len(ids) != 2 {
+		t.Fatalf("expected sent txs to be pending, got %v, err: %v", ids, err)
+	}
+	if _, err = core.SetStepFunctions(1, 1, commonv3.Asset{}, commonv3.PricingStepFunctions{}); err != nil {
+		t.Fatalf("expected mined step functions to be verified, err: %v", err)
+	}
+	// the control info is mined but differs on chain in the test blockchain
+	if _, err = core.SetTokenControlInfo(1, 2, commonv3.Asset{}, commonv3.PricingControlInfo{}); err == nil ||
+		errors.Is(err, ErrPricingContractTxPending) {
+		t.Fatalf("expected mined control info to fail verification, err: %v", err)
+	}
+	// the failed tx is sent again, sending is not supported by the test blockchain
+	if _, err = core.SetTokenControlInfo(1, 3, commonv3.Asset{}, commonv3.PricingControlInfo{}); err == nil ||
+		errors.Is(err, ErrPricingContractTxPending) {
+		t.Fatalf("expected failed tx to be sent again, err: %v", err)
+	}
+}
+
+func TestResetOperatorNonce(t *testing.T) {
+	bc := &nonceBlockchain{mined: 42}
+	core := getTestCore(false)
+	core.blockchain = bc
+	knc := commonv3.Asset{
+		Symbol:       "KNC",
+		Address:      ethereum.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Decimals:     12,
+		Transferable: true,
+	}
+	deposit := func() {
+		if _, err := core.Deposit(testExchange{}, knc, big.NewInt(10), common.NowInMillis()); err != nil {
+			t.Fatalf("failed to deposit: %v", err)
+		}
+	}
+
+	deposit()
+	deposit()
+	// the deposit key is switched to a key with a lower mined nonce
+	bc.mined = 3
+	deposit()
+	if bc.sent[2] != 44 {
+		t.Fatalf("expected deposit nonce kept by core to be used before reset, got %d", bc.sent[2])
+	}
+	core.ResetOperatorNonce(blockchain.DepositOP)
+	deposit()
+	if bc.sent[3] != 3 {
+		t.Fatalf("expected mined nonce of the new key to be used after reset, got %d", bc.sent[3])
+	}
+
+	core.ResetOperatorNonce(blockchain.PricingOP)
+	if len(bc.resets) != 2 || bc.resets[0] != blockchain.DepositOP || bc.resets[1] != blockchain.PricingOP {
+		t.Fatalf("expected cached mined nonces of deposit and pricing operators to be reset, got %v", bc.resets)
+	}
+}
@@ -0,0 +1,327 @@
+package risk
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// Rules checked by Checker, they are reported in Violation.
+const (
+	RuleKillSwitch     = "kill_switch"
+	RuleOrderNotional  = "max_order_notional"
+	RuleHourlyNotional = "max_hourly_notional"
+	RulePriceDeviation = "max_price_deviation"
+	RuleDailyLoss      = "max_daily_loss"
+	RuleDailyWithdraw  = "max_daily_withdraw"
+	RuleAddressAllowed = "address_allowlist"
+)
+
+const (
+	tradeTypeBuy = "buy"
+	hourlyWindow = time.Hour
+	dailyWindow  = 24 * time.Hour
+)
+
+// Violation is returned when a trade, withdraw or deposit breaks a risk limit.
+type Violation struct {
+	Rule  string
+	Value float64
+	Limit float64
+	// Detail describes the violation of rules without a numeric limit.
+	Detail string
+}
+
+func (v *Violation) Error() string {
+	switch {
+	case v.Rule == RuleKillSwitch:
+		return "risk check failed: kill switch is on"
+	case v.Detail != "":
+		return fmt.Sprintf("risk check failed: %s %s", v.Rule, v.Detail)
+	}
+	return fmt.Sprintf("risk check failed: %s %v exceeds limit %v", v.Rule, v.Value, v.Limit)
+}
+
+// SettingReader reads risk limits and trading pairs from setting storage.
+type SettingReader interface {
+	GetRiskLimits() (commonv3.RiskLimits, error)
+	GetKillSwitch() (bool, error)
+	GetTradingPair(id rtypes.TradingPairID, withDeleted bool) (commonv3.TradingPairSymbols, error)
+	GetAddressAllowlist() ([]commonv3.AllowedAddress, error)
+}
+
+// ActivityReader reads the activities core made.
+type ActivityReader interface {
+	GetAllRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error)
+}
+
+// PriceReader reads the latest order book of trading pairs.
+type PriceReader interface {
+	CurrentPriceVersion(timepoint uint64) (common.Version, error)
+	GetOnePrice(pairID rtypes.TradingPairID, v common.Version) (common.OnePrice, error)
+}
+
+// Checker checks trades, withdrawals and deposits against the risk limits in setting storage.
+type Checker struct {
+	sr  SettingReader
+	ar  ActivityReader
+	pr  PriceReader
+	now func() time.Time
+	l   *zap.SugaredLogger
+}
+
+// NewChecker creates a new Checker.
+func NewChecker(sr SettingReader, ar ActivityReader, pr PriceReader) *Checker {
+	return &Checker{
+		sr:  sr,
+		ar:  ar,
+		pr:  pr,
+		now: time.Now,
+		l:   zap.S(),
+	}
+}
+
+func (c *Checker) checkKillSwitch() error {
+	on, err := c.sr.GetKillSwitch()
+	if err != nil {
+		return fmt.Errorf("failed to get kill switch: %w", err)
+	}
+	if on {
+		return &Violation{Rule: RuleKillSwitch}
+	}
+	return nil
+}
+
+func checkLimit(rule string, value, limit float64) error {
+	if limit > 0 && value > limit {
+		return &Violation{Rule: rule, Value: value, Limit: limit}
+	}
+	return nil
+}
+
+// records returns the activities of action in the window until now.
+func (c *Checker) records(action string, window time.Duration) ([]common.ActivityRecord, error) {
+	now := c.now()
+	records, err := c.ar.GetAllRecords(common.TimeToMillis(now.Add(-window)), common.TimeToMillis(now))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activities: %w", err)
+	}
+	var result []common.ActivityRecord
+	for _, r := range records {
+		if r.Action == action && r.Params != nil {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+// tradedAmount returns the base amount an order may have traded, a cancelled order only traded
+// the filled part and a failed one traded nothing.
+func tradedAmount(r common.ActivityRecord) float64 {
+	switch r.ExchangeStatus {
+	case common.ExchangeStatusFailed:
+		return 0
+	case common.ExchangeStatusCancelled:
+		if r.Result != nil {
+			return r.Result.Done
+		}
+		return 0
+	}
+	return r.Params.Amount
+}
+
+// midPrice returns the middle of best bid and best ask of pair on exchange in the latest prices.
+func (c *Checker) midPrice(exchangeID rtypes.ExchangeID, pairID rtypes.TradingPairID) (float64, error) {
+	version, err := c.pr.CurrentPriceVersion(common.TimeToMillis(c.now()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get price version: %w", err)
+	}
+	onePrice, err := c.pr.GetOnePrice(pairID, version)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get price of pair %d: %w", pairID, err)
+	}
+	price, ok := onePrice[exchangeID]
+	if !ok || !price.Valid || len(price.Bids) == 0 || len(price.Asks) == 0 {
+		return 0, fmt.Errorf("no valid price of pair %d on exchange %s", pairID, exchangeID)
+	}
+	bid, ask := 0.0, math.MaxFloat64
+	for _, e := range price.Bids {
+		bid = math.Max(bid, e.Rate)
+	}
+	for _, e := range price.Asks {
+		ask = math.Min(ask, e.Rate)
+	}
+	return (bid + ask) / 2, nil
+}
+
+// tradeLoss returns the loss of an order valued at mid price, it is negative for a profit.
+func tradeLoss(tradeType string, rate, amount, mid float64) float64 {
+	if tradeType == tradeTypeBuy {
+		return (rate - mid) * amount
+	}
+	return (mid - rate) * amount
+}
+
+// quoteReader returns the quote asset of trading pairs, each pair is read from setting storage once.
+type quoteReader struct {
+	sr     SettingReader
+	quotes map[rtypes.TradingPairID]rtypes.AssetID
+}
+
+func (r *quoteReader) quote(id rtypes.TradingPairID) (rtypes.AssetID, error) {
+	if quote, ok := r.quotes[id]; ok {
+		return quote, nil
+	}
+	pair, err := r.sr.GetTradingPair(id, true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get trading pair %d: %w", id, err)
+	}
+	r.quotes[id] = pair.Quote
+	return pair.Quote, nil
+}
+
+// CheckTrade checks an order against kill switch, notional, price deviation and daily loss limits.
+// It returns the mid price of pair on exchange the order is checked against, to be recorded with
+// the trade so its loss is valued at the mid price when it was made. The mid price is 0 if there
+// is no valid price and no limit needs it.
+func (c *Checker) CheckTrade(exchangeID rtypes.ExchangeID, tradeType string, pair commonv3.TradingPairSymbols, rate, amount float64) (float64, error) {
+	if err := c.checkKillSwitch(); err != nil {
+		return 0, err
+	}
+	limits, err := c.sr.GetRiskLimits()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get risk limits: %w", err)
+	}
+	lossLimit := limits.MaxDailyLoss[pair.Quote]
+	mid, err := c.midPrice(exchangeID, pair.ID)
+	if err != nil {
+		if limits.MaxPriceDeviation > 0 || lossLimit > 0 {
+			return 0, err
+		}
+		c.l.Warnw("no mid price to record with trade", "exchange", exchangeID.String(), "pair", pair.ID, "err", err)
+		mid = 0
+	}
+	notional := rate * amount
+	if err := checkLimit(RuleOrderNotional, notional, limits.MaxOrderNotional[pair.Quote]); err != nil {
+		return mid, err
+	}
+
+	quotes := &quoteReader{sr: c.sr, quotes: map[rtypes.TradingPairID]rtypes.AssetID{pair.ID: pair.Quote}}
+	if limit := limits.MaxHourlyNotional[pair.Quote]; limit > 0 {
+		trades, err := c.records(common.ActionTrade, hourlyWindow)
+		if err != nil {
+			return mid, err
+		}
+		total := notional
+		for _, t := range trades {
+			quote, err := quotes.quote(t.Params.TradingPairID)
+			if err != nil {
+				return mid, err
+			}
+			if quote == pair.Quote {
+				total += t.Params.Rate * tradedAmount(t)
+			}
+		}
+		if err := checkLimit(RuleHourlyNotional, total, limit); err != nil {
+			return mid, err
+		}
+	}
+
+	if limits.MaxPriceDeviation > 0 {
+		deviation := math.Abs(rate-mid) / mid
+		if err := checkLimit(RulePriceDeviation, deviation, limits.MaxPriceDeviation); err != nil {
+			return mid, err
+		}
+	}
+	if lossLimit > 0 {
+		trades, err := c.records(common.ActionTrade, dailyWindow)
+		if err != nil {
+			return mid, err
+		}
+		loss := tradeLoss(tradeType, rate, amount, mid)
+		for _, t := range trades {
+			quote, err := quotes.quote(t.Params.TradingPairID)
+			if err != nil {
+				return mid, err
+			}
+			if quote != pair.Quote {
+				continue
+			}
+			if t.Params.MidPrice == 0 {
+				c.l.Warnw("skip trade without recorded mid price in daily loss", "id", t.ID)
+				continue
+			}
+			loss += tradeLoss(t.Params.Type, t.Params.Rate, tradedAmount(t), t.Params.MidPrice)
+		}
+		if err := checkLimit(RuleDailyLoss, loss, lossLimit); err != nil {
+			return mid, err
+		}
+	}
+	return mid, nil
+}
+
+// checkAddress checks that address is in the allowlist of asset on exchange for the type of transfer.
+func (c *Checker) checkAddress(exchangeID rtypes.ExchangeID, assetID rtypes.AssetID, addressType string, address ethereum.Address) error {
+	allowlist, err := c.sr.GetAddressAllowlist()
+	if err != nil {
+		return fmt.Errorf("failed to get address allowlist: %w", err)
+	}
+	for _, a := range allowlist {
+		if a.ExchangeID == exchangeID && a.AssetID == assetID && a.Type == addressType && a.Address == address {
+			return nil
+		}
+	}
+	return &Violation{
+		Rule: RuleAddressAllowed,
+		Detail: fmt.Sprintf("%s address %s of asset %d on %s is not in allowlist",
+			addressType, address.Hex(), assetID, exchangeID),
+	}
+}
+
+// CheckWithdraw checks a withdraw against kill switch, address allowlist and daily withdraw limit.
+func (c *Checker) CheckWithdraw(exchangeID rtypes.ExchangeID, asset commonv3.Asset, amount float64, address ethereum.Address) error {
+	if err := c.checkKillSwitch(); err != nil {
+		return err
+	}
+	if err := c.checkAddress(exchangeID, asset.ID, commonv3.AllowedAddressWithdraw, address); err != nil {
+		return err
+	}
+	limits, err := c.sr.GetRiskLimits()
+	if err != nil {
+		return fmt.Errorf("failed to get risk limits: %w", err)
+	}
+	limit := limits.MaxDailyWithdraw[asset.ID]
+	if limit == 0 {
+		return nil
+	}
+	withdraws, err := c.records(common.ActionWithdraw, dailyWindow)
+	if err != nil {
+		return err
+	}
+	total := amount
+	for _, w := range withdraws {
+		if w.Params.Asset != asset.ID ||
+			w.MiningStatus == common.MiningStatusFailed ||
+			w.ExchangeStatus == common.ExchangeStatusFailed ||
+			w.ExchangeStatus == common.ExchangeStatusCancelled {
+			continue
+		}
+		total += w.Params.Amount
+	}
+	return checkLimit(RuleDailyWithdraw, total, limit)
+}
+
+// CheckDeposit checks a deposit against kill switch and address allowlist.
+func (c *Checker) CheckDeposit(exchangeID rtypes.ExchangeID, asset commonv3.Asset, amount float64, address ethereum.Address) error {
+	if err := c.checkKillSwitch(); err != nil {
+		return err
+	}
+	return c.checkAddress(exchangeID, asset.ID, commonv3.AllowedAddressDeposit, address)
+}
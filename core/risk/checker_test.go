@@ -0,0 +1,230 @@
+package risk
+
+import (
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type testSettingReader struct {
+	limits     commonv3.RiskLimits
+	killSwitch bool
+	pairs      map[rtypes.TradingPairID]commonv3.TradingPairSymbols
+	allowlist  []commonv3.AllowedAddress
+	pairReads  int
+}
+
+func (s *testSettingReader) GetRiskLimits() (commonv3.RiskLimits, error) {
+	return s.limits, nil
+}
+
+func (s *testSettingReader) GetKillSwitch() (bool, error) {
+	return s.killSwitch, nil
+}
+
+func (s *testSettingReader) GetTradingPair(id rtypes.TradingPairID, withDeleted bool) (commonv3.TradingPairSymbols, error) {
+	s.pairReads++
+	return s.pairs[id], nil
+}
+
+func (s *testSettingReader) GetAddressAllowlist() ([]commonv3.AllowedAddress, error) {
+	return s.allowlist, nil
+}
+
+type testActivityReader []common.ActivityRecord
+
+func (r testActivityReader) GetAllRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error) {
+	return r, nil
+}
+
+type testPriceReader struct {
+	prices map[rtypes.TradingPairID]common.OnePrice
+	reads  int
+}
+
+func (r *testPriceReader) CurrentPriceVersion(timepoint uint64) (common.Version, error) {
+	return 1, nil
+}
+
+func (r *testPriceReader) GetOnePrice(pairID rtypes.TradingPairID, v common.Version) (common.OnePrice, error) {
+	r.reads++
+	return r.prices[pairID], nil
+}
+
+const (
+	binance = rtypes.Binance
+	eth     = rtypes.AssetID(1)
+	knc     = rtypes.AssetID(2)
+	kncETH  = rtypes.TradingPairID(1)
+)
+
+var (
+	reserveAddr = ethereum.HexToAddress("0x63825c174ab367968EC60f061753D3bbD36A0D8F")
+	depositAddr = ethereum.HexToAddress("0x3f5CE5FBFe3E9af3971dD833D26bA9b5C936f0bE")
+)
+
+func newTestChecker(limits commonv3.RiskLimits, records []common.ActivityRecord) (*Checker, *testSettingReader) {
+	sr := &testSettingReader{
+		limits: limits,
+		pairs: map[rtypes.TradingPairID]commonv3.TradingPairSymbols{
+			kncETH: {TradingPair: commonv3.TradingPair{ID: kncETH, Base: knc, Quote: eth}},
+		},
+		allowlist: []commonv3.AllowedAddress{
+			{AssetID: knc, ExchangeID: binance, Type: commonv3.AllowedAddressWithdraw, Address: reserveAddr},
+			{AssetID: eth, ExchangeID: binance, Type: commonv3.AllowedAddressWithdraw, Address: reserveAddr},
+			{AssetID: knc, ExchangeID: binance, Type: commonv3.AllowedAddressDeposit, Address: depositAddr},
+		},
+	}
+	pr := &testPriceReader{prices: map[rtypes.TradingPairID]common.OnePrice{
+		kncETH: {
+			binance: common.ExchangePrice{
+				Valid: true,
+				Bids:  []common.PriceEntry{{Quantity: 10, Rate: 0.0019}, {Quantity: 10, Rate: 0.0018}},
+				Asks:  []common.PriceEntry{{Quantity: 10, Rate: 0.0021}},
+			},
+		},
+	}}
+	c := NewChecker(sr, testActivityReader(records), pr)
+	c.now = func() time.Time { return time.Unix(1600000000, 0) }
+	return c, sr
+}
+
+// trade returns a trade record checked against mid price 0.002.
+func trade(tradeType string, rate, amount float64, status string) common.ActivityRecord {
+	return common.ActivityRecord{
+		Action: common.ActionTrade,
+		Params: &common.ActivityParams{
+			Exchange:      binance,
+			Type:          tradeType,
+			Rate:          rate,
+			Amount:        amount,
+			TradingPairID: kncETH,
+			MidPrice:      0.002,
+		},
+		Result:         &common.ActivityResult{},
+		ExchangeStatus: status,
+	}
+}
+
+func requireViolation(t *testing.T, err error, rule string) {
+	require.Error(t, err)
+	v, ok := err.(*Violation)
+	require.True(t, ok, "unexpected error %v", err)
+	require.Equal(t, rule, v.Rule)
+}
+
+func checkTrade(c *Checker, tradeType string, rate, amount float64) error {
+	pair := commonv3.TradingPairSymbols{TradingPair: commonv3.TradingPair{ID: kncETH, Base: knc, Quote: eth}}
+	_, err := c.CheckTrade(binance, tradeType, pair, rate, amount)
+	return err
+}
+
+func TestCheckTrade(t *testing.T) {
+	pair := commonv3.TradingPairSymbols{TradingPair: commonv3.TradingPair{ID: kncETH, Base: knc, Quote: eth}}
+
+	c, sr := newTestChecker(commonv3.RiskLimits{}, nil)
+	mid, err := c.CheckTrade(binance, "buy", pair, 0.0025, 1000000)
+	require.NoError(t, err)
+	require.Equal(t, 0.002, mid)
+	sr.killSwitch = true
+	requireViolation(t, checkTrade(c, "buy", 0.002, 100), RuleKillSwitch)
+
+	c, _ = newTestChecker(commonv3.RiskLimits{
+		MaxOrderNotional: map[rtypes.AssetID]float64{eth: 1},
+	}, nil)
+	require.NoError(t, checkTrade(c, "buy", 0.002, 500))
+	requireViolation(t, checkTrade(c, "buy", 0.002, 501), RuleOrderNotional)
+
+	c, _ = newTestChecker(commonv3.RiskLimits{
+		MaxHourlyNotional: map[rtypes.AssetID]float64{eth: 1},
+	}, []common.ActivityRecord{
+		trade("buy", 0.002, 300, common.ExchangeStatusDone),
+		trade("buy", 0.002, 1000, common.ExchangeStatusFailed),
+	})
+	require.NoError(t, checkTrade(c, "sell", 0.002, 200))
+	requireViolation(t, checkTrade(c, "sell", 0.002, 201), RuleHourlyNotional)
+
+	c, _ = newTestChecker(commonv3.RiskLimits{MaxPriceDeviation: 0.1}, nil)
+	require.NoError(t, checkTrade(c, "buy", 0.00215, 100))
+	requireViolation(t, checkTrade(c, "buy", 0.0023, 100), RulePriceDeviation)
+	requireViolation(t, checkTrade(c, "sell", 0.0017, 100), RulePriceDeviation)
+
+	// mid price is 0.002, the recorded buy lost 0.0001 * 1000 = 0.1 ETH
+	c, _ = newTestChecker(commonv3.RiskLimits{
+		MaxDailyLoss: map[rtypes.AssetID]float64{eth: 0.15},
+	}, []common.ActivityRecord{trade("buy", 0.0021, 1000, common.ExchangeStatusDone)})
+	require.NoError(t, checkTrade(c, "sell", 0.0019, 500))
+	requireViolation(t, checkTrade(c, "sell", 0.0019, 501), RuleDailyLoss)
+	require.NoError(t, checkTrade(c, "sell", 0.0021, 10000))
+}
+
+func TestCheckTradeDailyLossAtRecordedMid(t *testing.T) {
+	atMid := func(r common.ActivityRecord, mid float64) common.ActivityRecord {
+		r.Params.MidPrice = mid
+		return r
+	}
+	records := []common.ActivityRecord{
+		// bought at 0.0021 when mid price was 0.0022, a profit of 0.1 ETH though the current mid price is 0.002
+		atMid(trade("buy", 0.0021, 1000, common.ExchangeStatusDone), 0.0022),
+		// a trade without recorded mid price is not valued
+		atMid(trade("buy", 0.003, 1000, common.ExchangeStatusDone), 0),
+		trade("buy", 0.0021, 1000, common.ExchangeStatusDone),
+	}
+	c, sr := newTestChecker(commonv3.RiskLimits{
+		MaxDailyLoss:      map[rtypes.AssetID]float64{eth: 0.05},
+		MaxHourlyNotional: map[rtypes.AssetID]float64{eth: 100},
+	}, records)
+	require.NoError(t, checkTrade(c, "sell", 0.0019, 500))
+	requireViolation(t, checkTrade(c, "sell", 0.0019, 501), RuleDailyLoss)
+
+	// trading pairs of past trades and the current mid price are read once per check
+	sr.pairReads = 0
+	pr := c.pr.(*testPriceReader)
+	pr.reads = 0
+	require.NoError(t, checkTrade(c, "sell", 0.002, 1))
+	require.Equal(t, 0, sr.pairReads)
+	require.Equal(t, 1, pr.reads)
+}
+
+func TestCheckWithdraw(t *testing.T) {
+	withdraw := func(asset rtypes.AssetID, amount float64, status string) common.ActivityRecord {
+		return common.ActivityRecord{
+			Action:         common.ActionWithdraw,
+			Params:         &common.ActivityParams{Exchange: binance, Asset: asset, Amount: amount},
+			ExchangeStatus: status,
+		}
+	}
+	c, sr := newTestChecker(commonv3.RiskLimits{
+		MaxDailyWithdraw: map[rtypes.AssetID]float64{knc: 1000},
+	}, []common.ActivityRecord{
+		withdraw(knc, 600, common.ExchangeStatusDone),
+		withdraw(knc, 600, common.ExchangeStatusFailed),
+		withdraw(eth, 600, common.ExchangeStatusDone),
+	})
+	require.NoError(t, c.CheckWithdraw(binance, commonv3.Asset{ID: knc}, 400, reserveAddr))
+	requireViolation(t, c.CheckWithdraw(binance, commonv3.Asset{ID: knc}, 401, reserveAddr), RuleDailyWithdraw)
+	require.NoError(t, c.CheckWithdraw(binance, commonv3.Asset{ID: eth}, 10000, reserveAddr))
+
+	require.NoError(t, c.CheckDeposit(binance, commonv3.Asset{ID: knc}, 10000, depositAddr))
+	sr.killSwitch = true
+	requireViolation(t, c.CheckWithdraw(binance, commonv3.Asset{ID: eth}, 1, reserveAddr), RuleKillSwitch)
+	requireViolation(t, c.CheckDeposit(binance, commonv3.Asset{ID: knc}, 1, depositAddr), RuleKillSwitch)
+}
+
+func TestCheckAddressAllowlist(t *testing.T) {
+	c, _ := newTestChecker(commonv3.RiskLimits{}, nil)
+	require.NoError(t, c.CheckWithdraw(binance, commonv3.Asset{ID: knc}, 1, reserveAddr))
+	requireViolation(t, c.CheckWithdraw(binance, commonv3.Asset{ID: knc}, 1, depositAddr), RuleAddressAllowed)
+	requireViolation(t, c.CheckWithdraw(rtypes.Huobi, commonv3.Asset{ID: knc}, 1, reserveAddr), RuleAddressAllowed)
+
+	require.NoError(t, c.CheckDeposit(binance, commonv3.Asset{ID: knc}, 1, depositAddr))
+	// an allowed withdraw address is not allowed as deposit address
+	requireViolation(t, c.CheckDeposit(binance, commonv3.Asset{ID: knc}, 1, reserveAddr), RuleAddressAllowed)
+	requireViolation(t, c.CheckDeposit(binance, commonv3.Asset{ID: eth}, 1, depositAddr), RuleAddressAllowed)
+}
@@ -0,0 +1,313 @@
+package rotation
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+const (
+	defaultDrainTimeout = 10 * time.Minute
+	defaultPollInterval = 5 * time.Second
+)
+
+// ErrRotationInProgress is returned when a rotation is started for an operator which has an
+// unfinished rotation, or a rotation is resumed while it is running.
+var ErrRotationInProgress = errors.New("operator rotation is in progress")
+
+// Blockchain manages operators on chain and in the registry of the blockchain.
+type Blockchain interface {
+	OperatorAddresses() map[string]ethereum.Address
+	IsContractOperator(op string, addr ethereum.Address) (bool, error)
+	AddContractOperator(op string, addr ethereum.Address) (ethereum.Hash, error)
+	RemoveContractOperator(op string, addr ethereum.Address) (ethereum.Hash, error)
+	HasPendingTxs(op string) (bool, error)
+	PauseOperator(op string)
+	ResumeOperator(op string)
+}
+
+// Storage stores operator rotations.
+type Storage interface {
+	CreateOperatorRotation(r common.OperatorRotation) (uint64, error)
+	UpdateOperatorRotation(r common.OperatorRotation) error
+	GetOperatorRotations() ([]common.OperatorRotation, error)
+}
+
+// Core sends txs of operators, it keeps the nonce of the next tx of some operators.
+type Core interface {
+	ResetOperatorNonce(op string)
+}
+
+// ActivityStorage returns pending activities, the nonces of pending txs recorded in activities
+// are reused by core so they must be done before the key is switched.
+type ActivityStorage interface {
+	GetPendingActivities() ([]common.ActivityRecord, error)
+}
+
+// Key is the key an operator is rotated to.
+type Key struct {
+	Signer blockchain.Signer
+	// Register registers Signer as the operator, replacing the old key.
+	Register func()
+}
+
+// operatorActions are the activities whose txs are sent by an operator.
+var operatorActions = map[string][]string{
+	blockchain.PricingOP: {common.ActionSetRate, common.ActionCancelSetRate},
+	blockchain.DepositOP: {common.ActionDeposit},
+}
+
+// Rotator rotates keys of operators step by step, every step is stored so that a failed rotation
+// can be resumed from the failed step.
+type Rotator struct {
+	bc              Blockchain
+	storage         Storage
+	activityStorage ActivityStorage
+	core            Core
+	keys            map[string]Key
+	drainTimeout    time.Duration
+	pollInterval    time.Duration
+	l               *zap.SugaredLogger
+
+	mu      sync.Mutex
+	running map[uint64]bool
+}
+
+// NewRotator creates a Rotator which rotates operators to keys.
+func NewRotator(bc Blockchain, storage Storage, activityStorage ActivityStorage, core Core, keys map[string]Key) *Rotator {
+	return &Rotator{
+		bc:              bc,
+		storage:         storage,
+		activityStorage: activityStorage,
+		core:            core,
+		keys:            keys,
+		drainTimeout:    defaultDrainTimeout,
+		pollInterval:    defaultPollInterval,
+		l:               zap.S(),
+		running:         map[uint64]bool{},
+	}
+}
+
+// Restore registers the new key of operators whose latest rotation has switched to it, it should
+// be called on start until the config is updated to the new key.
+func (r *Rotator) Restore() error {
+	rotations, err := r.storage.GetOperatorRotations()
+	if err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	for _, rotation := range rotations {
+		if seen[rotation.Operator] {
+			continue
+		}
+		seen[rotation.Operator] = true
+		key, ok := r.keys[rotation.Operator]
+		if !rotation.Switched() || !ok || key.Signer.GetAddress() != rotation.NewAddress ||
+			r.bc.OperatorAddresses()[rotation.Operator] != rotation.OldAddress {
+			continue
+		}
+		r.l.Infow("restore rotated operator", "operator", rotation.Operator, "address", rotation.NewAddress.Hex())
+		key.Register()
+	}
+	return nil
+}
+
+// Rotations returns all rotations, the latest first.
+func (r *Rotator) Rotations() ([]common.OperatorRotation, error) {
+	return r.storage.GetOperatorRotations()
+}
+
+// Start starts rotating op to its configured key, the steps are run in background.
+func (r *Rotator) Start(op string) (common.OperatorRotation, error) {
+	if _, ok := operatorActions[op]; !ok {
+		return common.OperatorRotation{}, fmt.Errorf("operator %s can not be rotated", op)
+	}
+	key, ok := r.keys[op]
+	if !ok {
+		return common.OperatorRotation{}, fmt.Errorf("no rotation key is configured for %s", op)
+	}
+	oldAddress := r.bc.OperatorAddresses()[op]
+	if oldAddress == key.Signer.GetAddress() {
+		return common.OperatorRotation{}, fmt.Errorf("%s already uses address %s", op, oldAddress.Hex())
+	}
+	rotations, err := r.storage.GetOperatorRotations()
+	if err != nil {
+		return common.OperatorRotation{}, err
+	}
+	for _, rotation := range rotations {
+		if rotation.Operator == op && rotation.Step != common.RotationStepDone {
+			return common.OperatorRotation{}, fmt.Errorf("rotation %d of %s: %w", rotation.ID, op, ErrRotationInProgress)
+		}
+	}
+	rotation := common.OperatorRotation{
+		Operator:   op,
+		OldAddress: oldAddress,
+		NewAddress: key.Signer.GetAddress(),
+		Step:       common.RotationStepAddOperator,
+		Created:    time.Now().UTC(),
+	}
+	rotation.Updated = rotation.Created
+	if rotation.ID, err = r.storage.CreateOperatorRotation(rotation); err != nil {
+		return common.OperatorRotation{}, err
+	}
+	r.l.Infow("start operator rotation", "id", rotation.ID, "operator", op,
+		"old_address", rotation.OldAddress.Hex(), "new_address", rotation.NewAddress.Hex())
+	return rotation, r.goRun(rotation)
+}
+
+// Resume resumes a failed rotation from the step it failed at.
+func (r *Rotator) Resume(id uint64) (common.OperatorRotation, error) {
+	rotations, err := r.storage.GetOperatorRotations()
+	if err != nil {
+		return common.OperatorRotation{}, err
+	}
+	for _, rotation := range rotations {
+		if rotation.ID != id {
+			continue
+		}
+		if rotation.Step == common.RotationStepDone {
+			return rotation, fmt.Errorf("rotation %d is done", id)
+		}
+		if _, ok := r.keys[rotation.Operator]; !ok {
+			return rotation, fmt.Errorf("no rotation key is configured for %s", rotation.Operator)
+		}
+		if r.keys[rotation.Operator].Signer.GetAddress() != rotation.NewAddress {
+			return rotation, fmt.Errorf("rotation key of %s is not %s", rotation.Operator, rotation.NewAddress.Hex())
+		}
+		return rotation, r.goRun(rotation)
+	}
+	return common.OperatorRotation{}, fmt.Errorf("rotation %d is not found", id)
+}
+
+func (r *Rotator) goRun(rotation common.OperatorRotation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running[rotation.ID] {
+		return fmt.Errorf("rotation %d: %w", rotation.ID, ErrRotationInProgress)
+	}
+	r.running[rotation.ID] = true
+	go func() {
+		r.run(rotation)
+		r.mu.Lock()
+		delete(r.running, rotation.ID)
+		r.mu.Unlock()
+	}()
+	return nil
+}
+
+// run runs the steps of rotation until it is done or a step fails.
+func (r *Rotator) run(rotation common.OperatorRotation) {
+	for rotation.Step != common.RotationStepDone {
+		next, err := r.runStep(&rotation)
+		if err != nil {
+			r.l.Errorw("operator rotation step failed", "id", rotation.ID, "step", rotation.Step, "err", err)
+			rotation.Error = err.Error()
+		} else {
+			r.l.Infow("operator rotation step done", "id", rotation.ID, "step", rotation.Step)
+			rotation.Step = next
+			rotation.Error = ""
+		}
+		if sErr := r.storage.UpdateOperatorRotation(rotation); sErr != nil {
+			r.l.Errorw("failed to store operator rotation", "id", rotation.ID, "err", sErr)
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// runStep runs the step of rotation and returns the next step.
+func (r *Rotator) runStep(rotation *common.OperatorRotation) (string, error) {
+	switch rotation.Step {
+	case common.RotationStepAddOperator:
+		isOperator, err := r.bc.IsContractOperator(rotation.Operator, rotation.NewAddress)
+		if err != nil {
+			return "", err
+		}
+		if !isOperator {
+			tx, err := r.bc.AddContractOperator(rotation.Operator, rotation.NewAddress)
+			rotation.AddTx = tx.Hex()
+			if err != nil {
+				return "", err
+			}
+		}
+		return common.RotationStepDrain, nil
+	case common.RotationStepDrain:
+		if err := r.drain(rotation.Operator); err != nil {
+			return "", err
+		}
+		return common.RotationStepSwitch, nil
+	case common.RotationStepSwitch:
+		// drained again as the operator is not paused if the rotation is resumed after a restart
+		if err := r.drain(rotation.Operator); err != nil {
+			return "", err
+		}
+		// nonces cached for the old key must not be used by the new key
+		r.keys[rotation.Operator].Register()
+		r.core.ResetOperatorNonce(rotation.Operator)
+		return common.RotationStepRemoveOperator, nil
+	case common.RotationStepRemoveOperator:
+		isOperator, err := r.bc.IsContractOperator(rotation.Operator, rotation.OldAddress)
+		if err != nil {
+			return "", err
+		}
+		if isOperator {
+			tx, err := r.bc.RemoveContractOperator(rotation.Operator, rotation.OldAddress)
+			rotation.RemoveTx = tx.Hex()
+			if err != nil {
+				return "", err
+			}
+		}
+		return common.RotationStepDone, nil
+	default:
+		return "", fmt.Errorf("unknown rotation step %s", rotation.Step)
+	}
+}
+
+// drain pauses op and waits until its txs are mined and their activities are done. The operator
+// stays paused on success until the key is switched, it is resumed on failure.
+func (r *Rotator) drain(op string) error {
+	r.bc.PauseOperator(op)
+	deadline := time.Now().Add(r.drainTimeout)
+	for {
+		drained, err := r.drained(op)
+		if err == nil && drained {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			r.bc.ResumeOperator(op)
+			if err != nil {
+				return fmt.Errorf("%s is not drained after %v: %w", op, r.drainTimeout, err)
+			}
+			return fmt.Errorf("%s is not drained after %v", op, r.drainTimeout)
+		}
+		time.Sleep(r.pollInterval)
+	}
+}
+
+func (r *Rotator) drained(op string) (bool, error) {
+	pending, err := r.bc.HasPendingTxs(op)
+	if err != nil || pending {
+		return false, err
+	}
+	activities, err := r.activityStorage.GetPendingActivities()
+	if err != nil {
+		return false, err
+	}
+	for _, activity := range activities {
+		for _, action := range operatorActions[op] {
+			if activity.Action == action && blockchain.ActivityOperator(activity) == op && activity.IsBlockchainPending() {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,213 @@
+package rotation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+var (
+	oldAddress = ethereum.HexToAddress("0x1111111111111111111111111111111111111111")
+	newAddress = ethereum.HexToAddress("0x2222222222222222222222222222222222222222")
+)
+
+type testSigner ethereum.Address
+
+func (s testSigner) GetAddress() ethereum.Address {
+	return ethereum.Address(s)
+}
+
+func (s testSigner) Sign(tx *types.Transaction) (*types.Transaction, error) {
+	return tx, nil
+}
+
+type testBlockchain struct {
+	operators         map[string]ethereum.Address
+	contractOperators map[ethereum.Address]bool
+	pendingTxs        bool
+	paused            map[string]bool
+	addErr            error
+}
+
+func (b *testBlockchain) OperatorAddresses() map[string]ethereum.Address {
+	return b.operators
+}
+
+func (b *testBlockchain) IsContractOperator(op string, addr ethereum.Address) (bool, error) {
+	return b.contractOperators[addr], nil
+}
+
+func (b *testBlockchain) AddContractOperator(op string, addr ethereum.Address) (ethereum.Hash, error) {
+	if b.addErr != nil {
+		return ethereum.Hash{}, b.addErr
+	}
+	b.contractOperators[addr] = true
+	return ethereum.HexToHash("0x01"), nil
+}
+
+func (b *testBlockchain) RemoveContractOperator(op string, addr ethereum.Address) (ethereum.Hash, error) {
+	delete(b.contractOperators, addr)
+	return ethereum.HexToHash("0x02"), nil
+}
+
+func (b *testBlockchain) HasPendingTxs(op string) (bool, error) {
+	return b.pendingTxs, nil
+}
+
+func (b *testBlockchain) PauseOperator(op string) {
+	b.paused[op] = true
+}
+
+func (b *testBlockchain) ResumeOperator(op string) {
+	delete(b.paused, op)
+}
+
+type testStorage []common.OperatorRotation
+
+func (s *testStorage) CreateOperatorRotation(r common.OperatorRotation) (uint64, error) {
+	r.ID = uint64(len(*s) + 1)
+	*s = append(*s, r)
+	return r.ID, nil
+}
+
+func (s *testStorage) UpdateOperatorRotation(r common.OperatorRotation) error {
+	(*s)[r.ID-1] = r
+	return nil
+}
+
+func (s *testStorage) GetOperatorRotations() ([]common.OperatorRotation, error) {
+	var result []common.OperatorRotation
+	for i := len(*s) - 1; i >= 0; i-- {
+		result = append(result, (*s)[i])
+	}
+	return result, nil
+}
+
+// testCore records the operators whose nonces are reset.
+type testCore struct {
+	resets []string
+}
+
+func (c *testCore) ResetOperatorNonce(op string) {
+	c.resets = append(c.resets, op)
+}
+
+type testActivityStorage []common.ActivityRecord
+
+func (s testActivityStorage) GetPendingActivities() ([]common.ActivityRecord, error) {
+	return s, nil
+}
+
+func newTestRotator(bc *testBlockchain, storage *testStorage, activities testActivityStorage) *Rotator {
+	r := NewRotator(bc, storage, activities, &testCore{}, map[string]Key{
+		blockchain.PricingOP: {
+			Signer: testSigner(newAddress),
+			Register: func() {
+				bc.operators[blockchain.PricingOP] = newAddress
+				delete(bc.paused, blockchain.PricingOP)
+			},
+		},
+	})
+	r.drainTimeout = 10 * time.Millisecond
+	r.pollInterval = time.Millisecond
+	return r
+}
+
+func newTestBlockchain() *testBlockchain {
+	return &testBlockchain{
+		operators:         map[string]ethereum.Address{blockchain.PricingOP: oldAddress},
+		contractOperators: map[ethereum.Address]bool{oldAddress: true},
+		paused:            map[string]bool{},
+	}
+}
+
+func TestRotatorRun(t *testing.T) {
+	bc := newTestBlockchain()
+	storage := &testStorage{}
+	r := newTestRotator(bc, storage, nil)
+
+	_, err := storage.CreateOperatorRotation(common.OperatorRotation{
+		Operator:   blockchain.PricingOP,
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+		Step:       common.RotationStepAddOperator,
+	})
+	require.NoError(t, err)
+	r.run((*storage)[0])
+
+	rotation := (*storage)[0]
+	require.Equal(t, common.RotationStepDone, rotation.Step)
+	require.Empty(t, rotation.Error)
+	require.Equal(t, newAddress, bc.operators[blockchain.PricingOP])
+	require.Equal(t, map[ethereum.Address]bool{newAddress: true}, bc.contractOperators)
+	require.Empty(t, bc.paused)
+}
+
+func TestRotatorSwitchResetsNonce(t *testing.T) {
+	bc := newTestBlockchain()
+	storage := &testStorage{}
+	r := newTestRotator(bc, storage, nil)
+	core := &testCore{}
+	r.core = core
+
+	_, err := storage.CreateOperatorRotation(common.OperatorRotation{
+		Operator:   blockchain.PricingOP,
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+		Step:       common.RotationStepAddOperator,
+	})
+	require.NoError(t, err)
+	r.run((*storage)[0])
+	require.Equal(t, common.RotationStepDone, (*storage)[0].Step)
+	require.Equal(t, []string{blockchain.PricingOP}, core.resets)
+}
+
+func TestRotatorResumeFailedStep(t *testing.T) {
+	bc := newTestBlockchain()
+	bc.addErr = errors.New("admin is not configured")
+	storage := &testStorage{}
+	activities := testActivityStorage{{Action: common.ActionSetRate, MiningStatus: common.MiningStatusSubmitted}}
+	r := newTestRotator(bc, storage, activities)
+
+	_, err := storage.CreateOperatorRotation(common.OperatorRotation{
+		Operator:   blockchain.PricingOP,
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+		Step:       common.RotationStepAddOperator,
+	})
+	require.NoError(t, err)
+	r.run((*storage)[0])
+	require.Equal(t, common.RotationStepAddOperator, (*storage)[0].Step)
+	require.Equal(t, "admin is not configured", (*storage)[0].Error)
+
+	// the pending set rate keeps the old key from being drained
+	bc.addErr = nil
+	r.run((*storage)[0])
+	require.Equal(t, common.RotationStepDrain, (*storage)[0].Step)
+	require.NotEmpty(t, (*storage)[0].Error)
+	require.Empty(t, bc.paused, "operator must be resumed if it is not drained")
+	require.Equal(t, oldAddress, bc.operators[blockchain.PricingOP])
+
+	r.activityStorage = testActivityStorage{}
+	r.run((*storage)[0])
+	require.Equal(t, common.RotationStepDone, (*storage)[0].Step)
+	require.Equal(t, newAddress, bc.operators[blockchain.PricingOP])
+}
+
+func TestRotatorStart(t *testing.T) {
+	bc := newTestBlockchain()
+	storage := &testStorage{{ID: 1, Operator: blockchain.PricingOP, Step: common.RotationStepDrain}}
+	r := newTestRotator(bc, storage, nil)
+
+	_, err := r.Start(blockchain.DepositOP)
+	require.Error(t, err)
+	_, err = r.Start(blockchain.PricingOP)
+	require.True(t, errors.Is(err, ErrRotationInProgress))
+}
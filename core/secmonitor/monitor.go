@@ -0,0 +1,364 @@
+package secmonitor
+
+import (
+	"fmt"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+const (
+	defaultCheckInterval     = time.Minute
+	defaultStartBlocks       = 5760 // about a day
+	defaultMaxBlocksPerCheck = 1000
+)
+
+var ethAddress = ethereum.HexToAddress("0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee")
+
+// watchedEvents are the admin events of the reserve and pricing contracts, trades and deposits
+// are not watched.
+var watchedEvents = []string{
+	"TransferAdminPending",
+	"AdminClaimed",
+	"OperatorAdded",
+	"AlerterAdded",
+	"WithdrawAddressApproved",
+	"WithdrawFunds",
+	"TokenWithdraw",
+	"EtherWithdraw",
+	"SetContractAddresses",
+	"TradeEnabled",
+}
+
+// Blockchain returns decoded events of the reserve contracts and the state needed to classify them.
+type Blockchain interface {
+	CurrentBlock() (uint64, error)
+	GetContractEvents(fromBlock, toBlock uint64, events []string) ([]common.ContractEvent, error)
+	IsApprovedWithdrawAddress(token, addr ethereum.Address, block uint64) (bool, error)
+	OperatorAddresses() map[string]ethereum.Address
+	ListedTokens() []ethereum.Address
+	GetPricingAddress() ethereum.Address
+	GetPricingAdmin(block uint64) (ethereum.Address, error)
+}
+
+// Storage stores security findings, a finding of an event which is already stored is ignored.
+type Storage interface {
+	StoreSecurityFindings(findings []common.SecurityFinding) error
+	GetSecurityFindings(from, to time.Time, severities []string) ([]common.SecurityFinding, error)
+}
+
+// Monitor watches admin activity on the reserve contracts and stores the activity which is not
+// initiated by our operators as security findings.
+type Monitor struct {
+	bc      Blockchain
+	storage Storage
+	config  common.SecurityMonitorConfig
+	l       *zap.SugaredLogger
+
+	// lastBlock is the last block checked, 0 before the first check.
+	lastBlock    uint64
+	listedTokens []ethereum.Address
+}
+
+// NewMonitor creates a Monitor of the contracts of bc.
+func NewMonitor(bc Blockchain, storage Storage, config common.SecurityMonitorConfig) *Monitor {
+	if config.CheckInterval == 0 {
+		config.CheckInterval = common.HumanDuration(defaultCheckInterval)
+	}
+	if config.StartBlocks == 0 {
+		config.StartBlocks = defaultStartBlocks
+	}
+	if config.MaxBlocksPerCheck == 0 {
+		config.MaxBlocksPerCheck = defaultMaxBlocksPerCheck
+	}
+	return &Monitor{
+		bc:      bc,
+		storage: storage,
+		config:  config,
+		l:       zap.S(),
+	}
+}
+
+// Run checks new blocks every check interval, it never returns.
+func (m *Monitor) Run() {
+	ticker := time.NewTicker(time.Duration(m.config.CheckInterval))
+	defer ticker.Stop()
+	for {
+		if err := m.Check(); err != nil {
+			m.l.Errorw("failed to check contract admin activity", "err", err)
+		}
+		<-ticker.C
+	}
+}
+
+// Check stores findings of the events since the last check, at most max blocks per check, and of
+// the changes of listed tokens. Blocks are checked again on the next check if it fails.
+func (m *Monitor) Check() error {
+	current, err := m.bc.CurrentBlock()
+	if err != nil {
+		return err
+	}
+	from := m.lastBlock + 1
+	if m.lastBlock == 0 && current > m.config.StartBlocks {
+		from = current - m.config.StartBlocks
+	}
+	if from > current {
+		return nil
+	}
+	to := current
+	if to-from+1 > m.config.MaxBlocksPerCheck {
+		to = from + m.config.MaxBlocksPerCheck - 1
+	}
+	events, err := m.bc.GetContractEvents(from, to, watchedEvents)
+	if err != nil {
+		return err
+	}
+	operators := make(map[ethereum.Address]bool)
+	for _, addr := range m.bc.OperatorAddresses() {
+		operators[addr] = true
+	}
+	var findings []common.SecurityFinding
+	for _, event := range events {
+		finding, err := m.classify(event, operators)
+		if err != nil {
+			return err
+		}
+		if finding != nil {
+			findings = append(findings, *finding)
+		}
+	}
+	listed := m.bc.ListedTokens()
+	listingFindings, err := m.listingFindings(listed, current, operators)
+	if err != nil {
+		return err
+	}
+	findings = append(findings, listingFindings...)
+	if len(findings) != 0 {
+		if err := m.storage.StoreSecurityFindings(findings); err != nil {
+			return err
+		}
+		for _, finding := range findings {
+			m.l.Warnw("security finding", "severity", finding.Severity, "contract", finding.Contract,
+				"event", finding.Event, "tx", finding.TxHash, "description", finding.Description)
+		}
+	}
+	m.lastBlock = to
+	m.listedTokens = listed
+	return nil
+}
+
+// classify returns the finding of event, nil if event is initiated by our operators. A log which
+// cannot be decoded is always reported, less severe if it is emitted by a tx of our operators.
+func (m *Monitor) classify(event common.ContractEvent, operators map[ethereum.Address]bool) (*common.SecurityFinding, error) {
+	ours := operators[event.Sender]
+	if event.DecodeError != "" {
+		severity := common.SeverityHigh
+		if ours {
+			severity = common.SeverityLow
+		}
+		finding := newFinding(event, severity, fmt.Sprintf("log %d of tx %s cannot be decoded: %s",
+			event.LogIndex, event.TxHash.Hex(), event.DecodeError))
+		return &finding, nil
+	}
+	var (
+		severity    string
+		description string
+	)
+	switch event.Event {
+	case "WithdrawFunds", "TokenWithdraw", "EtherWithdraw":
+		return m.classifyWithdraw(event, operators)
+	case "TransferAdminPending":
+		severity = common.SeverityCritical
+		description = fmt.Sprintf("admin transfer to %s is pending", addressParam(event, "pendingAdmin").Hex())
+	case "AdminClaimed":
+		severity = common.SeverityCritical
+		description = fmt.Sprintf("admin is claimed by %s", addressParam(event, "newAdmin").Hex())
+	case "SetContractAddresses":
+		severity = common.SeverityCritical
+		description = "network, conversion rates and sanity rates contracts of the reserve are changed"
+	case "OperatorAdded":
+		severity, description = addedRemoved(event, "newOperator", "isAdd", "operator", common.SeverityHigh)
+	case "AlerterAdded":
+		severity, description = addedRemoved(event, "newAlerter", "isAdd", "alerter", common.SeverityMedium)
+	case "WithdrawAddressApproved":
+		addr := addressParam(event, "addr")
+		token := addressParam(event, "token")
+		if boolParam(event, "approve") {
+			severity = common.SeverityHigh
+			description = fmt.Sprintf("withdraw address %s of token %s is approved", addr.Hex(), token.Hex())
+		} else {
+			severity = common.SeverityLow
+			description = fmt.Sprintf("withdraw address %s of token %s is revoked", addr.Hex(), token.Hex())
+		}
+	case "TradeEnabled":
+		severity = common.SeverityMedium
+		description = "trade of the reserve is disabled"
+		if boolParam(event, "enable") {
+			description = "trade of the reserve is enabled"
+		}
+	default:
+		return nil, nil
+	}
+	if ours {
+		return nil, nil
+	}
+	finding := newFinding(event, severity, description)
+	return &finding, nil
+}
+
+// classifyWithdraw returns the finding of a withdraw which is not sent by our operators or is to an
+// address which is not approved, top ups of our operators by the reserve admin are not flagged.
+func (m *Monitor) classifyWithdraw(event common.ContractEvent, operators map[ethereum.Address]bool) (*common.SecurityFinding, error) {
+	var (
+		ours  = operators[event.Sender]
+		token = ethAddress
+		to    ethereum.Address
+	)
+	switch event.Event {
+	case "WithdrawFunds":
+		token = addressParam(event, "token")
+		to = addressParam(event, "destination")
+	case "TokenWithdraw":
+		token = addressParam(event, "token")
+		to = addressParam(event, "sendTo")
+	default:
+		to = addressParam(event, "sendTo")
+	}
+	approved := false
+	// only the reserve has approved withdraw addresses
+	if event.Contract == common.ContractReserve {
+		var err error
+		if approved, err = m.bc.IsApprovedWithdrawAddress(token, to, event.BlockNumber); err != nil {
+			return nil, err
+		}
+	}
+	var severity string
+	switch {
+	case approved && ours, !approved && ours && operators[to]:
+		return nil, nil
+	case approved:
+		severity = common.SeverityMedium
+	case ours:
+		severity = common.SeverityHigh
+	default:
+		severity = common.SeverityCritical
+	}
+	description := fmt.Sprintf("amount %s of token %s is withdrawn to %s", amountParam(event), token.Hex(), to.Hex())
+	if !approved {
+		description += " which is not an approved withdraw address"
+	}
+	finding := newFinding(event, severity, description)
+	return &finding, nil
+}
+
+// listingFindings returns the findings of tokens listed or delisted since the last check, the
+// pricing contract does not emit events for them. Only the admin of the pricing contract lists
+// tokens, so they are not flagged if the admin is one of our operators, a change of the admin is
+// flagged by its own events.
+func (m *Monitor) listingFindings(listed []ethereum.Address, block uint64, operators map[ethereum.Address]bool) ([]common.SecurityFinding, error) {
+	if m.listedTokens == nil || len(listed) == 0 {
+		return nil, nil
+	}
+	added, removed := subtract(listed, m.listedTokens), subtract(m.listedTokens, listed)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil, nil
+	}
+	admin, err := m.bc.GetPricingAdmin(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pricing admin: %w", err)
+	}
+	if operators[admin] {
+		m.l.Infow("listed tokens are changed by our pricing admin", "admin", admin.Hex(),
+			"listed", added, "delisted", removed)
+		return nil, nil
+	}
+	var findings []common.SecurityFinding
+	for _, token := range added {
+		findings = append(findings, m.listingFinding("TokenListed", common.SeverityHigh, token, block, admin,
+			fmt.Sprintf("token %s is listed by pricing admin %s", token.Hex(), admin.Hex())))
+	}
+	for _, token := range removed {
+		findings = append(findings, m.listingFinding("TokenDelisted", common.SeverityMedium, token, block, admin,
+			fmt.Sprintf("token %s is delisted by pricing admin %s", token.Hex(), admin.Hex())))
+	}
+	return findings, nil
+}
+
+func (m *Monitor) listingFinding(event, severity string, token ethereum.Address, block uint64, admin ethereum.Address, description string) common.SecurityFinding {
+	return common.SecurityFinding{
+		Severity:    severity,
+		Contract:    common.ContractPricing,
+		Address:     m.bc.GetPricingAddress(),
+		Event:       event,
+		Description: description,
+		Params:      map[string]interface{}{"token": token},
+		BlockNumber: block,
+		Sender:      admin,
+	}
+}
+
+func newFinding(event common.ContractEvent, severity, description string) common.SecurityFinding {
+	return common.SecurityFinding{
+		Severity:    severity,
+		Contract:    event.Contract,
+		Address:     event.Address,
+		Event:       event.Event,
+		Description: description,
+		Params:      event.Params,
+		BlockNumber: event.BlockNumber,
+		TxHash:      event.TxHash.Hex(),
+		LogIndex:    event.LogIndex,
+		Sender:      event.Sender,
+		Method:      event.Method,
+	}
+}
+
+// addedRemoved returns severity and description of an event adding or removing a role, removing
+// is one level less severe than adding.
+func addedRemoved(event common.ContractEvent, addrParam, isAddParam, role, severity string) (string, string) {
+	addr := addressParam(event, addrParam).Hex()
+	if boolParam(event, isAddParam) {
+		return severity, fmt.Sprintf("%s %s is added", role, addr)
+	}
+	if severity == common.SeverityHigh {
+		severity = common.SeverityMedium
+	} else {
+		severity = common.SeverityLow
+	}
+	return severity, fmt.Sprintf("%s %s is removed", role, addr)
+}
+
+func addressParam(event common.ContractEvent, name string) ethereum.Address {
+	addr, _ := event.Params[name].(ethereum.Address)
+	return addr
+}
+
+func boolParam(event common.ContractEvent, name string) bool {
+	b, _ := event.Params[name].(bool)
+	return b
+}
+
+// amountParam returns the amount of a withdraw event in token wei.
+func amountParam(event common.ContractEvent) string {
+	if amount, ok := event.Params["amount"]; ok {
+		return fmt.Sprint(amount)
+	}
+	return "unknown amount"
+}
+
+func subtract(a, b []ethereum.Address) []ethereum.Address {
+	set := make(map[ethereum.Address]bool, len(b))
+	for _, addr := range b {
+		set[addr] = true
+	}
+	var result []ethereum.Address
+	for _, addr := range a {
+		if !set[addr] {
+			result = append(result, addr)
+		}
+	}
+	return result
+}
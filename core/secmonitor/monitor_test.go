@@ -0,0 +1,213 @@
+package secmonitor
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+var (
+	pricingOPAddress = ethereum.HexToAddress("0x1111111111111111111111111111111111111111")
+	depositOPAddress = ethereum.HexToAddress("0x2222222222222222222222222222222222222222")
+	attacker         = ethereum.HexToAddress("0x3333333333333333333333333333333333333333")
+	exchange         = ethereum.HexToAddress("0x4444444444444444444444444444444444444444")
+	token            = ethereum.HexToAddress("0x5555555555555555555555555555555555555555")
+	newToken         = ethereum.HexToAddress("0x6666666666666666666666666666666666666666")
+)
+
+type testBlockchain struct {
+	current  uint64
+	events   []common.ContractEvent
+	listed   []ethereum.Address
+	approved map[ethereum.Address]bool
+	admin    ethereum.Address
+	// queried are the block ranges of events queried.
+	queried [][2]uint64
+}
+
+func (b *testBlockchain) CurrentBlock() (uint64, error) {
+	return b.current, nil
+}
+
+func (b *testBlockchain) GetContractEvents(fromBlock, toBlock uint64, events []string) ([]common.ContractEvent, error) {
+	b.queried = append(b.queried, [2]uint64{fromBlock, toBlock})
+	var result []common.ContractEvent
+	for _, event := range b.events {
+		if event.BlockNumber >= fromBlock && event.BlockNumber <= toBlock {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+func (b *testBlockchain) IsApprovedWithdrawAddress(token, addr ethereum.Address, block uint64) (bool, error) {
+	return b.approved[addr], nil
+}
+
+func (b *testBlockchain) OperatorAddresses() map[string]ethereum.Address {
+	return map[string]ethereum.Address{
+		blockchain.PricingOP: pricingOPAddress,
+		blockchain.DepositOP: depositOPAddress,
+	}
+}
+
+func (b *testBlockchain) ListedTokens() []ethereum.Address {
+	return b.listed
+}
+
+func (b *testBlockchain) GetPricingAddress() ethereum.Address {
+	return ethereum.Address{}
+}
+
+func (b *testBlockchain) GetPricingAdmin(block uint64) (ethereum.Address, error) {
+	return b.admin, nil
+}
+
+type testStorage struct {
+	findings []common.SecurityFinding
+}
+
+func (s *testStorage) StoreSecurityFindings(findings []common.SecurityFinding) error {
+	s.findings = append(s.findings, findings...)
+	return nil
+}
+
+func (s *testStorage) GetSecurityFindings(from, to time.Time, severities []string) ([]common.SecurityFinding, error) {
+	return s.findings, nil
+}
+
+func event(block uint64, contract, name string, sender ethereum.Address, params map[string]interface{}) common.ContractEvent {
+	return common.ContractEvent{
+		Contract:    contract,
+		Event:       name,
+		Params:      params,
+		BlockNumber: block,
+		TxHash:      ethereum.BigToHash(big.NewInt(int64(block))),
+		Sender:      sender,
+	}
+}
+
+func TestMonitorCheck(t *testing.T) {
+	bc := &testBlockchain{
+		current: 10000,
+		listed:  []ethereum.Address{token},
+		approved: map[ethereum.Address]bool{
+			exchange: true,
+		},
+		admin: attacker,
+		events: []common.ContractEvent{
+			// withdraw of our deposit operator to an approved address
+			event(4500, common.ContractReserve, "WithdrawFunds", depositOPAddress,
+				map[string]interface{}{"token": token, "amount": big.NewInt(1), "destination": exchange}),
+			// operator added by our operator, e.g a key rotation
+			event(4600, common.ContractPricing, "OperatorAdded", pricingOPAddress,
+				map[string]interface{}{"newOperator": depositOPAddress, "isAdd": true}),
+			event(4700, common.ContractReserve, "OperatorAdded", attacker,
+				map[string]interface{}{"newOperator": attacker, "isAdd": true}),
+			event(4800, common.ContractReserve, "TokenWithdraw", attacker,
+				map[string]interface{}{"token": token, "amount": big.NewInt(1), "sendTo": attacker}),
+			event(4900, common.ContractReserve, "TransferAdminPending", attacker,
+				map[string]interface{}{"pendingAdmin": attacker}),
+		},
+	}
+	storage := &testStorage{}
+	m := NewMonitor(bc, storage, common.SecurityMonitorConfig{StartBlocks: 5760, MaxBlocksPerCheck: 1000})
+
+	require.NoError(t, m.Check())
+	require.Equal(t, [][2]uint64{{4240, 5239}}, bc.queried)
+	require.Len(t, storage.findings, 3)
+	require.Equal(t, common.SeverityHigh, storage.findings[0].Severity)
+	require.Equal(t, "OperatorAdded", storage.findings[0].Event)
+	require.Equal(t, common.SeverityCritical, storage.findings[1].Severity)
+	require.Equal(t, "TokenWithdraw", storage.findings[1].Event)
+	require.Equal(t, common.SeverityCritical, storage.findings[2].Severity)
+	require.Equal(t, "TransferAdminPending", storage.findings[2].Event)
+
+	// listings are compared with the previous check
+	storage.findings = nil
+	bc.listed = []ethereum.Address{token, newToken}
+	require.NoError(t, m.Check())
+	require.Equal(t, [2]uint64{5240, 6239}, bc.queried[1])
+	require.Len(t, storage.findings, 1)
+	require.Equal(t, "TokenListed", storage.findings[0].Event)
+	require.Equal(t, common.SeverityHigh, storage.findings[0].Severity)
+	require.Equal(t, attacker, storage.findings[0].Sender)
+
+	// listings of our pricing admin are not flagged
+	storage.findings = nil
+	bc.admin = pricingOPAddress
+	bc.listed = []ethereum.Address{token}
+	require.NoError(t, m.Check())
+	require.Empty(t, storage.findings)
+}
+
+func TestMonitorCheckUndecodableLog(t *testing.T) {
+	undecodable := event(4500, common.ContractReserve, "TokenWithdraw", attacker, nil)
+	undecodable.DecodeError = "abi: cannot marshal in to go type"
+	bc := &testBlockchain{
+		current: 10000,
+		events:  []common.ContractEvent{undecodable},
+	}
+	storage := &testStorage{}
+	m := NewMonitor(bc, storage, common.SecurityMonitorConfig{StartBlocks: 5760, MaxBlocksPerCheck: 1000})
+
+	require.NoError(t, m.Check())
+	require.Len(t, storage.findings, 1)
+	require.Equal(t, common.SeverityHigh, storage.findings[0].Severity)
+	require.Contains(t, storage.findings[0].Description, "cannot be decoded")
+	require.Equal(t, uint64(5239), m.lastBlock, "blocks with undecodable logs must not be checked again")
+}
+
+func TestClassifyWithdraw(t *testing.T) {
+	bc := &testBlockchain{approved: map[ethereum.Address]bool{exchange: true}}
+	m := NewMonitor(bc, &testStorage{}, common.SecurityMonitorConfig{})
+	operators := map[ethereum.Address]bool{pricingOPAddress: true, depositOPAddress: true}
+
+	tests := []struct {
+		name     string
+		event    common.ContractEvent
+		severity string
+	}{
+		{
+			name: "top up of our operator by our operator",
+			event: event(1, common.ContractReserve, "EtherWithdraw", depositOPAddress,
+				map[string]interface{}{"amount": big.NewInt(1), "sendTo": pricingOPAddress}),
+		},
+		{
+			name: "withdraw to an approved address by someone else",
+			event: event(1, common.ContractReserve, "TokenWithdraw", attacker,
+				map[string]interface{}{"token": token, "amount": big.NewInt(1), "sendTo": exchange}),
+			severity: common.SeverityMedium,
+		},
+		{
+			name: "withdraw to a not approved address by our operator",
+			event: event(1, common.ContractReserve, "TokenWithdraw", depositOPAddress,
+				map[string]interface{}{"token": token, "amount": big.NewInt(1), "sendTo": attacker}),
+			severity: common.SeverityHigh,
+		},
+		{
+			name: "withdraw from pricing contract by someone else",
+			event: event(1, common.ContractPricing, "EtherWithdraw", attacker,
+				map[string]interface{}{"amount": big.NewInt(1), "sendTo": exchange}),
+			severity: common.SeverityCritical,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			finding, err := m.classify(tc.event, operators)
+			require.NoError(t, err)
+			if tc.severity == "" {
+				require.Nil(t, finding)
+				return
+			}
+			require.NotNil(t, finding)
+			require.Equal(t, tc.severity, finding.Severity)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+
+	reserveblockchain "github.com/KyberNetwork/reserve-data/blockchain"
+	"github.com/KyberNetwork/reserve-data/blockchain/simulated"
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+	"github.com/KyberNetwork/reserve-data/common/gasinfo"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// recordActivityStorage keeps the recorded activities.
+type recordActivityStorage struct {
+	testActivityStorage
+	records []common.ActivityRecord
+}
+
+func (s *recordActivityStorage) Record(
+	action string,
+	id common.ActivityID,
+	destination string,
+	params common.ActivityParams,
+	result common.ActivityResult,
+	estatus string,
+	mstatus string,
+	timepoint uint64,
+	isPending bool) error {
+	s.records = append(s.records, common.ActivityRecord{
+		Action:         action,
+		ID:             id,
+		Destination:    destination,
+		Params:         &params,
+		Result:         &result,
+		ExchangeStatus: estatus,
+		MiningStatus:   mstatus,
+	})
+	return nil
+}
+
+func TestSimulatedSetRates(t *testing.T) {
+	chain := simulated.NewChain(t)
+	defer chain.Close()
+	reserve := chain.DeployReserve(t)
+	knc := commonv3.Asset{
+		ID:       2,
+		Symbol:   "KNC",
+		Address:  chain.DeployToken(t, "KNC", 18),
+		Decimals: 18,
+		SetRate:  commonv3.ExchangeFeed,
+	}
+	reserve.ListToken(t, knc.Address)
+	pricing, deposit := chain.NewOperator(t), chain.NewOperator(t)
+	reserve.AddOperator(t, pricing.Address)
+	base := chain.NewBaseBlockchain(map[string]*blockchain.Operator{
+		blockchain.PricingOP: pricing,
+		blockchain.DepositOP: deposit,
+	})
+	bc, err := reserveblockchain.NewBlockchain(base, &reserve.Addresses, &simulated.AssetReader{Assets: []commonv3.Asset{knc}})
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	if err := bc.LoadAndSetTokenIndices(); err != nil {
+		t.Fatalf("failed to load token indices: %v", err)
+	}
+	storage := &recordActivityStorage{}
+	core := NewReserveCore(bc, storage, &reserve.Addresses,
+		gasinfo.NewGasPriceInfo(&gasinfo.ConstGasPriceLimiter{}, &ExampleGasConfig{}, &ExampleGasClient{}), nil)
+
+	header, err := chain.Client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("failed to get block: %v", err)
+	}
+	buys := []*big.Int{common.EthToWei(500)}
+	sells := []*big.Int{common.FloatToBigInt(0.0019, 18)}
+	mids := []*big.Int{common.FloatToBigInt(0.00195, 18)}
+	if _, err := core.SetRates([]commonv3.Asset{knc}, buys, sells, new(big.Int).Set(header.Number), mids, []string{""}, []bool{false}); err != nil {
+		t.Fatalf("failed to set rates: %v", err)
+	}
+
+	if len(storage.records) != 1 {
+		t.Fatalf("expected 1 set rate activity, got %d", len(storage.records))
+	}
+	record := storage.records[0]
+	if record.Action != common.ActionSetRate || record.MiningStatus != common.MiningStatusSubmitted {
+		t.Fatalf("expected submitted set rate activity, got %s %s", record.Action, record.MiningStatus)
+	}
+	if record.Result.Operator != blockchain.PricingOP || record.Result.Nonce != 0 {
+		t.Fatalf("expected first tx of pricing operator, got %s nonce %d", record.Result.Operator, record.Result.Nonce)
+	}
+	status, _, err := bc.TxStatus(ethereum.HexToHash(record.Result.Tx))
+	if err != nil || status != common.MiningStatusMined {
+		t.Fatalf("expected set rate tx to be mined, got %s, err: %v", status, err)
+	}
+	for side, intended := range map[string]*big.Int{"buy": buys[0], "sell": sells[0]} {
+		rate, err := bc.GetPrice(knc.Address, header.Number, side, big.NewInt(0), 0)
+		if err != nil {
+			t.Fatalf("failed to get %s rate: %v", side, err)
+		}
+		if rate.Cmp(intended) != 0 {
+			t.Fatalf("expected %s rate %s, got %s", side, intended, rate)
+		}
+	}
+}
@@ -0,0 +1,318 @@
+// Package balancehistory records hourly balances of every asset in reserve and exchanges and reconciles
+// their changes against recorded activities.
+package balancehistory
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+const (
+	// Period is the time between two balance snapshots in history.
+	Period = time.Hour
+	// DefaultTolerance is the default max unexplained change relative to balance of an asset.
+	DefaultTolerance = 0.005
+	// initialBackfill is how far back history is built when there is none.
+	initialBackfill = 24 * time.Hour
+)
+
+// Balance is balance of an asset at Time in reserve (ExchangeID 0) or an exchange account,
+// exchange balance is available plus locked balance.
+type Balance struct {
+	Time       uint64            `json:"time"`
+	AssetID    rtypes.AssetID    `json:"asset_id"`
+	ExchangeID rtypes.ExchangeID `json:"exchange_id"`
+	Balance    float64           `json:"balance"`
+}
+
+// Reconciliation explains change of total balance of an asset in the period ending at Time.
+// Closing is total balance in reserve, exchanges and in flight between them at Time, Opening is
+// Closing of the previous period, it is nil if previous period is missing.
+type Reconciliation struct {
+	Time        uint64         `json:"time"`
+	AssetID     rtypes.AssetID `json:"asset_id"`
+	Opening     *float64       `json:"opening"`
+	Closing     float64        `json:"closing"`
+	InFlight    float64        `json:"in_flight"`
+	Traded      float64        `json:"traded"`
+	Deposited   float64        `json:"deposited"`
+	Withdrawn   float64        `json:"withdrawn"`
+	WithdrawFee float64        `json:"withdraw_fee"`
+	Unexplained float64        `json:"unexplained"`
+	Flagged     bool           `json:"flagged"`
+	// Complete is false if balance of an exchange was not available at Time.
+	Complete bool `json:"complete"`
+}
+
+// Storage stores balance history and reads auth data and activities to build it.
+type Storage interface {
+	CurrentAuthDataVersion(timepoint uint64) (common.Version, error)
+	GetAuthData(common.Version) (common.AuthDataSnapshot, error)
+	GetAllRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error)
+
+	StoreBalanceHistory(t time.Time, balances []Balance, reconciliations []Reconciliation) error
+	// LastBalanceHistoryTime returns time of last stored balances, it is zero if there is none.
+	LastBalanceHistoryTime() (time.Time, error)
+	// GetBalanceHistory returns balances in [from, to), of all assets if assetID is 0.
+	GetBalanceHistory(assetID rtypes.AssetID, from, to time.Time) ([]Balance, error)
+	GetReconciliations(from, to time.Time, flaggedOnly bool) ([]Reconciliation, error)
+}
+
+// SettingStorage returns assets and trading pairs.
+type SettingStorage interface {
+	GetAssets() ([]v3.Asset, error)
+	GetTradingPair(id rtypes.TradingPairID, withDeleted bool) (v3.TradingPairSymbols, error)
+}
+
+// Reconciler builds balance history and reconciliation reports.
+type Reconciler struct {
+	s         Storage
+	settings  SettingStorage
+	tolerance float64
+	l         *zap.SugaredLogger
+}
+
+// NewReconciler creates a new Reconciler, changes not explained by activities are flagged when
+// larger than tolerance times balance of the asset.
+func NewReconciler(s Storage, settings SettingStorage, tolerance float64) *Reconciler {
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	return &Reconciler{s: s, settings: settings, tolerance: tolerance, l: zap.S()}
+}
+
+// Run builds history every interval, it never returns.
+func (r *Reconciler) Run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for t := range ticker.C {
+		if err := r.Process(t); err != nil {
+			r.l.Errorw("failed to build balance history", "err", err)
+		}
+	}
+}
+
+// Process records balances at every period boundary before now which is not recorded yet.
+func (r *Reconciler) Process(now time.Time) error {
+	end := now.UTC().Truncate(Period)
+	last, err := r.s.LastBalanceHistoryTime()
+	if err != nil {
+		return err
+	}
+	start := last.Add(Period)
+	if last.IsZero() {
+		start = end.Add(-initialBackfill)
+	}
+	var previous map[rtypes.AssetID]Reconciliation
+	for t := start; !t.After(end); t = t.Add(Period) {
+		if previous == nil {
+			if previous, err = r.previousReconciliations(t); err != nil {
+				return err
+			}
+		}
+		version, err := r.s.CurrentAuthDataVersion(common.TimeToMillis(t))
+		if err != nil {
+			r.l.Debugw("no auth data for balance history", "time", t, "err", err)
+			previous = map[rtypes.AssetID]Reconciliation{}
+			continue
+		}
+		authData, err := r.s.GetAuthData(version)
+		if err != nil {
+			return err
+		}
+		activities, err := r.s.GetAllRecords(common.TimeToMillis(t.Add(-Period)), common.TimeToMillis(t)-1)
+		if err != nil {
+			return err
+		}
+		balances, reconciliations, err := r.build(t, authData, activities, previous)
+		if err != nil {
+			return err
+		}
+		if err = r.s.StoreBalanceHistory(t, balances, reconciliations); err != nil {
+			return err
+		}
+		previous = make(map[rtypes.AssetID]Reconciliation, len(reconciliations))
+		for _, rec := range reconciliations {
+			previous[rec.AssetID] = rec
+			if rec.Flagged {
+				r.l.Errorw("unexplained balance change", "time", t, "asset", rec.AssetID,
+					"unexplained", rec.Unexplained, "opening", *rec.Opening, "closing", rec.Closing)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) previousReconciliations(t time.Time) (map[rtypes.AssetID]Reconciliation, error) {
+	prevTime := t.Add(-Period)
+	reconciliations, err := r.s.GetReconciliations(prevTime, prevTime.Add(time.Millisecond), false)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[rtypes.AssetID]Reconciliation, len(reconciliations))
+	for _, rec := range reconciliations {
+		result[rec.AssetID] = rec
+	}
+	return result, nil
+}
+
+// build returns balances at t and reconciliations of the period ending at t.
+func (r *Reconciler) build(t time.Time, authData common.AuthDataSnapshot, activities []common.ActivityRecord,
+	previous map[rtypes.AssetID]Reconciliation) ([]Balance, []Reconciliation, error) {
+	assets, err := r.settings.GetAssets()
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		ts       = common.TimeToMillis(t)
+		balances []Balance
+		totals   = make(map[rtypes.AssetID]*Reconciliation)
+		complete = true
+	)
+	total := func(assetID rtypes.AssetID) *Reconciliation {
+		rec, ok := totals[assetID]
+		if !ok {
+			rec = &Reconciliation{Time: ts, AssetID: assetID}
+			totals[assetID] = rec
+		}
+		return rec
+	}
+	for _, asset := range assets {
+		balance, ok := authData.ReserveBalances[asset.ID]
+		if !ok || !balance.Valid {
+			continue
+		}
+		value := balance.Balance.ToFloat(int64(asset.Decimals))
+		balances = append(balances, Balance{Time: ts, AssetID: asset.ID, Balance: value})
+		total(asset.ID).Closing += value
+	}
+	for exchangeID, ebalance := range authData.ExchangeBalances {
+		if !ebalance.Valid {
+			complete = false
+			continue
+		}
+		values := make(map[rtypes.AssetID]float64)
+		for assetID, v := range ebalance.AvailableBalance {
+			values[assetID] += v
+		}
+		for assetID, v := range ebalance.LockedBalance {
+			values[assetID] += v
+		}
+		for assetID, v := range values {
+			balances = append(balances, Balance{Time: ts, AssetID: assetID, ExchangeID: exchangeID, Balance: v})
+			total(assetID).Closing += v
+		}
+	}
+	for _, activity := range authData.PendingActivities {
+		if activity.Params == nil {
+			continue
+		}
+		if amount := inFlightAmount(activity); amount != 0 {
+			rec := total(activity.Params.Asset)
+			rec.InFlight += amount
+			rec.Closing += amount
+		}
+	}
+	for _, activity := range activities {
+		if activity.Params == nil || activity.Result == nil {
+			continue
+		}
+		switch activity.Action {
+		case common.ActionDeposit:
+			if activity.MiningStatus != common.MiningStatusFailed {
+				total(activity.Params.Asset).Deposited += activity.Params.Amount
+			}
+		case common.ActionWithdraw:
+			if activity.ExchangeStatus != common.ExchangeStatusFailed && activity.ExchangeStatus != common.ExchangeStatusCancelled {
+				rec := total(activity.Params.Asset)
+				rec.Withdrawn += activity.Params.Amount
+				rec.WithdrawFee += activity.Result.WithdrawFee
+			}
+		case common.ActionTrade:
+			if err := r.addTrade(activity, total); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	reconciliations := make([]Reconciliation, 0, len(totals))
+	for assetID, rec := range totals {
+		rec.Complete = complete
+		if prev, ok := previous[assetID]; ok {
+			opening := prev.Closing
+			rec.Opening = &opening
+			rec.Unexplained = rec.Closing - opening - rec.Traded + rec.WithdrawFee
+			limit := r.tolerance * math.Max(math.Abs(opening), math.Abs(rec.Closing))
+			rec.Flagged = complete && prev.Complete && math.Abs(rec.Unexplained) > limit
+		}
+		reconciliations = append(reconciliations, *rec)
+	}
+	sort.Slice(reconciliations, func(i, j int) bool { return reconciliations[i].AssetID < reconciliations[j].AssetID })
+	sort.Slice(balances, func(i, j int) bool {
+		if balances[i].AssetID != balances[j].AssetID {
+			return balances[i].AssetID < balances[j].AssetID
+		}
+		return balances[i].ExchangeID < balances[j].ExchangeID
+	})
+	return balances, reconciliations, nil
+}
+
+func (r *Reconciler) addTrade(activity common.ActivityRecord, total func(rtypes.AssetID) *Reconciliation) error {
+	filled := activity.Result.Done
+	if activity.ExchangeStatus == common.ExchangeStatusDone {
+		filled = activity.Params.Amount - activity.Result.Remaining
+	}
+	if filled == 0 {
+		return nil
+	}
+	pair, err := r.settings.GetTradingPair(activity.Params.TradingPairID, true)
+	if err != nil {
+		return err
+	}
+	switch activity.Params.Type {
+	case "buy":
+		total(pair.Base).Traded += filled
+		total(pair.Quote).Traded -= filled * activity.Params.Rate
+	case "sell":
+		total(pair.Base).Traded -= filled
+		total(pair.Quote).Traded += filled * activity.Params.Rate
+	}
+	return nil
+}
+
+// inFlightAmount returns amount of a pending activity which left reserve or an exchange but has not
+// arrived at the other side yet.
+func inFlightAmount(activity common.ActivityRecord) float64 {
+	switch activity.Action {
+	case common.ActionDeposit:
+		if activity.MiningStatus == common.MiningStatusMined && activity.IsExchangePending() {
+			return activity.Params.Amount
+		}
+	case common.ActionWithdraw:
+		if activity.IsBlockchainPending() {
+			var fee float64
+			if activity.Result != nil {
+				fee = activity.Result.WithdrawFee
+			}
+			return activity.Params.Amount - fee
+		}
+	}
+	return 0
+}
+
+// History returns balances of an asset, or all assets if assetID is 0, in [from, to).
+func (r *Reconciler) History(assetID rtypes.AssetID, from, to time.Time) ([]Balance, error) {
+	return r.s.GetBalanceHistory(assetID, from, to)
+}
+
+// Reconciliations returns reconciliations of periods ending in [from, to).
+func (r *Reconciler) Reconciliations(from, to time.Time, flaggedOnly bool) ([]Reconciliation, error) {
+	return r.s.GetReconciliations(from, to, flaggedOnly)
+}
@@ -0,0 +1,171 @@
+package balancehistory
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type memoryStorage struct {
+	authData        map[uint64]common.AuthDataSnapshot
+	activities      []common.ActivityRecord
+	balances        map[time.Time][]Balance
+	reconciliations map[time.Time][]Reconciliation
+}
+
+func (s *memoryStorage) CurrentAuthDataVersion(timepoint uint64) (common.Version, error) {
+	if _, ok := s.authData[timepoint]; !ok {
+		return 0, errors.New("no version")
+	}
+	return common.Version(timepoint), nil
+}
+
+func (s *memoryStorage) GetAuthData(v common.Version) (common.AuthDataSnapshot, error) {
+	return s.authData[uint64(v)], nil
+}
+
+func (s *memoryStorage) GetAllRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error) {
+	var result []common.ActivityRecord
+	for _, a := range s.activities {
+		if a.ID.Timepoint >= fromTime && a.ID.Timepoint <= toTime {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func (s *memoryStorage) StoreBalanceHistory(t time.Time, balances []Balance, reconciliations []Reconciliation) error {
+	s.balances[t] = balances
+	s.reconciliations[t] = reconciliations
+	return nil
+}
+
+func (s *memoryStorage) LastBalanceHistoryTime() (time.Time, error) {
+	var last time.Time
+	for t := range s.balances {
+		if t.After(last) {
+			last = t
+		}
+	}
+	return last, nil
+}
+
+func (s *memoryStorage) GetBalanceHistory(assetID rtypes.AssetID, from, to time.Time) ([]Balance, error) {
+	panic("not used")
+}
+
+func (s *memoryStorage) GetReconciliations(from, to time.Time, flaggedOnly bool) ([]Reconciliation, error) {
+	var result []Reconciliation
+	for t, recs := range s.reconciliations {
+		if !t.Before(from) && t.Before(to) {
+			result = append(result, recs...)
+		}
+	}
+	return result, nil
+}
+
+type settings struct{}
+
+func (settings) GetAssets() ([]v3.Asset, error) {
+	return []v3.Asset{{ID: 1, Decimals: 18}, {ID: 2, Decimals: 6}}, nil
+}
+
+func (settings) GetTradingPair(id rtypes.TradingPairID, withDeleted bool) (v3.TradingPairSymbols, error) {
+	return v3.TradingPairSymbols{TradingPair: v3.TradingPair{ID: id, Base: 2, Quote: 1}}, nil
+}
+
+func authData(ethReserve int64, usdtReserve, ethBinance, usdtBinance float64, pending ...common.ActivityRecord) common.AuthDataSnapshot {
+	return common.AuthDataSnapshot{
+		ReserveBalances: map[rtypes.AssetID]common.BalanceEntry{
+			1: {Valid: true, Balance: common.RawBalance(*new(big.Int).Mul(big.NewInt(ethReserve), big.NewInt(1e18)))},
+			2: {Valid: true, Balance: common.RawBalance(*big.NewInt(int64(usdtReserve * 1e6)))},
+		},
+		ExchangeBalances: map[rtypes.ExchangeID]common.EBalanceEntry{
+			rtypes.Binance: {
+				Valid:            true,
+				AvailableBalance: map[rtypes.AssetID]float64{1: ethBinance, 2: usdtBinance - 10},
+				LockedBalance:    map[rtypes.AssetID]float64{2: 10},
+			},
+		},
+		PendingActivities: pending,
+	}
+}
+
+func TestReconciler_Process(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts := func(hours int) uint64 { return common.TimeToMillis(start.Add(time.Duration(hours) * time.Hour)) }
+	withdraw := common.ActivityRecord{
+		Action: common.ActionWithdraw,
+		ID:     common.ActivityID{Timepoint: ts(2) - 10},
+		Params: &common.ActivityParams{Asset: 1, Amount: 5},
+		Result: &common.ActivityResult{WithdrawFee: 0.01},
+	}
+	s := &memoryStorage{
+		authData: map[uint64]common.AuthDataSnapshot{
+			ts(0): authData(100, 1000, 10, 500),
+			// bought 10 usdt at 0.01 eth each, minus 0.01 usdt fee
+			ts(1): authData(100, 1000, 9.9, 509.99),
+			// withdrawing 5 eth is in flight
+			ts(2): authData(100, 1000, 4.9, 509.99, withdraw),
+			// withdraw arrived, 100 usdt airdrop
+			ts(3): authData(104, 1100, 4.9+0.99, 509.99),
+		},
+		activities: []common.ActivityRecord{
+			{
+				Action:         common.ActionTrade,
+				ID:             common.ActivityID{Timepoint: ts(0) + 10},
+				ExchangeStatus: common.ExchangeStatusDone,
+				Params:         &common.ActivityParams{Type: "buy", Amount: 10, Rate: 0.01, TradingPairID: 1},
+				Result:         &common.ActivityResult{},
+			},
+			withdraw,
+		},
+		balances:        make(map[time.Time][]Balance),
+		reconciliations: make(map[time.Time][]Reconciliation),
+	}
+	r := NewReconciler(s, settings{}, 0.001)
+	require.NoError(t, r.Process(start.Add(3*time.Hour+time.Minute)))
+
+	assert.Equal(t, []Balance{
+		{Time: ts(0), AssetID: 1, Balance: 100},
+		{Time: ts(0), AssetID: 1, ExchangeID: rtypes.Binance, Balance: 10},
+		{Time: ts(0), AssetID: 2, Balance: 1000},
+		{Time: ts(0), AssetID: 2, ExchangeID: rtypes.Binance, Balance: 500},
+	}, s.balances[start])
+
+	first := s.reconciliations[start]
+	require.Len(t, first, 2)
+	assert.Nil(t, first[0].Opening)
+	assert.False(t, first[0].Flagged)
+
+	trade := s.reconciliations[start.Add(time.Hour)]
+	require.Len(t, trade, 2)
+	assert.InDelta(t, -0.1, trade[0].Traded, 1e-9)
+	assert.InDelta(t, 0, trade[0].Unexplained, 1e-9)
+	assert.InDelta(t, 10, trade[1].Traded, 1e-9)
+	assert.InDelta(t, -0.01, trade[1].Unexplained, 1e-9)
+	assert.False(t, trade[1].Flagged)
+
+	inFlight := s.reconciliations[start.Add(2*time.Hour)]
+	assert.InDelta(t, 4.99, inFlight[0].InFlight, 1e-9)
+	assert.InDelta(t, 0, inFlight[0].Unexplained, 1e-9)
+	assert.False(t, inFlight[0].Flagged)
+
+	airdrop := s.reconciliations[start.Add(3*time.Hour)]
+	assert.InDelta(t, 0, airdrop[0].Unexplained, 1e-9)
+	assert.False(t, airdrop[0].Flagged)
+	assert.InDelta(t, 100, airdrop[1].Unexplained, 1e-9)
+	assert.True(t, airdrop[1].Flagged)
+
+	// nothing new to process
+	require.NoError(t, r.Process(start.Add(3*time.Hour+30*time.Minute)))
+	assert.Len(t, s.balances, 4)
+}
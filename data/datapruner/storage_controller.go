@@ -1,22 +1,33 @@
 package datapruner
 
 import (
-	"github.com/KyberNetwork/reserve-data/common/archive"
-)
+	"fmt"
+	"strings"
 
-const (
-	expiredAuthDataPath = "expired-auth-data/"
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/archive"
 )
 
 type StorageController struct {
-	Runner              StorageControllerRunner
-	Arch                archive.Archive
-	ExpiredAuthDataPath string
+	Runner    StorageControllerRunner
+	Archives  []archive.Archive
+	Retention common.RetentionConfig
 }
 
-func NewStorageController(storageControllerRunner StorageControllerRunner, arch archive.Archive) (StorageController, error) {
+func NewStorageController(storageControllerRunner StorageControllerRunner, archives []archive.Archive,
+	retention common.RetentionConfig) (StorageController, error) {
+	for dataType := range retention {
+		if err := common.ValidPrunableData(dataType); err != nil {
+			return StorageController{}, err
+		}
+	}
 	storageController := StorageController{
-		storageControllerRunner, arch, expiredAuthDataPath,
+		storageControllerRunner, archives, retention,
 	}
 	return storageController, nil
 }
+
+// ExpiredDataPath returns folder in archives to store expired data of dataType, e.g expired-auth-data/.
+func ExpiredDataPath(dataType common.PrunableData) string {
+	return fmt.Sprintf("expired-%s/", strings.Replace(string(dataType), "_", "-", -1))
+}
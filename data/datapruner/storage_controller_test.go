@@ -0,0 +1,21 @@
+package datapruner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+func TestExpiredDataPath(t *testing.T) {
+	require.Equal(t, "expired-auth-data/", ExpiredDataPath(common.PrunableAuthData))
+	require.Equal(t, "expired-activity/", ExpiredDataPath(common.PrunableActivity))
+}
+
+func TestNewStorageController(t *testing.T) {
+	_, err := NewStorageController(nil, nil, common.RetentionConfig{"unknown": 0})
+	require.Error(t, err)
+	_, err = NewStorageController(nil, nil, common.DefaultRetention())
+	require.NoError(t, err)
+}
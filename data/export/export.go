@@ -0,0 +1,313 @@
+// Package export flattens fetch data into tidy CSV tables partitioned by day for analytics.
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+const (
+	// TablePrices has one row per order book level per trading pair per exchange.
+	TablePrices = "prices"
+	// TableExchangeBalances has one row per asset balance per exchange.
+	TableExchangeBalances = "exchange_balances"
+	// TableReserveBalances has one row per asset balance of reserve.
+	TableReserveBalances = "reserve_balances"
+	// TableRates has one row per rate per asset.
+	TableRates = "rates"
+
+	dayLayout       = "2006-01-02"
+	timestampLayout = "2006-01-02T15:04:05.000Z"
+)
+
+var headers = map[string][]string{
+	TablePrices:           {"timestamp", "block", "trading_pair_id", "exchange_id", "exchange", "side", "level", "rate", "quantity"},
+	TableExchangeBalances: {"timestamp", "exchange_id", "exchange", "asset_id", "available", "locked", "deposit"},
+	TableReserveBalances:  {"timestamp", "block", "asset_id", "balance"},
+	TableRates:            {"timestamp", "block_number", "asset_id", "block", "base_buy", "compact_buy", "base_sell", "compact_sell"},
+}
+
+// Storage reads fetch data in order of creation.
+type Storage interface {
+	IteratePrices(from, to uint64, fn func(created time.Time, price common.AllPriceEntry) error) error
+	IterateAuthData(from, to uint64, fn func(created time.Time, authData common.AuthDataSnapshot) error) error
+	IterateRates(from, to uint64, fn func(created time.Time, rate common.AllRateEntry) error) error
+}
+
+// ExchangeReader reads exchange accounts from setting database for their names.
+type ExchangeReader interface {
+	GetExchanges() ([]v3.Exchange, error)
+}
+
+// Exporter writes fetch data of a day to <dir>/<table>/date=<YYYY-MM-DD>/<table>.csv.
+type Exporter struct {
+	s   Storage
+	er  ExchangeReader
+	dir string
+	l   *zap.SugaredLogger
+}
+
+// NewExporter creates a new Exporter writing to dir.
+func NewExporter(s Storage, er ExchangeReader, dir string) *Exporter {
+	return &Exporter{s: s, er: er, dir: dir, l: zap.S()}
+}
+
+// PartitionPath returns path of the file holding a table of the UTC day containing t.
+func (e *Exporter) PartitionPath(table string, t time.Time) string {
+	return filepath.Join(e.dir, table, "date="+t.UTC().Format(dayLayout), table+".csv")
+}
+
+// ExportDay writes all tables of the UTC day containing day, existing files of the day are replaced.
+func (e *Exporter) ExportDay(day time.Time) error {
+	start := time.Date(day.UTC().Year(), day.UTC().Month(), day.UTC().Day(), 0, 0, 0, 0, time.UTC)
+	from, to := common.TimeToMillis(start), common.TimeToMillis(start.AddDate(0, 0, 1))
+	exchanges, err := e.er.GetExchanges()
+	if err != nil {
+		return fmt.Errorf("failed to get exchanges: %w", err)
+	}
+	names := make(map[rtypes.ExchangeID]string, len(exchanges))
+	for _, ex := range exchanges {
+		names[ex.ID] = ex.Name
+	}
+
+	err = e.writeTable(TablePrices, start, func(w *csv.Writer) error {
+		return e.s.IteratePrices(from, to, func(created time.Time, price common.AllPriceEntry) error {
+			return w.WriteAll(PriceRows(created, price, names))
+		})
+	})
+	if err != nil {
+		return err
+	}
+	err = e.writeTables([]string{TableExchangeBalances, TableReserveBalances}, start, func(ws []*csv.Writer) error {
+		return e.s.IterateAuthData(from, to, func(created time.Time, authData common.AuthDataSnapshot) error {
+			if err := ws[0].WriteAll(ExchangeBalanceRows(created, authData, names)); err != nil {
+				return err
+			}
+			return ws[1].WriteAll(ReserveBalanceRows(created, authData))
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return e.writeTable(TableRates, start, func(w *csv.Writer) error {
+		return e.s.IterateRates(from, to, func(created time.Time, rate common.AllRateEntry) error {
+			return w.WriteAll(RateRows(created, rate))
+		})
+	})
+}
+
+func (e *Exporter) writeTable(table string, day time.Time, fn func(w *csv.Writer) error) error {
+	return e.writeTables([]string{table}, day, func(ws []*csv.Writer) error {
+		return fn(ws[0])
+	})
+}
+
+// writeTables writes tables to temporary files and moves them to their partitions once all succeed.
+func (e *Exporter) writeTables(tables []string, day time.Time, fn func(ws []*csv.Writer) error) error {
+	var (
+		files   []*os.File
+		writers []*csv.Writer
+	)
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+	for _, table := range tables {
+		path := e.PartitionPath(table, day)
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return err
+		}
+		f, err := os.Create(path + ".tmp")
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+		w := csv.NewWriter(f)
+		if err = w.Write(headers[table]); err != nil {
+			return err
+		}
+		writers = append(writers, w)
+	}
+	if err := fn(writers); err != nil {
+		return fmt.Errorf("failed to export %v of %s: %w", tables, day.Format(dayLayout), err)
+	}
+	for i, w := range writers {
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return err
+		}
+		if err := files[i].Close(); err != nil {
+			return err
+		}
+		if err := os.Rename(files[i].Name(), e.PartitionPath(tables[i], day)); err != nil {
+			return err
+		}
+	}
+	e.l.Infow("exported fetch data", "tables", tables, "date", day.Format(dayLayout))
+	return nil
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timestampLayout)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func bigString(i *big.Int) string {
+	if i == nil {
+		return ""
+	}
+	return i.String()
+}
+
+func formatUint(i uint64) string {
+	return strconv.FormatUint(i, 10)
+}
+
+// exchangeName returns the name of exchange account id in names, or the id if it is not known.
+func exchangeName(names map[rtypes.ExchangeID]string, id rtypes.ExchangeID) string {
+	if name, ok := names[id]; ok {
+		return name
+	}
+	return id.String()
+}
+
+// PriceRows flattens prices into one row per order book level per trading pair per exchange,
+// invalid order books are skipped. names maps exchange accounts to their names.
+func PriceRows(created time.Time, price common.AllPriceEntry, names map[rtypes.ExchangeID]string) [][]string {
+	var (
+		rows    [][]string
+		ts      = formatTime(created)
+		block   = formatUint(price.Block)
+		pairIDs = make([]rtypes.TradingPairID, 0, len(price.Data))
+	)
+	for pairID := range price.Data {
+		pairIDs = append(pairIDs, pairID)
+	}
+	sort.Slice(pairIDs, func(i, j int) bool { return pairIDs[i] < pairIDs[j] })
+	for _, pairID := range pairIDs {
+		onePrice := price.Data[pairID]
+		for _, exchangeID := range sortedExchangeIDs(onePrice) {
+			ep := onePrice[exchangeID]
+			if !ep.Valid {
+				continue
+			}
+			for side, levels := range [][]common.PriceEntry{ep.Bids, ep.Asks} {
+				sideName := "bid"
+				if side == 1 {
+					sideName = "ask"
+				}
+				for level, entry := range levels {
+					rows = append(rows, []string{ts, block, formatUint(uint64(pairID)), formatUint(uint64(exchangeID)),
+						exchangeName(names, exchangeID), sideName, strconv.Itoa(level), formatFloat(entry.Rate), formatFloat(entry.Quantity)})
+				}
+			}
+		}
+	}
+	return rows
+}
+
+// ExchangeBalanceRows flattens exchange balances into one row per asset per exchange,
+// invalid balances are skipped. names maps exchange accounts to their names.
+func ExchangeBalanceRows(created time.Time, authData common.AuthDataSnapshot, names map[rtypes.ExchangeID]string) [][]string {
+	var (
+		rows        [][]string
+		ts          = formatTime(created)
+		exchangeIDs = make([]rtypes.ExchangeID, 0, len(authData.ExchangeBalances))
+	)
+	for exchangeID := range authData.ExchangeBalances {
+		exchangeIDs = append(exchangeIDs, exchangeID)
+	}
+	sort.Slice(exchangeIDs, func(i, j int) bool { return exchangeIDs[i] < exchangeIDs[j] })
+	for _, exchangeID := range exchangeIDs {
+		balance := authData.ExchangeBalances[exchangeID]
+		if !balance.Valid {
+			continue
+		}
+		assets := make(map[rtypes.AssetID]struct{})
+		for _, m := range []map[rtypes.AssetID]float64{balance.AvailableBalance, balance.LockedBalance, balance.DepositBalance} {
+			for assetID := range m {
+				assets[assetID] = struct{}{}
+			}
+		}
+		for _, assetID := range sortedAssetIDs(assets) {
+			rows = append(rows, []string{ts, formatUint(uint64(exchangeID)), exchangeName(names, exchangeID), formatUint(uint64(assetID)),
+				formatFloat(balance.AvailableBalance[assetID]), formatFloat(balance.LockedBalance[assetID]),
+				formatFloat(balance.DepositBalance[assetID])})
+		}
+	}
+	return rows
+}
+
+// ReserveBalanceRows flattens reserve balances into one row per asset, balances are in
+// token unit without decimals applied. Invalid balances are skipped.
+func ReserveBalanceRows(created time.Time, authData common.AuthDataSnapshot) [][]string {
+	var (
+		rows   [][]string
+		ts     = formatTime(created)
+		assets = make(map[rtypes.AssetID]struct{})
+	)
+	for assetID := range authData.ReserveBalances {
+		assets[assetID] = struct{}{}
+	}
+	for _, assetID := range sortedAssetIDs(assets) {
+		balance := authData.ReserveBalances[assetID]
+		if !balance.Valid {
+			continue
+		}
+		rows = append(rows, []string{ts, formatUint(authData.Block), formatUint(uint64(assetID)), bigString((*big.Int)(&balance.Balance))})
+	}
+	return rows
+}
+
+// RateRows flattens rates into one row per asset.
+func RateRows(created time.Time, rate common.AllRateEntry) [][]string {
+	var (
+		rows   [][]string
+		ts     = formatTime(created)
+		assets = make(map[rtypes.AssetID]struct{})
+	)
+	for assetID := range rate.Data {
+		assets[assetID] = struct{}{}
+	}
+	for _, assetID := range sortedAssetIDs(assets) {
+		entry := rate.Data[assetID]
+		rows = append(rows, []string{ts, formatUint(rate.BlockNumber), formatUint(uint64(assetID)), formatUint(entry.Block),
+			bigString(entry.BaseBuy), strconv.Itoa(int(entry.CompactBuy)), bigString(entry.BaseSell), strconv.Itoa(int(entry.CompactSell))})
+	}
+	return rows
+}
+
+func sortedExchangeIDs(onePrice common.OnePrice) []rtypes.ExchangeID {
+	ids := make([]rtypes.ExchangeID, 0, len(onePrice))
+	for id := range onePrice {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func sortedAssetIDs(assets map[rtypes.AssetID]struct{}) []rtypes.AssetID {
+	ids := make([]rtypes.AssetID, 0, len(assets))
+	for id := range assets {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
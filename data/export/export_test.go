@@ -0,0 +1,129 @@
+package export
+
+import (
+	"encoding/csv"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type fakeExchanges []v3.Exchange
+
+func (e fakeExchanges) GetExchanges() ([]v3.Exchange, error) {
+	return e, nil
+}
+
+type fakeStorage struct {
+	created time.Time
+}
+
+func (s fakeStorage) inRange(from, to uint64) bool {
+	ts := common.TimeToMillis(s.created)
+	return ts >= from && ts < to
+}
+
+func (s fakeStorage) IteratePrices(from, to uint64, fn func(created time.Time, price common.AllPriceEntry) error) error {
+	if !s.inRange(from, to) {
+		return nil
+	}
+	return fn(s.created, common.AllPriceEntry{
+		Block: 10,
+		Data: map[rtypes.TradingPairID]common.OnePrice{
+			2: {
+				rtypes.Binance: {
+					Valid: true,
+					Bids:  []common.PriceEntry{{Quantity: 1.5, Rate: 0.01}},
+					Asks:  []common.PriceEntry{{Quantity: 2, Rate: 0.02}, {Quantity: 3, Rate: 0.03}},
+				},
+				rtypes.Huobi: {Valid: false, Error: "timeout"},
+			},
+		},
+	})
+}
+
+func (s fakeStorage) IterateAuthData(from, to uint64, fn func(created time.Time, authData common.AuthDataSnapshot) error) error {
+	if !s.inRange(from, to) {
+		return nil
+	}
+	return fn(s.created, common.AuthDataSnapshot{
+		Block: 11,
+		ExchangeBalances: map[rtypes.ExchangeID]common.EBalanceEntry{
+			rtypes.Binance: {
+				Valid:            true,
+				AvailableBalance: map[rtypes.AssetID]float64{1: 100, 3: 5},
+				LockedBalance:    map[rtypes.AssetID]float64{1: 1},
+			},
+		},
+		ReserveBalances: map[rtypes.AssetID]common.BalanceEntry{
+			1: {Valid: true, Balance: common.RawBalance(*big.NewInt(1000))},
+			2: {Valid: false},
+		},
+	})
+}
+
+func (s fakeStorage) IterateRates(from, to uint64, fn func(created time.Time, rate common.AllRateEntry) error) error {
+	if !s.inRange(from, to) {
+		return nil
+	}
+	return fn(s.created, common.AllRateEntry{
+		BlockNumber: 12,
+		Data: map[rtypes.AssetID]common.RateEntry{
+			3: common.NewRateEntry(big.NewInt(300), 1, big.NewInt(400), -2, 9),
+		},
+	})
+}
+
+func readTable(t *testing.T, path string) [][]string {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	return records
+}
+
+func TestExporter_ExportDay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	created := time.Date(2020, 3, 4, 5, 6, 7, 8000000, time.UTC)
+	e := NewExporter(fakeStorage{created: created}, fakeExchanges{{ID: rtypes.Binance, Name: "binance_main"}}, dir)
+	require.NoError(t, e.ExportDay(created))
+
+	ts := "2020-03-04T05:06:07.008Z"
+	assert.Equal(t, dir+"/prices/date=2020-03-04/prices.csv", e.PartitionPath(TablePrices, created))
+	assert.Equal(t, [][]string{
+		headers[TablePrices],
+		{ts, "10", "2", "1", "binance_main", "bid", "0", "0.01", "1.5"},
+		{ts, "10", "2", "1", "binance_main", "ask", "0", "0.02", "2"},
+		{ts, "10", "2", "1", "binance_main", "ask", "1", "0.03", "3"},
+	}, readTable(t, e.PartitionPath(TablePrices, created)))
+	assert.Equal(t, [][]string{
+		headers[TableExchangeBalances],
+		{ts, "1", "binance_main", "1", "100", "1", "0"},
+		{ts, "1", "binance_main", "3", "5", "0", "0"},
+	}, readTable(t, e.PartitionPath(TableExchangeBalances, created)))
+	assert.Equal(t, [][]string{
+		headers[TableReserveBalances],
+		{ts, "11", "1", "1000"},
+	}, readTable(t, e.PartitionPath(TableReserveBalances, created)))
+	assert.Equal(t, [][]string{
+		headers[TableRates],
+		{ts, "12", "3", "9", "300", "1", "400", "-2"},
+	}, readTable(t, e.PartitionPath(TableRates, created)))
+
+	// a day without data only has headers
+	nextDay := created.AddDate(0, 0, 1)
+	require.NoError(t, e.ExportDay(nextDay))
+	assert.Equal(t, [][]string{headers[TableRates]}, readTable(t, e.PartitionPath(TableRates, nextDay)))
+}
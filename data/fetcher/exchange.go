@@ -10,6 +10,7 @@ import (
 // Exchange is the common interface of centralized exchanges.
 type Exchange interface {
 	ID() rtypes.ExchangeID
+	Name() string
 	FetchPriceData(timepoint uint64) (map[rtypes.TradingPairID]common.ExchangePrice, error)
 	FetchEBalanceData(timepoint uint64) (common.EBalanceEntry, error)
 	// FetchTradeHistory gets history and save data in the exchange db
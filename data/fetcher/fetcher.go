@@ -12,6 +12,7 @@ import (
 	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/common/blockchain"
 	"github.com/KyberNetwork/reserve-data/core"
+	"github.com/KyberNetwork/reserve-data/lib/eventbus"
 	"github.com/KyberNetwork/reserve-data/lib/rtypes"
 )
 
@@ -33,6 +34,14 @@ type Fetcher struct {
 	contractAddressConf    *common.ContractAddressConfiguration
 	l                      *zap.SugaredLogger
 	reserveCore            *core.ReserveCore
+	eventBus               *eventbus.Bus
+	operatorBalances       OperatorBalanceMonitor
+}
+
+// OperatorBalanceMonitor monitors ETH balances of operators.
+type OperatorBalanceMonitor interface {
+	Run()
+	OperatorBalances() []common.OperatorBalance
 }
 
 func NewFetcher(
@@ -60,6 +69,17 @@ func (f *Fetcher) SetBlockchain(blockchain Blockchain) {
 	f.FetchCurrentBlock(common.NowInMillis())
 }
 
+// SetEventBus sets the bus to publish price, auth data and activity status events to.
+func (f *Fetcher) SetEventBus(bus *eventbus.Bus) {
+	f.eventBus = bus
+}
+
+// SetOperatorBalanceMonitor sets the monitor run with the fetcher, the balances it reports are
+// included in auth data.
+func (f *Fetcher) SetOperatorBalanceMonitor(monitor OperatorBalanceMonitor) {
+	f.operatorBalances = monitor
+}
+
 func (f *Fetcher) AddExchange(exchange Exchange) {
 	f.exchanges = append(f.exchanges, exchange)
 }
@@ -79,6 +99,9 @@ func (f *Fetcher) Run() error {
 	go f.RunBlockFetcher()
 	go f.RunGlobalDataFetcher()
 	go f.RunFetchExchangeHistory()
+	if f.operatorBalances != nil {
+		go f.operatorBalances.Run()
+	}
 	f.l.Infof("Fetcher runner is running...")
 	return nil
 }
@@ -281,6 +304,9 @@ func (f *Fetcher) FetchAllAuthData(timepoint uint64) {
 		snapshot.Valid = false
 	}
 	snapshot.Block = f.currentBlock
+	if f.operatorBalances != nil {
+		snapshot.OperatorBalances = f.operatorBalances.OperatorBalances()
+	}
 	snapshot.ReturnTime = common.GetTimestamp()
 	err = f.PersistSnapshot(
 		&ebalances, bbalances, &estatuses, &bstatuses,
@@ -353,11 +379,8 @@ func (f *Fetcher) FetchBalanceFromBlockchain() (map[rtypes.AssetID]common.Balanc
 }
 
 func (f *Fetcher) newNonceValidator() func(common.ActivityRecord) bool {
-	// GetMinedNonceWithOP might be slow, use closure to not invoke it every time
-	minedNonce, err := f.blockchain.GetMinedNonceWithOP(blockchain.PricingOP)
-	if err != nil {
-		f.l.Warnw("Getting mined nonce failed", "err", err)
-	}
+	// GetMinedNonceWithOP might be slow, use closure to not invoke it every time for an operator
+	minedNonces := map[string]uint64{}
 
 	return func(act common.ActivityRecord) bool {
 		// this check only works with set rate transaction as:
@@ -366,6 +389,15 @@ func (f *Fetcher) newNonceValidator() func(common.ActivityRecord) bool {
 		if act.Action != common.ActionSetRate && act.Action != common.ActionDeposit {
 			return false
 		}
+		op := blockchain.ActivityOperator(act)
+		minedNonce, ok := minedNonces[op]
+		if !ok {
+			var err error
+			if minedNonce, err = f.blockchain.GetMinedNonceWithOP(op); err != nil {
+				f.l.Warnw("Getting mined nonce failed", "op", op, "err", err)
+			}
+			minedNonces[op] = minedNonce
+		}
 		return act.Result.Nonce < minedNonce
 	}
 }
@@ -375,7 +407,9 @@ func (f *Fetcher) FetchStatusFromBlockchain(pendings []common.ActivityRecord) (m
 	nonceValidator := f.newNonceValidator()
 
 	for _, activity := range pendings {
-		if activity.IsBlockchainPending() && (activity.Action == common.ActionSetRate || activity.Action == common.ActionDeposit || activity.Action == common.ActionWithdraw || activity.Action == common.ActionCancelSetRate) {
+		if activity.IsBlockchainPending() && (activity.Action == common.ActionSetRate || activity.Action == common.ActionDeposit || activity.Action == common.ActionWithdraw || activity.Action == common.ActionCancelSetRate ||
+			activity.Action == common.ActionReserveAdmin || activity.Action == common.ActionPricingContract ||
+			activity.Action == common.ActionOperatorTopUp) {
 			var (
 				blockNum uint64
 				status   string
@@ -572,6 +606,7 @@ func (f *Fetcher) PersistSnapshot(
 	pendingActivities := []common.ActivityRecord{}
 	for _, activity := range pendings {
 		activity := activity
+		previousExchangeStatus, previousMiningStatus := activity.ExchangeStatus, activity.MiningStatus
 		f.updateActivityWithExchangeStatus(&activity, estatuses, snapshot)
 		f.updateActivityWithBlockchainStatus(&activity, bstatuses, snapshot)
 		f.l.Debugf("Aggregate statuses, final activity: %+v", activity)
@@ -582,6 +617,12 @@ func (f *Fetcher) PersistSnapshot(
 		if err != nil {
 			snapshot.Valid = false
 			snapshot.Error = err.Error()
+		} else if activity.ExchangeStatus != previousExchangeStatus || activity.MiningStatus != previousMiningStatus {
+			f.eventBus.Publish(eventbus.ActivityStatusChanged, common.ActivityStatusChange{
+				Activity:               activity,
+				PreviousExchangeStatus: previousExchangeStatus,
+				PreviousMiningStatus:   previousMiningStatus,
+			})
 		}
 	}
 	// note: only update status when it's pending status
@@ -604,7 +645,11 @@ func (f *Fetcher) PersistSnapshot(
 	// persist blockchain balances
 	snapshot.ReserveBalances = bbalances
 	snapshot.PendingActivities = pendingActivities
-	return f.storage.StoreAuthSnapshot(snapshot, timepoint)
+	if err := f.storage.StoreAuthSnapshot(snapshot, timepoint); err != nil {
+		return err
+	}
+	f.eventBus.Publish(eventbus.AuthDataUpdated, eventbus.VersionData{Version: timepoint, Block: snapshot.Block, Valid: snapshot.Valid})
+	return nil
 }
 
 func (f *Fetcher) FetchAuthDataFromExchange(
@@ -626,13 +671,13 @@ func (f *Fetcher) FetchAuthDataFromExchange(
 		preStatuses := f.FetchStatusFromExchange(exchange, pendings, timepoint)
 		balances, err = exchange.FetchEBalanceData(timepoint)
 		if err != nil {
-			f.l.Warnw("Fetching exchange balances failed", "exchange", exchange.ID().String(), "err", err)
+			f.l.Warnw("Fetching exchange balances failed", "exchange", exchange.Name(), "err", err)
 			break
 		}
 		//Remove all token which is not in this exchange's token addresses
 		tokenAddress, err = exchange.TokenAddresses()
 		if err != nil {
-			f.l.Warnw("getting token address failed: %v", "exchange", exchange.ID().String(), "err", err)
+			f.l.Warnw("getting token address failed: %v", "exchange", exchange.Name(), "err", err)
 			break
 		}
 		for tokenID := range balances.AvailableBalance {
@@ -670,7 +715,7 @@ func (f *Fetcher) FetchAuthDataFromExchange(
 func (f *Fetcher) FetchStatusFromExchange(exchange Exchange, pendings []common.ActivityRecord, timepoint uint64) map[common.ActivityID]common.ActivityStatus {
 	result := map[common.ActivityID]common.ActivityStatus{}
 	for _, activity := range pendings {
-		if activity.Destination != exchange.ID().String() {
+		if activity.Destination != exchange.Name() {
 			continue
 		}
 		if activity.IsExchangePending() {
@@ -767,14 +812,16 @@ func (f *Fetcher) FetchOrderbook(timepoint uint64) {
 	err := f.storage.StorePrice(data.GetData(), timepoint)
 	if err != nil {
 		f.l.Warnw("Storing data failed", "err", err)
+		return
 	}
+	f.eventBus.Publish(eventbus.PriceUpdated, eventbus.VersionData{Version: timepoint, Block: f.currentBlock, Valid: true})
 }
 
 func (f *Fetcher) fetchPriceFromExchange(wg *sync.WaitGroup, exchange Exchange, data *ConcurrentAllPriceData, timepoint uint64) {
 	defer wg.Done()
 	exdata, err := exchange.FetchPriceData(timepoint)
 	if err != nil {
-		f.l.Warnw("Fetching data failed", "exchange", exchange.ID().String(), "err", err)
+		f.l.Warnw("Fetching data failed", "exchange", exchange.Name(), "err", err)
 		return
 	}
 	for pair, exchangeData := range exdata {
@@ -0,0 +1,137 @@
+package pricehistory
+
+import (
+	"sort"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+// OHLC is open, high, low and close of a value over a period, Count is the number of samples.
+type OHLC struct {
+	Open  float64 `json:"open"`
+	High  float64 `json:"high"`
+	Low   float64 `json:"low"`
+	Close float64 `json:"close"`
+	Count int     `json:"count"`
+}
+
+// Add adds a sample taken after all samples of o.
+func (o *OHLC) Add(v float64) {
+	o.Merge(OHLC{Open: v, High: v, Low: v, Close: v, Count: 1})
+}
+
+// Merge merges other, which covers the period right after o, into o.
+func (o *OHLC) Merge(other OHLC) {
+	if other.Count == 0 {
+		return
+	}
+	if o.Count == 0 {
+		*o = other
+		return
+	}
+	if other.High > o.High {
+		o.High = other.High
+	}
+	if other.Low < o.Low {
+		o.Low = other.Low
+	}
+	o.Close = other.Close
+	o.Count += other.Count
+}
+
+// Depth is the average rate to fill Quantity against bids and asks.
+type Depth struct {
+	Quantity float64 `json:"quantity"`
+	Bid      OHLC    `json:"bid"`
+	Ask      OHLC    `json:"ask"`
+}
+
+// Candle is the aggregate of order books of a trading pair in a period starting at Time.
+type Candle struct {
+	Time      uint64  `json:"time"`
+	Mid       OHLC    `json:"mid"`
+	BestBid   OHLC    `json:"best_bid"`
+	BestAsk   OHLC    `json:"best_ask"`
+	SpreadBps OHLC    `json:"spread_bps"`
+	Depth     []Depth `json:"depth,omitempty"`
+}
+
+// Merge merges other, which covers the period right after c, into c.
+func (c *Candle) Merge(other Candle) {
+	c.Mid.Merge(other.Mid)
+	c.BestBid.Merge(other.BestBid)
+	c.BestAsk.Merge(other.BestAsk)
+	c.SpreadBps.Merge(other.SpreadBps)
+	for _, od := range other.Depth {
+		found := false
+		for i := range c.Depth {
+			if c.Depth[i].Quantity == od.Quantity {
+				c.Depth[i].Bid.Merge(od.Bid)
+				c.Depth[i].Ask.Merge(od.Ask)
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.Depth = append(c.Depth, od)
+		}
+	}
+}
+
+// addOrderBook adds an order book sample to c, depth is sampled at quantities.
+func (c *Candle) addOrderBook(bids, asks []common.PriceEntry, quantities []float64) {
+	bestBid, bestAsk := bids[0].Rate, asks[0].Rate
+	mid := (bestBid + bestAsk) / 2
+	c.Mid.Add(mid)
+	c.BestBid.Add(bestBid)
+	c.BestAsk.Add(bestAsk)
+	if mid != 0 {
+		c.SpreadBps.Add((bestAsk - bestBid) / mid * 10000)
+	}
+	for _, q := range quantities {
+		var sample Depth
+		if rate, ok := fillRate(bids, q); ok {
+			sample.Bid.Add(rate)
+		}
+		if rate, ok := fillRate(asks, q); ok {
+			sample.Ask.Add(rate)
+		}
+		sample.Quantity = q
+		c.Merge(Candle{Depth: []Depth{sample}})
+	}
+}
+
+// fillRate returns average rate to fill quantity against levels, ok is false if levels are not deep enough.
+func fillRate(levels []common.PriceEntry, quantity float64) (float64, bool) {
+	var filled, cost float64
+	for _, level := range levels {
+		q := level.Quantity
+		if filled+q > quantity {
+			q = quantity - filled
+		}
+		filled += q
+		cost += q * level.Rate
+		if filled >= quantity {
+			return cost / quantity, quantity > 0
+		}
+	}
+	return 0, false
+}
+
+// orderBook returns order book of a trading pair in a price snapshot, the pair belongs to one exchange
+// but the lowest exchange id with a valid book is used if there are several.
+func orderBook(onePrice common.OnePrice) (bids, asks []common.PriceEntry, ok bool) {
+	ids := make([]rtypes.ExchangeID, 0, len(onePrice))
+	for id := range onePrice {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		ep := onePrice[id]
+		if ep.Valid && len(ep.Bids) != 0 && len(ep.Asks) != 0 {
+			return ep.Bids, ep.Asks, true
+		}
+	}
+	return nil, nil, false
+}
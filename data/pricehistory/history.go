@@ -0,0 +1,180 @@
+// Package pricehistory aggregates stored order books into candles of mid, best bid/ask, spread and depth.
+package pricehistory
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+const (
+	// initialBackfill is how far back prices are aggregated when there is no candle yet.
+	initialBackfill = 24 * time.Hour
+	// maxCandles is the max number of candles returned by a query.
+	maxCandles = 10000
+)
+
+// Resolutions are the periods of stored candles, each one is aggregated from the previous one
+// and the first one from stored prices.
+var Resolutions = []time.Duration{time.Minute, time.Hour, 24 * time.Hour}
+
+// ErrInvalidInterval is returned when query interval is not a multiple of a minute.
+var ErrInvalidInterval = errors.New("interval must be a positive multiple of 1m")
+
+// Storage stores candles and reads prices to aggregate.
+type Storage interface {
+	IteratePrices(from, to uint64, fn func(created time.Time, price common.AllPriceEntry) error) error
+	StorePriceCandles(resolution time.Duration, candles map[rtypes.TradingPairID][]Candle) error
+	GetPriceCandles(resolution time.Duration, from, to time.Time) (map[rtypes.TradingPairID][]Candle, error)
+	GetPairPriceCandles(pairID rtypes.TradingPairID, resolution time.Duration, from, to time.Time) ([]Candle, error)
+	// PriceCandleRange returns time of first and last candles of resolution, they are zero if there is none.
+	PriceCandleRange(resolution time.Duration) (first, last time.Time, err error)
+}
+
+// History aggregates candles and answers price history queries.
+type History struct {
+	s               Storage
+	depthQuantities map[rtypes.TradingPairID][]float64
+	l               *zap.SugaredLogger
+}
+
+// NewHistory creates a new History, depth of a trading pair is sampled at its quantities in depthQuantities.
+func NewHistory(s Storage, depthQuantities map[rtypes.TradingPairID][]float64) *History {
+	return &History{s: s, depthQuantities: depthQuantities, l: zap.S()}
+}
+
+// Run aggregates candles every interval, it never returns.
+func (h *History) Run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for t := range ticker.C {
+		if err := h.Aggregate(t); err != nil {
+			h.l.Errorw("failed to aggregate price history", "err", err)
+		}
+	}
+}
+
+// Aggregate stores candles of all periods ended before now which are not stored yet.
+func (h *History) Aggregate(now time.Time) error {
+	for i, resolution := range Resolutions {
+		end := now.UTC().Truncate(resolution)
+		_, last, err := h.s.PriceCandleRange(resolution)
+		if err != nil {
+			return err
+		}
+		start := last.Add(resolution)
+		if last.IsZero() {
+			if i == 0 {
+				start = end.Add(-initialBackfill)
+			} else {
+				lowerFirst, _, err := h.s.PriceCandleRange(Resolutions[i-1])
+				if err != nil {
+					return err
+				}
+				if lowerFirst.IsZero() {
+					continue
+				}
+				start = lowerFirst.Truncate(resolution)
+			}
+		}
+		// aggregate in chunks to bound memory
+		chunk := resolution * 60
+		for from := start; from.Before(end); from = from.Add(chunk) {
+			to := from.Add(chunk)
+			if to.After(end) {
+				to = end
+			}
+			var candles map[rtypes.TradingPairID][]Candle
+			if i == 0 {
+				candles, err = h.aggregatePrices(from, to, resolution)
+			} else {
+				candles, err = h.aggregateCandles(Resolutions[i-1], from, to, resolution)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to aggregate %s candles from %s: %w", resolution, from, err)
+			}
+			if err = h.s.StorePriceCandles(resolution, candles); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (h *History) aggregatePrices(from, to time.Time, resolution time.Duration) (map[rtypes.TradingPairID][]Candle, error) {
+	result := make(map[rtypes.TradingPairID][]Candle)
+	err := h.s.IteratePrices(common.TimeToMillis(from), common.TimeToMillis(to), func(created time.Time, price common.AllPriceEntry) error {
+		bucket := common.TimeToMillis(created.Truncate(resolution))
+		for pairID, onePrice := range price.Data {
+			bids, asks, ok := orderBook(onePrice)
+			if !ok {
+				continue
+			}
+			candles := result[pairID]
+			if len(candles) == 0 || candles[len(candles)-1].Time != bucket {
+				candles = append(candles, Candle{Time: bucket})
+			}
+			candles[len(candles)-1].addOrderBook(bids, asks, h.depthQuantities[pairID])
+			result[pairID] = candles
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (h *History) aggregateCandles(lower time.Duration, from, to time.Time, resolution time.Duration) (map[rtypes.TradingPairID][]Candle, error) {
+	lowerCandles, err := h.s.GetPriceCandles(lower, from, to)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[rtypes.TradingPairID][]Candle, len(lowerCandles))
+	for pairID, candles := range lowerCandles {
+		result[pairID] = mergeCandles(candles, resolution)
+	}
+	return result, nil
+}
+
+// mergeCandles merges candles sorted by time into candles of interval.
+func mergeCandles(candles []Candle, interval time.Duration) []Candle {
+	var result []Candle
+	for _, c := range candles {
+		bucket := common.TimeToMillis(common.MillisToTime(c.Time).Truncate(interval))
+		if len(result) == 0 || result[len(result)-1].Time != bucket {
+			result = append(result, Candle{Time: bucket})
+		}
+		result[len(result)-1].Merge(c)
+	}
+	return result
+}
+
+// Query returns candles of interval of a trading pair in [from, to), periods without prices are omitted.
+func (h *History) Query(pairID rtypes.TradingPairID, from, to time.Time, interval time.Duration) ([]Candle, error) {
+	if interval <= 0 || interval%time.Minute != 0 {
+		return nil, ErrInvalidInterval
+	}
+	from = from.UTC().Truncate(interval)
+	if !to.After(from) {
+		return nil, errors.New("to must be after from")
+	}
+	if to.Sub(from)/interval > maxCandles {
+		return nil, fmt.Errorf("too many candles, query at most %d intervals", maxCandles)
+	}
+	resolution := Resolutions[0]
+	for _, r := range Resolutions {
+		if interval%r == 0 {
+			resolution = r
+		}
+	}
+	candles, err := h.s.GetPairPriceCandles(pairID, resolution, from, to)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(candles, func(i, j int) bool { return candles[i].Time < candles[j].Time })
+	return mergeCandles(candles, interval), nil
+}
@@ -0,0 +1,173 @@
+package pricehistory
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+type memoryStorage struct {
+	prices  map[time.Time]common.AllPriceEntry
+	candles map[time.Duration]map[rtypes.TradingPairID]map[uint64]Candle
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{
+		prices:  make(map[time.Time]common.AllPriceEntry),
+		candles: make(map[time.Duration]map[rtypes.TradingPairID]map[uint64]Candle),
+	}
+}
+
+func (s *memoryStorage) IteratePrices(from, to uint64, fn func(created time.Time, price common.AllPriceEntry) error) error {
+	var times []time.Time
+	for t := range s.prices {
+		if ts := common.TimeToMillis(t); ts >= from && ts < to {
+			times = append(times, t)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	for _, t := range times {
+		if err := fn(t, s.prices[t]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memoryStorage) StorePriceCandles(resolution time.Duration, candles map[rtypes.TradingPairID][]Candle) error {
+	if s.candles[resolution] == nil {
+		s.candles[resolution] = make(map[rtypes.TradingPairID]map[uint64]Candle)
+	}
+	for pairID, pairCandles := range candles {
+		if s.candles[resolution][pairID] == nil {
+			s.candles[resolution][pairID] = make(map[uint64]Candle)
+		}
+		for _, c := range pairCandles {
+			s.candles[resolution][pairID][c.Time] = c
+		}
+	}
+	return nil
+}
+
+func (s *memoryStorage) GetPriceCandles(resolution time.Duration, from, to time.Time) (map[rtypes.TradingPairID][]Candle, error) {
+	result := make(map[rtypes.TradingPairID][]Candle)
+	for pairID := range s.candles[resolution] {
+		candles, _ := s.GetPairPriceCandles(pairID, resolution, from, to)
+		if len(candles) != 0 {
+			result[pairID] = candles
+		}
+	}
+	return result, nil
+}
+
+func (s *memoryStorage) GetPairPriceCandles(pairID rtypes.TradingPairID, resolution time.Duration, from, to time.Time) ([]Candle, error) {
+	var result []Candle
+	for ts, c := range s.candles[resolution][pairID] {
+		if ts >= common.TimeToMillis(from) && ts < common.TimeToMillis(to) {
+			result = append(result, c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Time < result[j].Time })
+	return result, nil
+}
+
+func (s *memoryStorage) PriceCandleRange(resolution time.Duration) (time.Time, time.Time, error) {
+	var first, last time.Time
+	for _, candles := range s.candles[resolution] {
+		for ts := range candles {
+			t := common.MillisToTime(ts).UTC()
+			if first.IsZero() || t.Before(first) {
+				first = t
+			}
+			if t.After(last) {
+				last = t
+			}
+		}
+	}
+	return first, last, nil
+}
+
+func priceEntry(bid, ask float64) common.AllPriceEntry {
+	return common.AllPriceEntry{
+		Data: map[rtypes.TradingPairID]common.OnePrice{
+			1: {
+				rtypes.Binance: {
+					Valid: true,
+					Bids:  []common.PriceEntry{{Rate: bid, Quantity: 1}, {Rate: bid - 1, Quantity: 1}},
+					Asks:  []common.PriceEntry{{Rate: ask, Quantity: 1}, {Rate: ask + 1, Quantity: 1}},
+				},
+			},
+			2: {
+				rtypes.Binance: {Valid: false},
+			},
+		},
+	}
+}
+
+func TestFillRate(t *testing.T) {
+	levels := []common.PriceEntry{{Rate: 10, Quantity: 1}, {Rate: 8, Quantity: 2}}
+	rate, ok := fillRate(levels, 2)
+	require.True(t, ok)
+	assert.Equal(t, 9.0, rate)
+	_, ok = fillRate(levels, 4)
+	assert.False(t, ok)
+}
+
+func TestHistory(t *testing.T) {
+	s := newMemoryStorage()
+	start := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	s.prices[start.Add(10*time.Second)] = priceEntry(100, 102)
+	s.prices[start.Add(20*time.Second)] = priceEntry(104, 106)
+	s.prices[start.Add(30*time.Second)] = priceEntry(98, 100)
+	s.prices[start.Add(90*time.Second)] = priceEntry(102, 104)
+	s.prices[start.Add(time.Hour+time.Second)] = priceEntry(110, 112)
+
+	h := NewHistory(s, map[rtypes.TradingPairID][]float64{1: {2}})
+	require.NoError(t, h.Aggregate(start.Add(2*time.Hour+30*time.Second)))
+
+	minutes, err := s.GetPairPriceCandles(1, time.Minute, start, start.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, minutes, 3)
+	first := minutes[0]
+	assert.Equal(t, common.TimeToMillis(start), first.Time)
+	assert.Equal(t, OHLC{Open: 101, High: 105, Low: 99, Close: 99, Count: 3}, first.Mid)
+	assert.Equal(t, OHLC{Open: 100, High: 104, Low: 98, Close: 98, Count: 3}, first.BestBid)
+	require.Len(t, first.Depth, 1)
+	assert.Equal(t, OHLC{Open: 99.5, High: 103.5, Low: 97.5, Close: 97.5, Count: 3}, first.Depth[0].Bid)
+	assert.Equal(t, OHLC{Open: 102.5, High: 106.5, Low: 100.5, Close: 100.5, Count: 3}, first.Depth[0].Ask)
+
+	hours, err := s.GetPairPriceCandles(1, time.Hour, start, start.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, hours, 2)
+	assert.Equal(t, OHLC{Open: 101, High: 105, Low: 99, Close: 103, Count: 4}, hours[0].Mid)
+
+	// days are not complete yet
+	_, last, err := s.PriceCandleRange(24 * time.Hour)
+	require.NoError(t, err)
+	assert.True(t, last.IsZero())
+
+	candles, err := h.Query(1, start, start.Add(2*time.Hour), 2*time.Hour)
+	require.NoError(t, err)
+	require.Len(t, candles, 1)
+	assert.Equal(t, OHLC{Open: 101, High: 111, Low: 99, Close: 111, Count: 5}, candles[0].Mid)
+
+	candles, err = h.Query(1, start, start.Add(time.Hour), 5*time.Minute)
+	require.NoError(t, err)
+	require.Len(t, candles, 1)
+	assert.Equal(t, 4, candles[0].Mid.Count)
+
+	_, err = h.Query(1, start, start.Add(time.Hour), 30*time.Second)
+	assert.Equal(t, ErrInvalidInterval, err)
+
+	// aggregating again does not duplicate samples
+	require.NoError(t, h.Aggregate(start.Add(2*time.Hour+30*time.Second)))
+	hours, err = s.GetPairPriceCandles(1, time.Hour, start, start.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 4, hours[0].Mid.Count)
+}
@@ -12,7 +12,9 @@ import (
 
 	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/common/archive"
+	"github.com/KyberNetwork/reserve-data/data/balancehistory"
 	"github.com/KyberNetwork/reserve-data/data/datapruner"
+	"github.com/KyberNetwork/reserve-data/data/pricehistory"
 	"github.com/KyberNetwork/reserve-data/lib/rtypes"
 	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
@@ -26,6 +28,8 @@ type ReserveData struct {
 	globalStorage     GlobalStorage
 	exchanges         []common.Exchange
 	settingStorage    storage.Interface
+	priceHistory      *pricehistory.History
+	reconciler        *balancehistory.Reconciler
 	l                 *zap.SugaredLogger
 }
 
@@ -118,6 +122,31 @@ func (rd ReserveData) GetOnePrice(pairID rtypes.TradingPairID, timepoint uint64)
 	return result, err
 }
 
+// GetPriceHistory returns candles of interval of a trading pair in [fromTime, toTime).
+func (rd ReserveData) GetPriceHistory(pairID rtypes.TradingPairID, fromTime, toTime uint64,
+	interval time.Duration) ([]pricehistory.Candle, error) {
+	if rd.priceHistory == nil {
+		return nil, errors.New("price history is not enabled")
+	}
+	return rd.priceHistory.Query(pairID, common.MillisToTime(fromTime), common.MillisToTime(toTime), interval)
+}
+
+// GetBalanceHistory returns balances of an asset, or all assets if assetID is 0, in [fromTime, toTime).
+func (rd ReserveData) GetBalanceHistory(assetID rtypes.AssetID, fromTime, toTime uint64) ([]balancehistory.Balance, error) {
+	if rd.reconciler == nil {
+		return nil, errors.New("balance history is not enabled")
+	}
+	return rd.reconciler.History(assetID, common.MillisToTime(fromTime), common.MillisToTime(toTime))
+}
+
+// GetBalanceReconciliations returns reconciliations of periods ending in [fromTime, toTime).
+func (rd ReserveData) GetBalanceReconciliations(fromTime, toTime uint64, flaggedOnly bool) ([]balancehistory.Reconciliation, error) {
+	if rd.reconciler == nil {
+		return nil, errors.New("balance history is not enabled")
+	}
+	return rd.reconciler.Reconciliations(common.MillisToTime(fromTime), common.MillisToTime(toTime), flaggedOnly)
+}
+
 // SetPreferGasSource ...
 func (rd ReserveData) SetPreferGasSource(v v3.PreferGasSource) error {
 	return rd.settingStorage.SetPreferGasSource(v)
@@ -167,16 +196,17 @@ func (rd ReserveData) GetAuthData(timepoint uint64) (common.AuthDataResponseV3,
 	result.PendingActivities.Withdraw = pendingWithdraw
 	result.PendingActivities.Deposit = pendingDeposit
 	result.PendingActivities.Trades = pendingTrades
+	result.OperatorBalances = data.OperatorBalances
 	// map of token
 	assets := make(map[rtypes.AssetID]v3.Asset)
-	exchanges := make(map[string]v3.Exchange)
-	// get id from exchange balance asset name
+	exchanges := make(map[rtypes.ExchangeID]v3.Exchange)
+	// get name of exchange accounts with balances
 	for exchangeID, balances := range data.ExchangeBalances {
-		exchange, err := rd.settingStorage.GetExchangeByName(exchangeID.String())
+		exchange, err := rd.settingStorage.GetExchange(exchangeID)
 		if err != nil {
-			return result, errors.Wrapf(err, "failed to get exchange by name: %s", exchangeID.String())
+			return result, errors.Wrapf(err, "failed to get exchange %d", exchangeID)
 		}
-		exchanges[exchangeID.String()] = exchange
+		exchanges[exchangeID] = exchange
 		for assetID := range balances.AvailableBalance {
 			//* cos symbol of token in an exchange can be different then we need to use GetAssetExchangeBySymbol
 			token, err := rd.settingStorage.GetAsset(assetID)
@@ -217,14 +247,13 @@ func (rd ReserveData) GetAuthData(timepoint uint64) (common.AuthDataResponseV3,
 			}
 
 			exchangeBalance := common.ExchangeBalance{
-				ExchangeID: exchanges[exchangeID.String()].ID,
-				Name:       exchangeID.String(),
+				ExchangeID: exchangeID,
+				Name:       exchanges[exchangeID].Name,
 			}
 			if balances.Error != "" {
 				exchangeBalance.Error = balances.Error
 				tokenBalance.Valid = false
 			}
-			exchangeBalance.ExchangeID = exchanges[exchangeID.String()].ID
 			exchangeBalance.Available = balances.AvailableBalance[token.ID]
 			exchangeBalance.Locked = balances.LockedBalance[token.ID]
 			exchangeBalances = append(exchangeBalances, exchangeBalance)
@@ -383,6 +412,12 @@ func (rd ReserveData) GetPendingActivities() ([]common.ActivityRecord, error) {
 
 // Run run fetcher
 func (rd ReserveData) Run() error {
+	if rd.priceHistory != nil {
+		go rd.priceHistory.Run(time.Minute)
+	}
+	if rd.reconciler != nil {
+		go rd.reconciler.Run(time.Minute)
+	}
 	return rd.fetcher.Run()
 }
 
@@ -391,9 +426,9 @@ func (rd ReserveData) Stop() error {
 	return rd.fetcher.Stop()
 }
 
-// ControlAuthDataSize pack old data to file, push to S3 and prune outdated data
-func (rd ReserveData) ControlAuthDataSize() error {
-	tmpDir, err := ioutil.TempDir("", "ExpiredAuthData")
+// ControlDataSize pack data older than its retention to file, push to all archives and prune outdated data
+func (rd ReserveData) ControlDataSize() error {
+	tmpDir, err := ioutil.TempDir("", "ExpiredData")
 	if err != nil {
 		return err
 	}
@@ -405,55 +440,81 @@ func (rd ReserveData) ControlAuthDataSize() error {
 	}()
 
 	for {
-		rd.l.Debug("DataPruner: waiting for signal from runner AuthData controller channel")
+		rd.l.Debug("DataPruner: waiting for signal from runner data controller channel")
 		t := <-rd.storageController.Runner.GetAuthBucketTicker()
-		timepoint := common.TimeToMillis(t)
-		rd.l.Infow("DataPruner: got signal in AuthData controller channel", "timestamp", common.TimeToMillis(t))
-		fileName := filepath.Join(tmpDir, fmt.Sprintf("ExpiredAuthData_at_%s", time.Unix(int64(timepoint/1000), 0).UTC()))
-		nRecord, err := rd.storage.ExportExpiredAuthData(common.TimeToMillis(t), fileName)
-		if err != nil {
-			rd.l.Errorw("ERROR: DataPruner export AuthData operation failed", "err", err, "file", fileName)
-		} else {
-			var integrity bool
-			if nRecord > 0 {
-				err = rd.storageController.Arch.UploadFile(rd.storageController.Arch.GetReserveDataBucketName(), rd.storageController.ExpiredAuthDataPath, fileName)
-				if err != nil {
-					rd.l.Errorw("DataPruner: Upload file failed", "err", err, "file", fileName)
-				} else {
-					integrity, err = rd.storageController.Arch.CheckFileIntergrity(rd.storageController.Arch.GetReserveDataBucketName(), rd.storageController.ExpiredAuthDataPath, fileName)
-					if err != nil {
-						rd.l.Errorw("ERROR: DataPruner: error in file integrity check", "err", err)
-					} else if !integrity {
-						rd.l.Errorw("ERROR: DataPruner: file upload corrupted")
-
-					}
-					if err != nil || !integrity {
-						// if the intergrity check failed, remove the remote file.
-						removalErr := rd.storageController.Arch.RemoveFile(rd.storageController.Arch.GetReserveDataBucketName(), rd.storageController.ExpiredAuthDataPath, fileName)
-						if removalErr != nil {
-							rd.l.Warnw("ERROR: DataPruner: cannot remove remote file", "err", removalErr, "file", fileName)
-							return err
-						}
-					}
-				}
+		rd.l.Infow("DataPruner: got signal in data controller channel", "timestamp", common.TimeToMillis(t))
+		for _, dataType := range common.AllPrunableData() {
+			retention := time.Duration(rd.storageController.Retention[dataType])
+			if retention <= 0 {
+				continue
 			}
-			if integrity && err == nil {
-				nPrunedRecords, err := rd.storage.PruneExpiredAuthData(common.TimeToMillis(t))
-				switch {
-				case err != nil:
-					rd.l.Errorw("DataPruner: Can not prune Auth Data", "err", err)
-					return err
-				case nPrunedRecords != nRecord:
-					rd.l.Infof("DataPruner: Number of Exported Data is %d, which is different from number of pruned data %d", nRecord, nPrunedRecords)
-				default:
-					rd.l.Infof("DataPruner: exported and pruned %d expired records from AuthData", nRecord)
-				}
+			if err := rd.archiveExpiredData(dataType, t.Add(-retention), tmpDir); err != nil {
+				return err
 			}
 		}
-		if err := os.Remove(fileName); err != nil {
-			return err
+	}
+}
+
+// archiveExpiredData exports data of dataType created before expiredBefore, data is only pruned
+// if it is uploaded to all archives.
+func (rd ReserveData) archiveExpiredData(dataType common.PrunableData, expiredBefore time.Time, tmpDir string) error {
+	timepoint := common.TimeToMillis(expiredBefore)
+	folder := datapruner.ExpiredDataPath(dataType)
+	fileName := filepath.Join(tmpDir, fmt.Sprintf("Expired_%s_before_%s", dataType, expiredBefore.UTC().Format(time.RFC3339)))
+	defer func() {
+		if err := os.Remove(fileName); err != nil && !os.IsNotExist(err) {
+			rd.l.Errorw("DataPruner: cannot remove local file", "err", err, "file", fileName)
+		}
+	}()
+
+	ids, err := rd.storage.ExportExpiredData(dataType, timepoint, fileName)
+	if err != nil {
+		rd.l.Errorw("ERROR: DataPruner export operation failed", "data_type", dataType, "err", err, "file", fileName)
+		return nil
+	}
+	nRecord := uint64(len(ids))
+	if nRecord == 0 {
+		return nil
+	}
+	if len(rd.storageController.Archives) == 0 {
+		rd.l.Warnw("DataPruner: no archive configured, expired data is kept", "data_type", dataType)
+		return nil
+	}
+	for _, arch := range rd.storageController.Archives {
+		bucket := arch.GetReserveDataBucketName()
+		err = arch.UploadFile(bucket, folder, fileName)
+		if err != nil {
+			rd.l.Errorw("DataPruner: Upload file failed", "err", err, "bucket", bucket, "file", fileName)
+			return nil
+		}
+		integrity, err := arch.CheckFileIntergrity(bucket, folder, fileName)
+		if err != nil {
+			rd.l.Errorw("ERROR: DataPruner: error in file integrity check", "err", err, "bucket", bucket)
+		} else if !integrity {
+			rd.l.Errorw("ERROR: DataPruner: file upload corrupted", "bucket", bucket)
+		}
+		if err != nil || !integrity {
+			// if the intergrity check failed, remove the remote file.
+			if removalErr := arch.RemoveFile(bucket, folder, fileName); removalErr != nil {
+				rd.l.Warnw("ERROR: DataPruner: cannot remove remote file", "err", removalErr, "file", fileName)
+				return removalErr
+			}
+			return nil
 		}
 	}
+
+	// only exported records are pruned, activities completed after the export are archived next time
+	nPrunedRecords, err := rd.storage.PruneExportedData(dataType, ids)
+	switch {
+	case err != nil:
+		rd.l.Errorw("DataPruner: Can not prune data", "data_type", dataType, "err", err)
+		return err
+	case nPrunedRecords != nRecord:
+		rd.l.Infof("DataPruner: Number of Exported %s is %d, which is different from number of pruned data %d", dataType, nRecord, nPrunedRecords)
+	default:
+		rd.l.Infof("DataPruner: exported and pruned %d expired records of %s", nRecord, dataType)
+	}
+	return nil
 }
 
 // GetTradeHistory return trade history
@@ -477,8 +538,8 @@ func (rd ReserveData) RunStorageController() error {
 		rd.l.Fatalw("Storage controller runner error", "err", err)
 	}
 	go func() {
-		if err := rd.ControlAuthDataSize(); err != nil {
-			rd.l.Errorw("Control auth data size failed", "err", err)
+		if err := rd.ControlDataSize(); err != nil {
+			rd.l.Errorw("Control data size failed", "err", err)
 		}
 	}()
 	return nil
@@ -487,10 +548,12 @@ func (rd ReserveData) RunStorageController() error {
 // NewReserveData initiate a new reserve instance
 func NewReserveData(storage Storage,
 	fetcher Fetcher, storageControllerRunner datapruner.StorageControllerRunner,
-	arch archive.Archive, globalStorage GlobalStorage,
+	archives []archive.Archive, retention common.RetentionConfig, globalStorage GlobalStorage,
 	exchanges []common.Exchange,
-	settingStorage storage.Interface) *ReserveData {
-	storageController, err := datapruner.NewStorageController(storageControllerRunner, arch)
+	settingStorage storage.Interface,
+	priceHistory *pricehistory.History,
+	reconciler *balancehistory.Reconciler) *ReserveData {
+	storageController, err := datapruner.NewStorageController(storageControllerRunner, archives, retention)
 	if err != nil {
 		panic(err)
 	}
@@ -501,6 +564,8 @@ func NewReserveData(storage Storage,
 		globalStorage:     globalStorage,
 		exchanges:         exchanges,
 		settingStorage:    settingStorage,
+		priceHistory:      priceHistory,
+		reconciler:        reconciler,
 		l:                 zap.S(),
 	}
 }
@@ -13,11 +13,11 @@ type Storage interface {
 
 	CurrentAuthDataVersion(timepoint uint64) (common.Version, error)
 	GetAuthData(common.Version) (common.AuthDataSnapshot, error)
-	//ExportExpiredAuthData: Write all expired records into a predetermined filepath
+	//ExportExpiredData: Write all records of dataType created before expiredBefore into a predetermined filepath
 	//each record will be represented in JSON format, and seperates by endline character
-	//Return: Number of records exported (uint64) and error
-	ExportExpiredAuthData(timepoint uint64, filePath string) (uint64, error)
-	PruneExpiredAuthData(timepoint uint64) (uint64, error)
+	//Return: IDs of records exported and error
+	ExportExpiredData(dataType common.PrunableData, expiredBefore uint64, filePath string) ([]uint64, error)
+	PruneExportedData(dataType common.PrunableData, ids []uint64) (uint64, error)
 	CurrentRateVersion(timepoint uint64) (common.Version, error)
 	GetRate(common.Version) (common.AllRateEntry, error)
 	GetRates(fromTime, toTime uint64) ([]common.AllRateEntry, error)
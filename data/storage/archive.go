@@ -0,0 +1,211 @@
+package storage
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/DataDog/zstd"
+	"github.com/lib/pq"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	pgutil "github.com/KyberNetwork/reserve-data/common/postgres"
+)
+
+// archivedRecord is a row of fetch_data or activity table, archive files hold one
+// record per line so they can be restored into database.
+type archivedRecord struct {
+	DataType  common.PrunableData `json:"data_type"`
+	Created   time.Time           `json:"created"`
+	Data      []byte              `json:"data"`
+	IsPending bool                `json:"is_pending,omitempty"`
+	Timepoint uint64              `json:"timepoint,omitempty"`
+	EID       string              `json:"eid,omitempty"`
+}
+
+// expiredDataQuery returns query and its arguments to select data created before expiredBefore.
+// Pending activities are never expired, delta encoded fetch data is expired before the last keyframe
+// created before expiredBefore so remaining deltas still have their keyframe.
+func expiredDataQuery(selectColumns string, dataType common.PrunableData, expiredBefore time.Time) (string, []interface{}, error) {
+	if dataType == common.PrunableActivity {
+		return fmt.Sprintf(`%s FROM "%s" WHERE is_pending IS FALSE AND created < $1`, selectColumns, activityTable),
+			[]interface{}{expiredBefore}, nil
+	}
+	fetchType, err := fetchDataTypeString(string(dataType))
+	if err != nil {
+		return "", nil, common.ValidPrunableData(dataType)
+	}
+	if deltaEncodedTypes[fetchType] {
+		return fmt.Sprintf(`%[1]s FROM "%[2]s" WHERE type = $1 AND created < $2 AND created < (
+	SELECT COALESCE(MAX(created), $2) FROM "%[2]s" WHERE type = $1 AND created <= $2 AND base IS NULL)`,
+			selectColumns, fetchDataTable), []interface{}{fetchType, expiredBefore}, nil
+	}
+	return fmt.Sprintf(`%s FROM "%s" WHERE type = $1 AND created < $2`, selectColumns, fetchDataTable),
+		[]interface{}{fetchType, expiredBefore}, nil
+}
+
+// ExportExpiredData writes all records of dataType created before expiredBefore into filePath,
+// delta encoded fetch data is written as full snapshots. It returns IDs of the records exported,
+// they are the ones to prune as records may expire after the export.
+func (ps *PostgresStorage) ExportExpiredData(dataType common.PrunableData, expiredBefore uint64, filePath string) ([]uint64, error) {
+	selectColumns := "SELECT id, created, data, FALSE AS is_pending, 0 AS timepoint, '' AS eid, base"
+	if dataType == common.PrunableActivity {
+		selectColumns = "SELECT id, created, data, is_pending, timepoint, eid, NULL::TIMESTAMPTZ AS base"
+	}
+	query, args, err := expiredDataQuery(selectColumns, dataType, common.MillisToTime(expiredBefore))
+	if err != nil {
+		return nil, err
+	}
+	outFile, err := os.Create(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cErr := outFile.Close(); cErr != nil {
+			ps.l.Errorf("Close file error: %s", cErr.Error())
+		}
+	}()
+
+	rows, err := ps.db.Queryx(query+" ORDER BY created", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cle := rows.Close(); cle != nil {
+			ps.l.Errorf("close result error %v", cle)
+		}
+	}()
+
+	var (
+		ids     []uint64
+		writer  = bufio.NewWriter(outFile)
+		encoder = json.NewEncoder(writer)
+		reader  *snapshotReader
+	)
+	if fetchType, fErr := fetchDataTypeString(string(dataType)); fErr == nil && deltaEncodedTypes[fetchType] {
+		reader = &snapshotReader{ps: ps, dataType: fetchType}
+	}
+	for rows.Next() {
+		var (
+			id     uint64
+			record = archivedRecord{DataType: dataType}
+			base   *time.Time
+		)
+		if err = rows.Scan(&id, &record.Created, &record.Data, &record.IsPending, &record.Timepoint, &record.EID, &base); err != nil {
+			return nil, err
+		}
+		if reader != nil {
+			data, err := reader.read(record.Created, record.Data, base)
+			if err != nil {
+				return nil, err
+			}
+			if base != nil {
+				if record.Data, err = zstd.CompressLevel(nil, data, 9); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err = encoder.Encode(record); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, writer.Flush()
+}
+
+// PruneExportedData removes records of dataType with given IDs returned by ExportExpiredData,
+// it returns number of records removed.
+func (ps *PostgresStorage) PruneExportedData(dataType common.PrunableData, ids []uint64) (uint64, error) {
+	var (
+		query string
+		args  []interface{}
+	)
+	if dataType == common.PrunableActivity {
+		query = fmt.Sprintf(`DELETE FROM "%s" WHERE id = ANY($1)`, activityTable)
+		args = []interface{}{pq.Array(ids)}
+	} else {
+		fetchType, err := fetchDataTypeString(string(dataType))
+		if err != nil {
+			return 0, common.ValidPrunableData(dataType)
+		}
+		query = fmt.Sprintf(`DELETE FROM "%s" WHERE type = $1 AND id = ANY($2)`, fetchDataTable)
+		args = []interface{}{fetchType, pq.Array(ids)}
+	}
+	var count uint64
+	query = fmt.Sprintf(`WITH deleted AS (%s RETURNING 1) SELECT count(*) FROM deleted`, query)
+	if err := ps.db.Get(&count, query, args...); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// RestoreData imports an archive file written by ExportExpiredData back into database,
+// records which are still in database are skipped. It returns number of records imported.
+func (ps *PostgresStorage) RestoreData(filePath string) (uint64, error) {
+	const (
+		restoreFetchDataQuery = `INSERT INTO "fetch_data" (created, data, type)
+SELECT $1, $2, $3 WHERE NOT EXISTS (SELECT 1 FROM "fetch_data" WHERE created = $1 AND type = $3)`
+		restoreActivityQuery = `INSERT INTO "activity" (created, data, is_pending, timepoint, eid)
+SELECT $1, $2, $3, $4, $5 WHERE NOT EXISTS (SELECT 1 FROM "activity" WHERE timepoint = $4 AND eid = $5)`
+	)
+	inFile, err := os.Open(filePath)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if cErr := inFile.Close(); cErr != nil {
+			ps.l.Errorf("Close file error: %s", cErr.Error())
+		}
+	}()
+
+	tx, err := ps.db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer pgutil.RollbackUnlessCommitted(tx)
+
+	var (
+		count, line uint64
+		decoder     = json.NewDecoder(bufio.NewReader(inFile))
+	)
+	for {
+		line++
+		var record archivedRecord
+		err = decoder.Decode(&record)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("invalid archive record %d: %w", line, err)
+		}
+		var query string
+		var args []interface{}
+		if record.DataType == common.PrunableActivity {
+			query = restoreActivityQuery
+			args = []interface{}{record.Created, record.Data, record.IsPending, record.Timepoint, record.EID}
+		} else {
+			fetchType, fErr := fetchDataTypeString(string(record.DataType))
+			if fErr != nil {
+				return 0, fmt.Errorf("invalid archive record %d: %w", line, fErr)
+			}
+			query = restoreFetchDataQuery
+			args = []interface{}{record.Created, record.Data, fetchType}
+		}
+		res, err := tx.Exec(query, args...)
+		if err != nil {
+			return 0, err
+		}
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		count += uint64(inserted)
+	}
+	return count, tx.Commit()
+}
@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+func TestExportPruneRestoreData(t *testing.T) {
+	db, teardown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		require.NoError(t, teardown())
+	}()
+
+	ps, err := NewPostgresStorage(db)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "archive")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	const (
+		oldTimepoint    = uint64(1568358532784)
+		expiredBefore   = uint64(1568358532785)
+		recentTimepoint = uint64(1568358532786)
+	)
+	require.NoError(t, ps.StoreRate(common.AllRateEntry{BlockNumber: 1}, oldTimepoint))
+	require.NoError(t, ps.StoreRate(common.AllRateEntry{BlockNumber: 2}, recentTimepoint))
+	require.NoError(t, ps.StorePrice(common.AllPriceEntry{Block: 1}, oldTimepoint))
+	require.NoError(t, ps.Record("deposit", common.NewActivityID(oldTimepoint, "done"), "binance",
+		common.ActivityParams{}, common.ActivityResult{}, common.ExchangeStatusDone, common.MiningStatusMined, oldTimepoint, false))
+	require.NoError(t, ps.Record("deposit", common.NewActivityID(oldTimepoint, "pending"), "binance",
+		common.ActivityParams{}, common.ActivityResult{}, "", common.MiningStatusPending, oldTimepoint, true))
+
+	rateFile := filepath.Join(dir, "rate")
+	exported, err := ps.ExportExpiredData(common.PrunableRate, expiredBefore, rateFile)
+	require.NoError(t, err)
+	require.Len(t, exported, 1)
+	pruned, err := ps.PruneExportedData(common.PrunableRate, exported)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pruned)
+
+	// pending activities are kept
+	activityFile := filepath.Join(dir, "activity")
+	exported, err = ps.ExportExpiredData(common.PrunableActivity, expiredBefore, activityFile)
+	require.NoError(t, err)
+	require.Len(t, exported, 1)
+	// an activity completed after the export is not pruned as it is not archived
+	pendingID := common.NewActivityID(oldTimepoint, "pending")
+	require.NoError(t, ps.UpdateActivity(pendingID, common.NewActivityRecord("deposit", pendingID, "binance",
+		common.ActivityParams{}, common.ActivityResult{}, common.ExchangeStatusDone, common.MiningStatusMined,
+		common.Timestamp("1568358532784"))))
+	pruned, err = ps.PruneExportedData(common.PrunableActivity, exported)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pruned)
+	_, err = ps.GetActivity(rtypes.Binance.String(), "pending")
+	require.NoError(t, err)
+
+	_, err = ps.CurrentRateVersion(oldTimepoint)
+	require.Error(t, err)
+
+	restored, err := ps.RestoreData(rateFile)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), restored)
+	v, err := ps.CurrentRateVersion(oldTimepoint)
+	require.NoError(t, err)
+	rate, err := ps.GetRate(v)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), rate.BlockNumber)
+
+	restored, err = ps.RestoreData(activityFile)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), restored)
+	_, err = ps.GetActivity(rtypes.Binance.String(), "done")
+	require.NoError(t, err)
+
+	// restoring again does not duplicate records
+	restored, err = ps.RestoreData(rateFile)
+	require.NoError(t, err)
+	require.Zero(t, restored)
+}
@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	pgutil "github.com/KyberNetwork/reserve-data/common/postgres"
+	"github.com/KyberNetwork/reserve-data/data/balancehistory"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+type balanceDB struct {
+	Time       time.Time         `db:"time"`
+	AssetID    rtypes.AssetID    `db:"asset_id"`
+	ExchangeID rtypes.ExchangeID `db:"exchange_id"`
+	Balance    float64           `db:"balance"`
+}
+
+// StoreBalanceHistory stores balances and reconciliations at t, existing records at t are replaced.
+func (ps *PostgresStorage) StoreBalanceHistory(t time.Time, balances []balancehistory.Balance,
+	reconciliations []balancehistory.Reconciliation) error {
+	const (
+		balanceQuery = `INSERT INTO "balance_history" (time, asset_id, exchange_id, balance) VALUES ($1, $2, $3, $4)
+ON CONFLICT (time, asset_id, exchange_id) DO UPDATE SET balance = EXCLUDED.balance`
+		reconciliationQuery = `INSERT INTO "balance_reconciliation" (time, asset_id, flagged, data) VALUES ($1, $2, $3, $4)
+ON CONFLICT (time, asset_id) DO UPDATE SET flagged = EXCLUDED.flagged, data = EXCLUDED.data`
+	)
+	tx, err := ps.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer pgutil.RollbackUnlessCommitted(tx)
+	for _, b := range balances {
+		if _, err = tx.Exec(balanceQuery, t, b.AssetID, b.ExchangeID, b.Balance); err != nil {
+			return err
+		}
+	}
+	for _, r := range reconciliations {
+		data, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Exec(reconciliationQuery, t, r.AssetID, r.Flagged, data); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// LastBalanceHistoryTime returns time of last stored balances, it is zero if there is none.
+func (ps *PostgresStorage) LastBalanceHistoryTime() (time.Time, error) {
+	var last *time.Time
+	if err := ps.db.Get(&last, `SELECT MAX(time) FROM "balance_history"`); err != nil {
+		return time.Time{}, err
+	}
+	if last == nil {
+		return time.Time{}, nil
+	}
+	return last.UTC(), nil
+}
+
+// GetBalanceHistory returns balances in [from, to) ordered by time, of all assets if assetID is 0.
+func (ps *PostgresStorage) GetBalanceHistory(assetID rtypes.AssetID, from, to time.Time) ([]balancehistory.Balance, error) {
+	const query = `SELECT time, asset_id, exchange_id, balance FROM "balance_history"
+WHERE time >= $1 AND time < $2 AND ($3 = 0 OR asset_id = $3) ORDER BY time, asset_id, exchange_id`
+	var records []balanceDB
+	if err := ps.db.Select(&records, query, from, to, assetID); err != nil {
+		return nil, err
+	}
+	result := make([]balancehistory.Balance, 0, len(records))
+	for _, r := range records {
+		result = append(result, balancehistory.Balance{
+			Time:       common.TimeToMillis(r.Time),
+			AssetID:    r.AssetID,
+			ExchangeID: r.ExchangeID,
+			Balance:    r.Balance,
+		})
+	}
+	return result, nil
+}
+
+// GetReconciliations returns reconciliations of periods ending in [from, to) ordered by time.
+func (ps *PostgresStorage) GetReconciliations(from, to time.Time, flaggedOnly bool) ([]balancehistory.Reconciliation, error) {
+	const query = `SELECT data FROM "balance_reconciliation"
+WHERE time >= $1 AND time < $2 AND (flagged OR NOT $3) ORDER BY time, asset_id`
+	var records [][]byte
+	if err := ps.db.Select(&records, query, from, to, flaggedOnly); err != nil {
+		return nil, err
+	}
+	result := make([]balancehistory.Reconciliation, 0, len(records))
+	for _, data := range records {
+		var r balancehistory.Reconciliation
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
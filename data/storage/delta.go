@@ -0,0 +1,235 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/DataDog/zstd"
+)
+
+// keyframeInterval is the max number of rows of a delta chain, the first row of a chain is a keyframe
+// holding a full snapshot, each following row holds a JSON merge patch (RFC 7386) from its previous row.
+const keyframeInterval = 30
+
+// deltaEncodedTypes are fetch data types stored as delta chains.
+var deltaEncodedTypes = map[fetchDataType]bool{
+	priceDataType: true,
+	authDataType:  true,
+}
+
+// deltaChain is the state of the last stored row of a data type.
+type deltaChain struct {
+	base     time.Time // created of keyframe
+	length   int
+	created  time.Time
+	doc      interface{}
+	dataJSON []byte
+}
+
+// decodeJSON decodes data into generic values, numbers are kept as json.Number so big integers
+// are not rounded.
+func decodeJSON(data []byte) (interface{}, error) {
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// diffJSON returns the merge patch turning from into to, changed is false if they are equal.
+func diffJSON(from, to interface{}) (patch interface{}, changed bool) {
+	fromObj, fromOK := from.(map[string]interface{})
+	toObj, toOK := to.(map[string]interface{})
+	if !fromOK || !toOK {
+		if reflect.DeepEqual(from, to) {
+			return nil, false
+		}
+		return to, true
+	}
+	patchObj := make(map[string]interface{})
+	for k := range fromObj {
+		if _, ok := toObj[k]; !ok {
+			patchObj[k] = nil
+		}
+	}
+	for k, v := range toObj {
+		fv, ok := fromObj[k]
+		if !ok {
+			patchObj[k] = v
+			continue
+		}
+		if sub, subChanged := diffJSON(fv, v); subChanged {
+			patchObj[k] = sub
+		}
+	}
+	return patchObj, len(patchObj) != 0
+}
+
+// applyPatch applies a merge patch to doc, doc is modified in place when possible.
+func applyPatch(doc, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	docObj, ok := doc.(map[string]interface{})
+	if !ok {
+		docObj = make(map[string]interface{})
+	}
+	for k, v := range patchObj {
+		if v == nil {
+			delete(docObj, k)
+			continue
+		}
+		docObj[k] = applyPatch(docObj[k], v)
+	}
+	return docObj
+}
+
+// encodeFetchData returns the data to store for a new row of dataType and its keyframe, base is nil
+// if the row is a keyframe.
+func (ps *PostgresStorage) encodeFetchData(dataType fetchDataType, created time.Time, dataJSON []byte) ([]byte, *time.Time, error) {
+	if !deltaEncodedTypes[dataType] {
+		return dataJSON, nil, nil
+	}
+	doc, err := decodeJSON(dataJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	chain := ps.chains[dataType]
+	next := &deltaChain{base: created, length: 1, created: created, doc: doc, dataJSON: dataJSON}
+	ps.chains[dataType] = next
+	if chain == nil || chain.length >= keyframeInterval || !created.After(chain.created) {
+		return dataJSON, nil, nil
+	}
+	patch, _ := diffJSON(chain.doc, doc)
+	if patch == nil {
+		patch = map[string]interface{}{}
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(patchJSON) > len(dataJSON)/2 {
+		return dataJSON, nil, nil // snapshot changed too much to be worth a delta
+	}
+	next.base, next.length = chain.base, chain.length+1
+	return patchJSON, &next.base, nil
+}
+
+// resetChain makes next row of dataType a keyframe, it is called when storing a row fails.
+func (ps *PostgresStorage) resetChain(dataType fetchDataType) {
+	ps.mu.Lock()
+	delete(ps.chains, dataType)
+	ps.mu.Unlock()
+}
+
+// lastStored returns JSON data of last row of dataType stored by this instance if it was created at created.
+func (ps *PostgresStorage) lastStored(dataType fetchDataType, created time.Time) ([]byte, bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	chain := ps.chains[dataType]
+	if chain == nil || !chain.created.Equal(created) {
+		return nil, false
+	}
+	return chain.dataJSON, true
+}
+
+// rebuildSnapshot returns the snapshot of dataType created at upTo whose keyframe was created at base.
+func (ps *PostgresStorage) rebuildSnapshot(dataType fetchDataType, base, upTo time.Time) (interface{}, error) {
+	query := fmt.Sprintf(`SELECT created, data, base FROM "%s"
+WHERE type = $1 AND created >= $2 AND created <= $3 AND (created = $2 OR base = $2) ORDER BY created`, fetchDataTable)
+	rows, err := ps.db.Query(query, dataType, base, upTo)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cle := rows.Close(); cle != nil {
+			ps.l.Errorf("close result error %v", cle)
+		}
+	}()
+	var (
+		doc          interface{}
+		decompressed []byte
+		found        bool
+	)
+	for rows.Next() {
+		var (
+			created    time.Time
+			compressed []byte
+			rowBase    *time.Time
+		)
+		if err = rows.Scan(&created, &compressed, &rowBase); err != nil {
+			return nil, err
+		}
+		if !found && (rowBase != nil || !created.Equal(base)) {
+			break
+		}
+		if decompressed, err = zstd.Decompress(decompressed, compressed); err != nil {
+			return nil, err
+		}
+		value, err := decodeJSON(decompressed)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			doc, found = value, true
+			continue
+		}
+		doc = applyPatch(doc, value)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("keyframe of %s data created at %s is missing", dataType, base)
+	}
+	return doc, nil
+}
+
+// snapshotReader rebuilds full snapshots from rows of a data type read in order of creation.
+type snapshotReader struct {
+	ps       *PostgresStorage
+	dataType fetchDataType
+	base     *time.Time
+	doc      interface{}
+	buf      []byte
+}
+
+// read returns JSON data of the full snapshot of a row.
+func (r *snapshotReader) read(created time.Time, compressed []byte, base *time.Time) ([]byte, error) {
+	var err error
+	if r.buf, err = zstd.Decompress(r.buf, compressed); err != nil {
+		return nil, fmt.Errorf("failed to decompress %s data created at %s: %w", r.dataType, created, err)
+	}
+	if !deltaEncodedTypes[r.dataType] {
+		return r.buf, nil
+	}
+	if base == nil {
+		if r.doc, err = decodeJSON(r.buf); err != nil {
+			return nil, err
+		}
+		r.base = &created
+		return r.buf, nil
+	}
+	if r.base == nil || !r.base.Equal(*base) {
+		// starting in the middle of a chain
+		if r.doc, err = r.ps.rebuildSnapshot(r.dataType, *base, created); err != nil {
+			return nil, err
+		}
+		r.base = base
+	} else {
+		patch, err := decodeJSON(r.buf)
+		if err != nil {
+			return nil, err
+		}
+		r.doc = applyPatch(r.doc, patch)
+	}
+	return json.Marshal(r.doc)
+}
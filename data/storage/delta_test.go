@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+func TestDiffApplyJSON(t *testing.T) {
+	from, err := decodeJSON([]byte(`{"a":1,"b":{"c":[1,2],"d":"x"},"e":123456789012345678901234567890}`))
+	require.NoError(t, err)
+	to, err := decodeJSON([]byte(`{"a":1,"b":{"c":[1,3]},"e":123456789012345678901234567891,"f":null}`))
+	require.NoError(t, err)
+
+	patch, changed := diffJSON(from, to)
+	require.True(t, changed)
+	patchJSON, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"b":{"c":[1,3],"d":null},"e":123456789012345678901234567891,"f":null}`, string(patchJSON))
+
+	patched, err := json.Marshal(applyPatch(from, patch))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a":1,"b":{"c":[1,3]},"e":123456789012345678901234567891}`, string(patched))
+
+	_, changed = diffJSON(to, to)
+	assert.False(t, changed)
+}
+
+func TestDeltaEncodedAuthData(t *testing.T) {
+	db, teardown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		require.NoError(t, teardown())
+	}()
+
+	ps, err := NewPostgresStorage(db)
+	require.NoError(t, err)
+
+	const timepoint = uint64(1568358536753)
+	var snapshots []common.AuthDataSnapshot
+	for i := 0; i < keyframeInterval+2; i++ {
+		snapshot := common.AuthDataSnapshot{
+			Valid: true,
+			Block: uint64(i),
+			ReserveBalances: map[rtypes.AssetID]common.BalanceEntry{
+				1: {Valid: true, Balance: common.RawBalance(*big.NewInt(int64(1000 + i%3)))},
+				2: {Valid: true, Balance: common.RawBalance(*big.NewInt(2000))},
+			},
+			ExchangeBalances: map[rtypes.ExchangeID]common.EBalanceEntry{
+				rtypes.Binance: {Valid: true, AvailableBalance: map[rtypes.AssetID]float64{1: 1, 2: 2}},
+			},
+		}
+		require.NoError(t, ps.StoreAuthSnapshot(&snapshot, timepoint+uint64(i)))
+		snapshots = append(snapshots, snapshot)
+	}
+
+	var deltas int
+	require.NoError(t, db.Get(&deltas, `SELECT count(*) FROM fetch_data WHERE base IS NOT NULL`))
+	assert.Equal(t, keyframeInterval, deltas)
+
+	// read without the cache of last stored row
+	ps, err = NewPostgresStorage(db)
+	require.NoError(t, err)
+	for i, expected := range snapshots {
+		authData, err := ps.GetAuthData(common.Version(timepoint + uint64(i)))
+		require.NoError(t, err)
+		assert.Equal(t, expected, authData)
+	}
+
+	var blocks []uint64
+	require.NoError(t, ps.IterateAuthData(timepoint+5, timepoint+8, func(_ time.Time, authData common.AuthDataSnapshot) error {
+		blocks = append(blocks, authData.Block)
+		return nil
+	}))
+	assert.Equal(t, []uint64{5, 6, 7}, blocks)
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// iterateFetchData calls fn with full snapshot of every fetch data of dataType created
+// in [from, to), in order of creation.
+func (ps *PostgresStorage) iterateFetchData(dataType fetchDataType, from, to uint64, fn func(created time.Time, data []byte) error) error {
+	query := fmt.Sprintf(`SELECT created, data, base FROM "%s" WHERE type = $1 AND created >= $2 AND created < $3 ORDER BY created`, fetchDataTable)
+	rows, err := ps.db.Query(query, dataType, common.MillisToTime(from), common.MillisToTime(to))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cle := rows.Close(); cle != nil {
+			ps.l.Errorf("close result error %v", cle)
+		}
+	}()
+	reader := &snapshotReader{ps: ps, dataType: dataType}
+	for rows.Next() {
+		var (
+			created    time.Time
+			compressed []byte
+			base       *time.Time
+		)
+		if err = rows.Scan(&created, &compressed, &base); err != nil {
+			return err
+		}
+		data, err := reader.read(created, compressed, base)
+		if err != nil {
+			return err
+		}
+		if err = fn(created, data); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// IteratePrices calls fn with every price entry stored in [from, to).
+func (ps *PostgresStorage) IteratePrices(from, to uint64, fn func(created time.Time, price common.AllPriceEntry) error) error {
+	return ps.iterateFetchData(priceDataType, from, to, func(created time.Time, data []byte) error {
+		var price common.AllPriceEntry
+		if err := json.Unmarshal(data, &price); err != nil {
+			return err
+		}
+		return fn(created, price)
+	})
+}
+
+// IterateAuthData calls fn with every auth data snapshot stored in [from, to).
+func (ps *PostgresStorage) IterateAuthData(from, to uint64, fn func(created time.Time, authData common.AuthDataSnapshot) error) error {
+	return ps.iterateFetchData(authDataType, from, to, func(created time.Time, data []byte) error {
+		var authData common.AuthDataSnapshot
+		if err := json.Unmarshal(data, &authData); err != nil {
+			return err
+		}
+		return fn(created, authData)
+	})
+}
+
+// IterateRates calls fn with every rate entry stored in [from, to).
+func (ps *PostgresStorage) IterateRates(from, to uint64, fn func(created time.Time, rate common.AllRateEntry) error) error {
+	return ps.iterateFetchData(rateDataType, from, to, func(created time.Time, data []byte) error {
+		var rate common.AllRateEntry
+		if err := json.Unmarshal(data, &rate); err != nil {
+			return err
+		}
+		return fn(created, rate)
+	})
+}
@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+)
+
+func TestIterateRates(t *testing.T) {
+	db, teardown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		require.NoError(t, teardown())
+	}()
+
+	ps, err := NewPostgresStorage(db)
+	require.NoError(t, err)
+
+	const from = uint64(1568358532784)
+	for i := uint64(0); i < 3; i++ {
+		require.NoError(t, ps.StoreRate(common.AllRateEntry{BlockNumber: i}, from+i))
+	}
+
+	var blocks []uint64
+	err = ps.IterateRates(from, from+2, func(created time.Time, rate common.AllRateEntry) error {
+		require.Equal(t, from+rate.BlockNumber, common.TimeToMillis(created))
+		blocks = append(blocks, rate.BlockNumber)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1}, blocks)
+}
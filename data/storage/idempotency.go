@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+type idempotentRequestDB struct {
+	Key         string         `db:"key"`
+	Route       string         `db:"route"`
+	RequestHash string         `db:"request_hash"`
+	Completed   bool           `db:"completed"`
+	Status      sql.NullInt64  `db:"status"`
+	Response    []byte         `db:"response"`
+	Timepoint   sql.NullInt64  `db:"timepoint"`
+	EID         sql.NullString `db:"eid"`
+}
+
+func (r idempotentRequestDB) ToCommon() common.IdempotentRequest {
+	result := common.IdempotentRequest{
+		Key:         r.Key,
+		Route:       r.Route,
+		RequestHash: r.RequestHash,
+		Completed:   r.Completed,
+		Status:      int(r.Status.Int64),
+		Response:    r.Response,
+	}
+	if r.Timepoint.Valid && r.EID.Valid {
+		result.ActivityID = &common.ActivityID{
+			Timepoint: uint64(r.Timepoint.Int64),
+			EID:       r.EID.String,
+		}
+	}
+	return result
+}
+
+// ReserveIdempotencyKey stores an in progress request with the key of given request. If the key
+// is already used, it returns the stored request and false. A key which is still in progress and
+// was reserved before staleBefore, e.g the server crashed while executing the request, is taken over.
+func (ps *PostgresStorage) ReserveIdempotencyKey(req common.IdempotentRequest, staleBefore time.Time) (common.IdempotentRequest, bool, error) {
+	const insertQuery = `INSERT INTO "idempotency_key" (key, route, request_hash) VALUES ($1, $2, $3)
+ON CONFLICT (key) DO UPDATE SET route = EXCLUDED.route, request_hash = EXCLUDED.request_hash, created = now()
+WHERE NOT "idempotency_key".completed AND "idempotency_key".created < $4`
+	res, err := ps.db.Exec(insertQuery, req.Key, req.Route, req.RequestHash, staleBefore)
+	if err != nil {
+		return common.IdempotentRequest{}, false, err
+	}
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return common.IdempotentRequest{}, false, err
+	}
+	if inserted == 1 {
+		return req, true, nil
+	}
+
+	const selectQuery = `SELECT key, route, request_hash, completed, status, response, timepoint, eid
+FROM "idempotency_key" WHERE key = $1`
+	var stored idempotentRequestDB
+	if err := ps.db.Get(&stored, selectQuery, req.Key); err != nil {
+		return common.IdempotentRequest{}, false, err
+	}
+	return stored.ToCommon(), false, nil
+}
+
+// CompleteIdempotentRequest stores the outcome of the request with given key.
+func (ps *PostgresStorage) CompleteIdempotentRequest(key string, status int, response []byte, activityID *common.ActivityID) error {
+	var (
+		timepoint sql.NullInt64
+		eid       sql.NullString
+	)
+	if activityID != nil {
+		timepoint = sql.NullInt64{Int64: int64(activityID.Timepoint), Valid: true}
+		eid = sql.NullString{String: activityID.EID, Valid: true}
+	}
+	const query = `UPDATE "idempotency_key"
+SET completed = TRUE, status = $2, response = $3, timepoint = $4, eid = $5
+WHERE key = $1`
+	_, err := ps.db.Exec(query, key, status, response, timepoint, eid)
+	return err
+}
+
+// ReleaseIdempotencyKey removes the in progress request with given key, so the request can be
+// retried with the same key.
+func (ps *PostgresStorage) ReleaseIdempotencyKey(key string) error {
+	const query = `DELETE FROM "idempotency_key" WHERE key = $1 AND NOT completed`
+	_, err := ps.db.Exec(query, key)
+	return err
+}
+
+// PruneIdempotencyKeys removes the requests with keys reserved before given time and returns the
+// number of removed requests.
+func (ps *PostgresStorage) PruneIdempotencyKeys(before time.Time) (int64, error) {
+	const query = `DELETE FROM "idempotency_key" WHERE created < $1`
+	res, err := ps.db.Exec(query, before)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
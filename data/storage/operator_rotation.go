@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+type operatorRotationDB struct {
+	ID         uint64    `db:"id"`
+	Operator   string    `db:"operator"`
+	OldAddress string    `db:"old_address"`
+	NewAddress string    `db:"new_address"`
+	Step       string    `db:"step"`
+	Error      string    `db:"error"`
+	AddTx      string    `db:"add_tx"`
+	RemoveTx   string    `db:"remove_tx"`
+	Created    time.Time `db:"created"`
+	Updated    time.Time `db:"updated"`
+}
+
+func (r operatorRotationDB) ToCommon() common.OperatorRotation {
+	return common.OperatorRotation{
+		ID:         r.ID,
+		Operator:   r.Operator,
+		OldAddress: ethereum.HexToAddress(r.OldAddress),
+		NewAddress: ethereum.HexToAddress(r.NewAddress),
+		Step:       r.Step,
+		Error:      r.Error,
+		AddTx:      r.AddTx,
+		RemoveTx:   r.RemoveTx,
+		Created:    r.Created.UTC(),
+		Updated:    r.Updated.UTC(),
+	}
+}
+
+// CreateOperatorRotation stores a new operator rotation and returns its id.
+func (ps *PostgresStorage) CreateOperatorRotation(r common.OperatorRotation) (uint64, error) {
+	const query = `INSERT INTO "operator_rotation" (operator, old_address, new_address, step)
+VALUES ($1, $2, $3, $4) RETURNING id`
+	var id uint64
+	err := ps.db.Get(&id, query, r.Operator, r.OldAddress.Hex(), r.NewAddress.Hex(), r.Step)
+	return id, err
+}
+
+// UpdateOperatorRotation stores the step, error and txs of an operator rotation.
+func (ps *PostgresStorage) UpdateOperatorRotation(r common.OperatorRotation) error {
+	const query = `UPDATE "operator_rotation"
+SET step = $2, error = $3, add_tx = $4, remove_tx = $5, updated = now()
+WHERE id = $1`
+	_, err := ps.db.Exec(query, r.ID, r.Step, r.Error, r.AddTx, r.RemoveTx)
+	return err
+}
+
+// GetOperatorRotations returns all operator rotations, the latest first.
+func (ps *PostgresStorage) GetOperatorRotations() ([]common.OperatorRotation, error) {
+	const query = `SELECT id, operator, old_address, new_address, step, error, add_tx, remove_tx, created, updated
+FROM "operator_rotation" ORDER BY id DESC`
+	var records []operatorRotationDB
+	if err := ps.db.Select(&records, query); err != nil {
+		return nil, err
+	}
+	result := make([]common.OperatorRotation, 0, len(records))
+	for _, r := range records {
+		result = append(result, r.ToCommon())
+	}
+	return result, nil
+}
@@ -6,8 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/DataDog/zstd"
@@ -15,6 +15,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
 	"github.com/KyberNetwork/reserve-data/lib/caller"
 	"github.com/KyberNetwork/reserve-data/lib/rtypes"
 	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
@@ -23,9 +24,6 @@ import (
 const (
 	fetchDataTable = "fetch_data" // data fetch from exchange and blockchain
 	activityTable  = "activity"
-	// data type constant
-
-	authDataExpiredDuration uint64 = 10 * 86400000 // 10day in milisec
 )
 
 //go:generate enumer -type=fetchDataType -linecomment -json=true -sql=true
@@ -49,13 +47,17 @@ var (
 type PostgresStorage struct {
 	db *sqlx.DB
 	l  *zap.SugaredLogger
+
+	mu     sync.Mutex
+	chains map[fetchDataType]*deltaChain
 }
 
 // NewPostgresStorage return new db instance
 func NewPostgresStorage(db *sqlx.DB) (*PostgresStorage, error) {
 	s := &PostgresStorage{
-		db: db,
-		l:  zap.S(),
+		db:     db,
+		l:      zap.S(),
+		chains: make(map[fetchDataType]*deltaChain),
 	}
 
 	return s, nil
@@ -80,18 +82,25 @@ func getDataType(data interface{}) fetchDataType {
 }
 
 func (ps *PostgresStorage) storeFetchData(data interface{}, timepoint uint64) error {
-	query := fmt.Sprintf(`INSERT INTO "%s" (created, data, type) VALUES ($1, $2, $3)`, fetchDataTable)
+	query := fmt.Sprintf(`INSERT INTO "%s" (created, data, type, base) VALUES ($1, $2, $3, $4)`, fetchDataTable)
 	timestamp := common.MillisToTime(timepoint)
 	dataJSON, err := json.Marshal(data)
 	if err != nil {
 		return err
 	}
 	dataType := getDataType(data)
-	dataCompressed, err := zstd.CompressLevel(nil, dataJSON, 9)
+	encoded, base, err := ps.encodeFetchData(dataType, timestamp, dataJSON)
+	if err != nil {
+		ps.resetChain(dataType)
+		return err
+	}
+	dataCompressed, err := zstd.CompressLevel(nil, encoded, 9)
 	if err != nil {
+		ps.resetChain(dataType)
 		return err
 	}
-	if _, err := ps.db.Exec(query, timestamp, dataCompressed, dataType); err != nil {
+	if _, err := ps.db.Exec(query, timestamp, dataCompressed, dataType, base); err != nil {
+		ps.resetChain(dataType)
 		return err
 	}
 	return nil
@@ -131,17 +140,35 @@ WHERE
 
 func (ps *PostgresStorage) getData(o interface{}, v common.Version) error {
 	var (
-		dataCompress []byte
-		logger       = ps.l.With("func", caller.GetCurrentFunctionName())
+		record struct {
+			Data []byte     `db:"data"`
+			Base *time.Time `db:"base"`
+		}
+		logger = ps.l.With("func", caller.GetCurrentFunctionName())
 	)
 	ts := common.MillisToTime(uint64(v))
 	dataType := getDataType(o)
-	query := fmt.Sprintf(`SELECT data FROM "%s" WHERE created = $1 AND type = $2`, fetchDataTable)
-	if err := ps.db.Get(&dataCompress, query, ts, dataType); err != nil {
+	if data, ok := ps.lastStored(dataType, ts); ok {
+		return json.Unmarshal(data, o)
+	}
+	query := fmt.Sprintf(`SELECT data, base FROM "%s" WHERE created = $1 AND type = $2`, fetchDataTable)
+	if err := ps.db.Get(&record, query, ts, dataType); err != nil {
 		logger.Errorw("failed to get data from fetchData table", "error", err)
 		return err
 	}
-	data, err := zstd.Decompress(nil, dataCompress)
+	if record.Base != nil {
+		doc, err := ps.rebuildSnapshot(dataType, *record.Base, ts)
+		if err != nil {
+			logger.Errorw("failed to rebuild snapshot", "error", err)
+			return err
+		}
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, o)
+	}
+	data, err := zstd.Decompress(nil, record.Data)
 	if err != nil {
 		logger.Errorw("failed to decompress data", "error", err)
 		return err
@@ -200,73 +227,6 @@ func (ps *PostgresStorage) GetAuthData(v common.Version) (common.AuthDataSnapsho
 	return authData, err
 }
 
-// ExportExpiredAuthData export data to store on s3 storage
-func (ps *PostgresStorage) ExportExpiredAuthData(timepoint uint64, filePath string) (uint64, error) {
-
-	// create export file
-	outFile, err := os.Create(filePath)
-	if err != nil {
-		return 0, err
-	}
-	defer func() {
-		if cErr := outFile.Close(); cErr != nil {
-			ps.l.Errorf("Close file error: %s", cErr.Error())
-		}
-	}()
-
-	// Get expire data
-	timepointExpireData := timepoint - authDataExpiredDuration
-	timestampExpire := common.MillisToTime(timepointExpireData)
-	query := fmt.Sprintf(`SELECT data FROM "%s" WHERE type = $1 AND created < $2`, fetchDataTable)
-	rows, err := ps.db.Query(query, authDataType, timestampExpire)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return 0, nil
-		}
-		return 0, err
-	}
-	defer func() {
-		if cle := rows.Close(); cle != nil {
-			ps.l.Errorf("close result error %v", cle)
-		}
-	}()
-
-	var count uint64
-	for rows.Next() {
-		var data []byte
-		err = rows.Scan(&data)
-		if err != nil {
-			return 0, err
-		}
-		_, _ = outFile.Write(data)
-		_, err = outFile.Write([]byte{'\n'})
-		if err != nil {
-			return 0, err
-		}
-		count++
-	}
-	return count, nil
-}
-
-// PruneExpiredAuthData remove expire auth data from database
-func (ps *PostgresStorage) PruneExpiredAuthData(timepoint uint64) (uint64, error) {
-	var (
-		count uint64
-	)
-	// Get expire data
-	timepointExpireData := timepoint - authDataExpiredDuration
-	timestampExpire := common.MillisToTime(timepointExpireData)
-	query := fmt.Sprintf(`WITH deleted AS 
-	(DELETE FROM "%s" WHERE type = $1 AND created < $2 RETURNING *) SELECT count(*) FROM deleted`, fetchDataTable)
-	if err := ps.db.Get(&count, query, authDataType, timestampExpire); err != nil {
-		if err == sql.ErrNoRows {
-			return 0, nil
-		}
-		return 0, err
-	}
-	return count, nil
-}
-
 // StoreRate store rate
 func (ps *PostgresStorage) StoreRate(allRateEntry common.AllRateEntry, timepoint uint64) error {
 	return ps.storeFetchData(allRateEntry, timepoint)
@@ -376,6 +336,27 @@ SELECT id AS asset_id, (SELECT count(*) FROM (
 	return cres, nil
 }
 
+// GetSettingChangeActivities returns the activities of action sent for setting change id.
+func (ps *PostgresStorage) GetSettingChangeActivities(action string, id rtypes.SettingChangeID) ([]common.ActivityRecord, error) {
+	var (
+		activities []common.ActivityRecord
+		data       [][]byte
+	)
+	query := fmt.Sprintf(`SELECT data FROM "%s" WHERE data->>'action' = $1 AND (data->'params'->>'setting_change_id')::BIGINT = $2`,
+		activityTable)
+	if err := ps.db.Select(&data, query, action, id); err != nil {
+		return nil, err
+	}
+	for _, dataByte := range data {
+		var activity common.ActivityRecord
+		if err := json.Unmarshal(dataByte, &activity); err != nil {
+			return nil, err
+		}
+		activities = append(activities, activity)
+	}
+	return activities, nil
+}
+
 // GetPendingActivities return all pending activities
 func (ps *PostgresStorage) GetPendingActivities() ([]common.ActivityRecord, error) {
 	var (
@@ -422,14 +403,14 @@ func (ps *PostgresStorage) UpdateActivity(id common.ActivityID, act common.Activ
 	return nil
 }
 
-// GetActivity return activity record by id
-func (ps *PostgresStorage) GetActivity(exchangeID rtypes.ExchangeID, id string) (common.ActivityRecord, error) {
+// GetActivity return activity record by the name of its exchange and id
+func (ps *PostgresStorage) GetActivity(exchange string, id string) (common.ActivityRecord, error) {
 	var (
 		activityRecord common.ActivityRecord
 		data           []byte
 	)
 	query := fmt.Sprintf(`SELECT data FROM "%s" WHERE data->>'destination' = $1 AND eid = $2`, activityTable)
-	if err := ps.db.Get(&data, query, exchangeID.String(), id); err != nil {
+	if err := ps.db.Get(&data, query, exchange, id); err != nil {
 		if err == sql.ErrNoRows {
 			return common.ActivityRecord{}, ErrorNotFound
 		}
@@ -443,13 +424,13 @@ func (ps *PostgresStorage) GetActivity(exchangeID rtypes.ExchangeID, id string)
 func getFirstAndCountPendingAction(
 	l *zap.SugaredLogger,
 	pendings []common.ActivityRecord,
-	minedNonce uint64, activityType string) (*common.ActivityRecord, uint64, error) {
+	op string, minedNonce uint64, activityType string) (*common.ActivityRecord, uint64, error) {
 	var minNonce uint64 = math.MaxUint64
 	var minPrice uint64 = math.MaxUint64
 	var result *common.ActivityRecord
 	var count uint64
 	for i, act := range pendings {
-		if act.Action == activityType {
+		if act.Action == activityType && blockchain.ActivityOperator(act) == op {
 			l.Infof("looking for pending (%s): %+v", activityType, act)
 			avtNonce := act.Result.Nonce
 			if avtNonce < minedNonce {
@@ -492,13 +473,13 @@ func getFirstAndCountPendingAction(
 	return result, count, nil
 }
 
-// PendingActivityForAction return pending set rate activity
-func (ps *PostgresStorage) PendingActivityForAction(minedNonce uint64, activityType string) (*common.ActivityRecord, uint64, error) {
+// PendingActivityForAction return pending activity of an action sent by operator op
+func (ps *PostgresStorage) PendingActivityForAction(op string, minedNonce uint64, activityType string) (*common.ActivityRecord, uint64, error) {
 	pendings, err := ps.GetPendingActivities()
 	if err != nil {
 		return nil, 0, err
 	}
-	return getFirstAndCountPendingAction(ps.l, pendings, minedNonce, activityType)
+	return getFirstAndCountPendingAction(ps.l, pendings, op, minedNonce, activityType)
 }
 
 // HasPendingDeposit return true if there is any pending deposit for a token
@@ -508,7 +489,7 @@ func (ps *PostgresStorage) HasPendingDeposit(token commonv3.Asset, exchange comm
 		data            [][]byte
 	)
 	query := fmt.Sprintf(`SELECT data FROM "%s" WHERE is_pending IS TRUE AND data->>'action' = $1 AND data ->> 'destination' = $2`, activityTable)
-	if err := ps.db.Select(&data, query, common.ActionDeposit, exchange.ID().String()); err != nil {
+	if err := ps.db.Select(&data, query, common.ActionDeposit, exchange.Name()); err != nil {
 		if err == sql.ErrNoRows {
 			return false, nil
 		}
@@ -525,14 +506,17 @@ func (ps *PostgresStorage) HasPendingDeposit(token commonv3.Asset, exchange comm
 	return false, nil
 }
 
-// MaxPendingNonce return biggest nonce in pending activity for an action
-func (ps *PostgresStorage) MaxPendingNonce(action string) (int64, error) {
+// MaxPendingNonce return biggest nonce in pending activity for an action sent by operator op
+func (ps *PostgresStorage) MaxPendingNonce(op string, action string) (int64, error) {
+	pendings, err := ps.GetPendingActivities()
+	if err != nil {
+		return 0, err
+	}
 	var v int64
-	if err := ps.db.Get(&v, "SELECT MAX(data->'result'->>'nonce') FROM activity WHERE is_pending IS TRUE AND data->>'action' = $1", action); err != nil {
-		if err == sql.ErrNoRows {
-			return 0, nil
+	for _, act := range pendings {
+		if act.Action == action && blockchain.ActivityOperator(act) == op && act.Result != nil && int64(act.Result.Nonce) > v {
+			v = int64(act.Result.Nonce)
 		}
-		return 0, err
 	}
 	return v, nil
 }
@@ -2,7 +2,10 @@ package storage
 
 import (
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -190,7 +193,7 @@ func TestActivity(t *testing.T) {
 	assert.False(t, hasPending)
 
 	// test get activity
-	activity, err := ps.GetActivity(rtypes.Binance, testID.EID)
+	activity, err := ps.GetActivity(rtypes.Binance.String(), testID.EID)
 	assert.NoError(t, err)
 	assert.Equal(t, activityTest, activity)
 }
@@ -265,8 +268,10 @@ func TestAuthData(t *testing.T) {
 	assert.Equal(t, authDataTest, getAuthData)
 
 	// prune outdated data
-	timepoint = common.NowInMillis()
-	deleted, err := ps.PruneExpiredAuthData(timepoint)
+	timepoint = common.NowInMillis() - uint64((10 * 24 * time.Hour).Milliseconds())
+	exported, err := ps.ExportExpiredData(common.PrunableAuthData, timepoint, filepath.Join(os.TempDir(), "auth_data_test"))
+	assert.NoError(t, err)
+	deleted, err := ps.PruneExportedData(common.PrunableAuthData, exported)
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(1), deleted)
 }
@@ -427,3 +432,59 @@ func TestUSDData(t *testing.T) {
 	err = ps.StoreUSDInfo(usdTest)
 	assert.NoError(t, err)
 }
+
+func TestIdempotencyKey(t *testing.T) {
+	db, teardown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		require.NoError(t, teardown())
+	}()
+
+	ps, err := NewPostgresStorage(db)
+	require.NoError(t, err)
+
+	req := common.IdempotentRequest{
+		Key:         "key",
+		Route:       "/v3/withdraw",
+		RequestHash: "hash",
+	}
+	staleBefore := time.Now().Add(-time.Minute)
+	_, reserved, err := ps.ReserveIdempotencyKey(req, staleBefore)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	stored, reserved, err := ps.ReserveIdempotencyKey(req, staleBefore)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.False(t, stored.Completed)
+
+	// in progress key is taken over once it is stale
+	_, reserved, err = ps.ReserveIdempotencyKey(req, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	require.NoError(t, ps.ReleaseIdempotencyKey(req.Key))
+	_, reserved, err = ps.ReserveIdempotencyKey(req, staleBefore)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	activityID := common.ActivityID{Timepoint: 1568358532784, EID: "eid"}
+	response := []byte(`{"id":"1568358532784|eid","success":true}`)
+	require.NoError(t, ps.CompleteIdempotentRequest(req.Key, 200, response, &activityID))
+
+	stored, reserved, err = ps.ReserveIdempotencyKey(req, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Equal(t, common.IdempotentRequest{
+		Key:         req.Key,
+		Route:       req.Route,
+		RequestHash: req.RequestHash,
+		Completed:   true,
+		Status:      200,
+		Response:    response,
+		ActivityID:  &activityID,
+	}, stored)
+
+	pruned, err := ps.PruneIdempotencyKeys(time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+}
@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	pgutil "github.com/KyberNetwork/reserve-data/common/postgres"
+	"github.com/KyberNetwork/reserve-data/data/pricehistory"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+type priceCandleDB struct {
+	PairID rtypes.TradingPairID `db:"pair_id"`
+	Data   []byte               `db:"data"`
+}
+
+// StorePriceCandles stores candles of resolution, existing candles of the same period are replaced.
+func (ps *PostgresStorage) StorePriceCandles(resolution time.Duration, candles map[rtypes.TradingPairID][]pricehistory.Candle) error {
+	const query = `INSERT INTO "price_candles" (resolution, pair_id, bucket, data) VALUES ($1, $2, $3, $4)
+ON CONFLICT (resolution, pair_id, bucket) DO UPDATE SET data = EXCLUDED.data`
+	tx, err := ps.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer pgutil.RollbackUnlessCommitted(tx)
+	for pairID, pairCandles := range candles {
+		for _, candle := range pairCandles {
+			data, err := json.Marshal(candle)
+			if err != nil {
+				return err
+			}
+			if _, err = tx.Exec(query, int64(resolution/time.Second), pairID, common.MillisToTime(candle.Time), data); err != nil {
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// GetPriceCandles returns candles of resolution of all trading pairs in [from, to) sorted by time.
+func (ps *PostgresStorage) GetPriceCandles(resolution time.Duration, from, to time.Time) (map[rtypes.TradingPairID][]pricehistory.Candle, error) {
+	const query = `SELECT pair_id, data FROM "price_candles"
+WHERE resolution = $1 AND bucket >= $2 AND bucket < $3 ORDER BY bucket`
+	var records []priceCandleDB
+	if err := ps.db.Select(&records, query, int64(resolution/time.Second), from, to); err != nil {
+		return nil, err
+	}
+	result := make(map[rtypes.TradingPairID][]pricehistory.Candle)
+	for _, r := range records {
+		var candle pricehistory.Candle
+		if err := json.Unmarshal(r.Data, &candle); err != nil {
+			return nil, err
+		}
+		result[r.PairID] = append(result[r.PairID], candle)
+	}
+	return result, nil
+}
+
+// GetPairPriceCandles returns candles of resolution of a trading pair in [from, to) sorted by time.
+func (ps *PostgresStorage) GetPairPriceCandles(pairID rtypes.TradingPairID, resolution time.Duration, from, to time.Time) ([]pricehistory.Candle, error) {
+	const query = `SELECT data FROM "price_candles"
+WHERE resolution = $1 AND pair_id = $2 AND bucket >= $3 AND bucket < $4 ORDER BY bucket`
+	var records [][]byte
+	if err := ps.db.Select(&records, query, int64(resolution/time.Second), pairID, from, to); err != nil {
+		return nil, err
+	}
+	result := make([]pricehistory.Candle, 0, len(records))
+	for _, data := range records {
+		var candle pricehistory.Candle
+		if err := json.Unmarshal(data, &candle); err != nil {
+			return nil, err
+		}
+		result = append(result, candle)
+	}
+	return result, nil
+}
+
+// PriceCandleRange returns time of first and last candles of resolution, they are zero if there is none.
+func (ps *PostgresStorage) PriceCandleRange(resolution time.Duration) (time.Time, time.Time, error) {
+	const query = `SELECT MIN(bucket), MAX(bucket) FROM "price_candles" WHERE resolution = $1`
+	var first, last *time.Time
+	if err := ps.db.QueryRow(query, int64(resolution/time.Second)).Scan(&first, &last); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if first == nil || last == nil {
+		return time.Time{}, time.Time{}, nil
+	}
+	return first.UTC(), last.UTC(), nil
+}
@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	pgutil "github.com/KyberNetwork/reserve-data/common/postgres"
+)
+
+type securityFindingDB struct {
+	ID      uint64    `db:"id"`
+	Data    []byte    `db:"data"`
+	Created time.Time `db:"created"`
+}
+
+// StoreSecurityFindings stores security findings, a finding of an event which is already stored is
+// ignored.
+func (ps *PostgresStorage) StoreSecurityFindings(findings []common.SecurityFinding) error {
+	const query = `INSERT INTO "security_finding" (severity, tx_hash, log_index, data) VALUES ($1, $2, $3, $4)
+ON CONFLICT (tx_hash, log_index) WHERE tx_hash <> '' DO NOTHING`
+	tx, err := ps.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer pgutil.RollbackUnlessCommitted(tx)
+	for _, f := range findings {
+		data, err := json.Marshal(f)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Exec(query, f.Severity, f.TxHash, f.LogIndex, data); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetSecurityFindings returns security findings created in [from, to) with one of severities, the
+// latest first.
+func (ps *PostgresStorage) GetSecurityFindings(from, to time.Time, severities []string) ([]common.SecurityFinding, error) {
+	const query = `SELECT id, data, created FROM "security_finding"
+WHERE created >= $1 AND created < $2 AND severity = ANY($3) ORDER BY id DESC`
+	var records []securityFindingDB
+	if err := ps.db.Select(&records, query, from, to, pq.Array(severities)); err != nil {
+		return nil, err
+	}
+	result := make([]common.SecurityFinding, 0, len(records))
+	for _, r := range records {
+		var f common.SecurityFinding
+		if err := json.Unmarshal(r.Data, &f); err != nil {
+			return nil, err
+		}
+		f.ID = r.ID
+		f.Created = r.Created.UTC()
+		result = append(result, f)
+	}
+	return result, nil
+}
@@ -32,7 +32,8 @@ type Binance struct {
 	sr      storage.Interface
 	l       *zap.SugaredLogger
 	BinanceLive
-	id rtypes.ExchangeID
+	id   rtypes.ExchangeID
+	name string
 }
 
 // TokenAddresses return deposit addresses of token
@@ -44,12 +45,14 @@ func (bn *Binance) TokenAddresses() (map[rtypes.AssetID]ethereum.Address, error)
 	return result, nil
 }
 
-// MarshalText Return exchange id by name
+// MarshalText Return exchange name
 func (bn *Binance) MarshalText() (text []byte, err error) {
-	return []byte(bn.ID().String()), nil
+	return []byte(bn.Name()), nil
 }
 
-// Address returns the deposit address of given token.
+// Address returns the deposit address of given token. Live address is preferred over the stored
+// one, it is stored if there is no stored address yet. A live address that differs from the stored
+// address is reported and not stored, it must be reviewed and updated by a setting change.
 func (bn *Binance) Address(asset commonv3.Asset) (ethereum.Address, bool) {
 	var symbol string
 	for _, exchange := range asset.Exchanges {
@@ -57,27 +60,38 @@ func (bn *Binance) Address(asset commonv3.Asset) (ethereum.Address, bool) {
 			symbol = exchange.Symbol
 		}
 	}
+	addrs, sErr := bn.sr.GetDepositAddresses(bn.id)
+	if sErr != nil {
+		bn.l.Warnw("failed to get stored deposit addresses", "exchange", bn.name, "err", sErr)
+	}
+	storedAddress := addrs[asset.ID]
 	liveAddress, err := bn.interf.GetDepositAddress(symbol)
 	if err != nil || liveAddress.Address == "" {
 		bn.l.Warnw("Get Binance live deposit address for token failed or the address replied is empty . Use the currently available address instead", "assetID", asset.ID, "err", err)
-		addrs, uErr := bn.sr.GetDepositAddresses(bn.id)
-		if uErr != nil {
-			bn.l.Warnw("get address of token in Binance exchange failed, it will be considered as not supported", "assetID", asset.ID, "err", err)
+		if sErr != nil {
+			bn.l.Warnw("get address of token in Binance exchange failed, it will be considered as not supported", "assetID", asset.ID, "err", sErr)
 			return ethereum.Address{}, false
 		}
-		depositAddr, ok := addrs[asset.ID]
-		return depositAddr, ok && !commonv3.IsZeroAddress(depositAddr)
+		return storedAddress, !commonv3.IsZeroAddress(storedAddress)
+	}
+	live := ethereum.HexToAddress(liveAddress.Address)
+	if sErr != nil {
+		return live, true
+	}
+	if !commonv3.IsZeroAddress(storedAddress) {
+		if storedAddress != live {
+			bn.l.Errorw("live deposit address differs from stored deposit address",
+				"exchange", bn.name, "assetID", asset.ID, "symbol", symbol,
+				"live", live.Hex(), "stored", storedAddress.Hex())
+		}
+		return live, true
 	}
 	bn.l.Infof("Got Binance live deposit address for token %d, attempt to update it to current setting", asset.ID)
-	if err = bn.sr.UpdateDepositAddress(
-		asset.ID,
-		bn.id,
-		ethereum.HexToAddress(liveAddress.Address)); err != nil {
+	if err = bn.sr.UpdateDepositAddress(asset.ID, bn.id, live); err != nil {
 		bn.l.Warnw("failed to update deposit address", "err", err)
 		return ethereum.Address{}, false
-
 	}
-	return ethereum.HexToAddress(liveAddress.Address), true
+	return live, true
 }
 
 // ID must return the exact string or else simulation will fail
@@ -85,6 +99,11 @@ func (bn *Binance) ID() rtypes.ExchangeID {
 	return bn.id
 }
 
+// Name returns the name of the exchange account.
+func (bn *Binance) Name() string {
+	return bn.name
+}
+
 // TokenPairs return token pairs supported by exchange
 func (bn *Binance) TokenPairs() ([]commonv3.TradingPairSymbols, error) {
 	pairs, err := bn.sr.GetTradingPairs(bn.id)
@@ -142,6 +161,11 @@ func (bn *Binance) Transfer(fromAccount string, toAccount string, asset commonv3
 	return bn.interf.Transfer(fromAccount, toAccount, asset, amount)
 }
 
+// AccountID returns account id of this exchange account, it is used to transfer between accounts.
+func (bn *Binance) AccountID() string {
+	return bn.interf.AccountID()
+}
+
 // CancelOrder cancel order on binance
 func (bn *Binance) CancelOrder(id, symbol string) error {
 	idNo, err := strconv.ParseUint(id, 10, 64)
@@ -518,7 +542,7 @@ func (bn *Binance) OpenOrders(pair commonv3.TradingPairSymbols) ([]common.Order,
 }
 
 // NewBinance init new binance instance
-func NewBinance(id rtypes.ExchangeID, interf BinanceInterface, storage BinanceStorage, sr storage.Interface) (*Binance, error) {
+func NewBinance(id rtypes.ExchangeID, name string, interf BinanceInterface, storage BinanceStorage, sr storage.Interface) (*Binance, error) {
 	binance := &Binance{
 		interf:  interf,
 		storage: storage,
@@ -526,8 +550,9 @@ func NewBinance(id rtypes.ExchangeID, interf BinanceInterface, storage BinanceSt
 		BinanceLive: BinanceLive{
 			interf: interf,
 		},
-		id: id,
-		l:  zap.S(),
+		id:   id,
+		name: name,
+		l:    zap.S(),
 	}
 	return binance, nil
 }
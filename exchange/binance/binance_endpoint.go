@@ -337,6 +337,11 @@ type transferResult struct {
 	Msg     string `json:"msg"`
 }
 
+// AccountID returns id of the account in account data service.
+func (ep *Endpoint) AccountID() string {
+	return ep.accountID
+}
+
 func (ep *Endpoint) Transfer(fromAccount string, toAccount string, asset commonv3.Asset, amount *big.Int) (string, error) {
 	var symbol string
 	for _, exchg := range asset.Exchanges {
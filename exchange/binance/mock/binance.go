@@ -57,6 +57,11 @@ func (bte *BinanceTestExchange) ID() rtypes.ExchangeID {
 	return rtypes.Binance
 }
 
+// Name return binance exchange name
+func (bte *BinanceTestExchange) Name() string {
+	return rtypes.Binance.String()
+}
+
 // GetLiveExchangeInfos of TestExchangeForSetting return a valid result for
 func (bte *BinanceTestExchange) GetLiveExchangeInfos(tokenPairIDs []commonv3.TradingPairSymbols) (common.ExchangeInfo, error) {
 	result := make(common.ExchangeInfo)
@@ -27,6 +27,9 @@ type BinanceInterface interface {
 		amount *big.Int,
 		address ethereum.Address) (string, error)
 	Transfer(fromAccount string, toAccount string, asset commonv3.Asset, amount *big.Int) (string, error)
+
+	// AccountID returns id of the account in account data service.
+	AccountID() string
 	Trade(
 		tradeType string,
 		pair commonv3.TradingPairSymbols,
@@ -14,7 +14,7 @@ import (
 func TestBinance(t *testing.T) {
 
 	binanceEndpoint := &binanceTestInterface{}
-	binance, err := NewBinance(rtypes.Binance, binanceEndpoint, nil, nil)
+	binance, err := NewBinance(rtypes.Binance, rtypes.Binance.String(), binanceEndpoint, nil, nil)
 	require.NoError(t, err)
 	t.Log(binance.ID())
 }
@@ -23,6 +23,10 @@ func TestBinance(t *testing.T) {
 type binanceTestInterface struct {
 }
 
+func (bi *binanceTestInterface) AccountID() string {
+	return "test"
+}
+
 func (bi *binanceTestInterface) Transfer(fromAccount string, toAccount string, asset commonv3.Asset, amount *big.Int) (string, error) {
 	return "tid", nil
 }
@@ -37,6 +37,8 @@ type Huobi struct {
 	sr         storage.SettingReader
 	l          *zap.SugaredLogger
 	HuobiLive
+	id   rtypes.ExchangeID
+	name string
 }
 
 func (h *Huobi) Transfer(fromAccount string, toAccount string, asset commonv3.Asset, amount *big.Int) (string, error) {
@@ -45,7 +47,7 @@ func (h *Huobi) Transfer(fromAccount string, toAccount string, asset commonv3.As
 
 // TokenAddresses return deposit of all token supported by Huobi
 func (h *Huobi) TokenAddresses() (map[rtypes.AssetID]ethereum.Address, error) {
-	result, err := h.sr.GetDepositAddresses(rtypes.Huobi)
+	result, err := h.sr.GetDepositAddresses(h.id)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +56,7 @@ func (h *Huobi) TokenAddresses() (map[rtypes.AssetID]ethereum.Address, error) {
 
 // MarshalText marshal Huobi exchange name
 func (h *Huobi) MarshalText() (text []byte, err error) {
-	return []byte(h.ID().String()), nil
+	return []byte(h.Name()), nil
 }
 
 // RealDepositAddress return the actual Huobi deposit address of a token
@@ -67,7 +69,7 @@ func (h *Huobi) RealDepositAddress(tokenID string, asset commonv3.Asset) (ethere
 		} else {
 			h.l.Warnw("Get Huobi live deposit address for token failed: the replied address is empty. Check the currently available address instead", "tokenID", tokenID)
 		}
-		addrs, uErr := h.sr.GetDepositAddresses(rtypes.Huobi)
+		addrs, uErr := h.sr.GetDepositAddresses(h.id)
 		if uErr != nil {
 			return ethereum.Address{}, uErr
 		}
@@ -86,7 +88,7 @@ func (h *Huobi) RealDepositAddress(tokenID string, asset commonv3.Asset) (ethere
 func (h *Huobi) Address(asset commonv3.Asset) (ethereum.Address, bool) {
 	var exhSymbol string
 	for _, exchange := range asset.Exchanges {
-		if exchange.ExchangeID == rtypes.Huobi {
+		if exchange.ExchangeID == h.id {
 			exhSymbol = exchange.Symbol
 		}
 	}
@@ -101,7 +103,7 @@ func (h *Huobi) Address(asset commonv3.Asset) (ethereum.Address, bool) {
 
 // TokenPairs return all token pair support by Huobi
 func (h *Huobi) TokenPairs() ([]commonv3.TradingPairSymbols, error) {
-	pairs, err := h.sr.GetTradingPairs(rtypes.Huobi)
+	pairs, err := h.sr.GetTradingPairs(h.id)
 	if err != nil {
 		return nil, err
 	}
@@ -295,7 +297,7 @@ func (h *Huobi) FetchEBalanceData(timepoint uint64) (common.EBalanceEntry, error
 				tokenSymbol := strings.ToUpper(b.Currency)
 				for _, asset := range assets {
 					for _, exchg := range asset.Exchanges {
-						if exchg.ExchangeID == rtypes.Huobi && exchg.Symbol == tokenSymbol {
+						if exchg.ExchangeID == h.id && exchg.Symbol == tokenSymbol {
 							balance, _ := strconv.ParseFloat(b.Balance, 64)
 							if b.Type == "trade" {
 								result.AvailableBalance[asset.ID] = balance
@@ -496,7 +498,7 @@ func (h *Huobi) exchangeDepositStatus(id common.ActivityID, tx2Entry common.TXEn
 		if deposit.TxHash == tx2Entry.Hash {
 			if deposit.State == "safe" || deposit.State == "confirmed" {
 				data := common.NewTXEntry(tx2Entry.Hash,
-					h.ID().String(),
+					h.Name(),
 					assetID,
 					"mined",
 					exchangeStatusDone,
@@ -537,7 +539,7 @@ func (h *Huobi) process1stTx(id common.ActivityID, tx1Hash string, assetID rtype
 
 		var exhSymbol string
 		for _, exchg := range asset.Exchanges {
-			if exchg.ExchangeID == rtypes.Huobi {
+			if exchg.ExchangeID == h.id {
 				exhSymbol = exchg.Symbol
 			}
 		}
@@ -554,7 +556,7 @@ func (h *Huobi) process1stTx(id common.ActivityID, tx1Hash string, assetID rtype
 		//store tx2 to pendingIntermediateTx
 		data := common.NewTXEntry(
 			tx2.Hash().Hex(),
-			h.ID().String(),
+			h.Name(),
 			assetID,
 			common.MiningStatusSubmitted,
 			"",
@@ -596,7 +598,7 @@ func (h *Huobi) DepositStatus(id common.ActivityID, tx1Hash string, assetID rtyp
 		h.l.Infof("Huobi 2nd Transaction is mined. Processed to store it and check the Huobi Deposit history")
 		data = common.NewTXEntry(
 			tx2Entry.Hash,
-			h.ID().String(),
+			h.Name(),
 			assetID,
 			common.MiningStatusMined,
 			"",
@@ -612,7 +614,7 @@ func (h *Huobi) DepositStatus(id common.ActivityID, tx1Hash string, assetID rtyp
 	case common.MiningStatusFailed:
 		data = common.NewTXEntry(
 			tx2Entry.Hash,
-			h.ID().String(),
+			h.Name(),
 			assetID,
 			common.MiningStatusFailed,
 			common.ExchangeStatusFailed,
@@ -629,7 +631,7 @@ func (h *Huobi) DepositStatus(id common.ActivityID, tx1Hash string, assetID rtyp
 		if elapsed > uint64(15*time.Minute/time.Millisecond) {
 			data = common.NewTXEntry(
 				tx2Entry.Hash,
-				h.ID().String(),
+				h.Name(),
 				assetID,
 				common.MiningStatusLost,
 				common.ExchangeStatusLost,
@@ -707,7 +709,12 @@ func (h *Huobi) OrderStatus(id, base, quote string) (string, float64, error) {
 
 // ID return exchange ID
 func (h *Huobi) ID() rtypes.ExchangeID {
-	return rtypes.Huobi
+	return h.id
+}
+
+// Name returns the name of the exchange account.
+func (h *Huobi) Name() string {
+	return h.name
 }
 
 // OpenOrders get open orders from binance
@@ -776,6 +783,8 @@ func (h *Huobi) OpenOrders(pair commonv3.TradingPairSymbols) ([]common.Order, er
 
 //NewHuobi creates new Huobi exchange instance
 func NewHuobi(
+	id rtypes.ExchangeID,
+	name string,
 	interf HuobiInterface,
 	blockchain *blockchain.BaseBlockchain,
 	signer blockchain.Signer,
@@ -797,7 +806,9 @@ func NewHuobi(
 		HuobiLive: HuobiLive{
 			interf: interf,
 		},
-		l: zap.S(),
+		l:    zap.S(),
+		id:   id,
+		name: name,
 	}
 	huobiServer := huobihttp.NewHuobiHTTPServer(&huobiObj)
 	go huobiServer.Run()
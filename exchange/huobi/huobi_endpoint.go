@@ -29,6 +29,7 @@ type Endpoint struct {
 	l                 *zap.SugaredLogger
 	client            *http.Client
 	marketDataBaseURL string
+	exchangeID        rtypes.ExchangeID
 }
 
 func (ep *Endpoint) fillRequest(req *http.Request, signNeeded bool) {
@@ -278,7 +279,7 @@ func (ep *Endpoint) OrderStatus(symbol string, id uint64) (exchange.HuobiOrder,
 func (ep *Endpoint) Withdraw(asset commonv3.Asset, amount *big.Int, address ethereum.Address) (string, error) {
 	var symbol string
 	for _, exchg := range asset.Exchanges {
-		if exchg.ExchangeID == rtypes.Huobi {
+		if exchg.ExchangeID == ep.exchangeID {
 			symbol = exchg.Symbol
 		}
 	}
@@ -442,12 +443,14 @@ func (ep *Endpoint) GetAllAssetDetail() ([]exchange.HuobiAssetDetail, error) {
 }
 
 //NewHuobiEndpoint return new endpoint instance
-func NewHuobiEndpoint(signer Signer, interf Interface, client *http.Client, marketDataBaseURL string) *Endpoint {
+func NewHuobiEndpoint(signer Signer, interf Interface, client *http.Client, marketDataBaseURL string,
+	exchangeID rtypes.ExchangeID) *Endpoint {
 	return &Endpoint{
 		signer:            signer,
 		interf:            interf,
 		l:                 zap.S(),
 		client:            client,
 		marketDataBaseURL: marketDataBaseURL,
+		exchangeID:        exchangeID,
 	}
 }
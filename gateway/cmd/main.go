@@ -3,19 +3,23 @@ package main
 import (
 	"log"
 	"os"
+	"time"
 
-	"github.com/gin-contrib/httpsign"
-	"github.com/gin-gonic/gin"
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 
-	"github.com/KyberNetwork/httpsign-utils/authenticator"
+	"github.com/KyberNetwork/reserve-data/cmd/configuration"
 	"github.com/KyberNetwork/reserve-data/cmd/mode"
+	"github.com/KyberNetwork/reserve-data/gateway/common"
 	"github.com/KyberNetwork/reserve-data/gateway/http"
+	"github.com/KyberNetwork/reserve-data/gateway/permission"
+	"github.com/KyberNetwork/reserve-data/gateway/storage"
+	"github.com/KyberNetwork/reserve-data/gateway/storage/postgres"
 	libapp "github.com/KyberNetwork/reserve-data/lib/app"
 	"github.com/KyberNetwork/reserve-data/lib/httputil"
+	"github.com/KyberNetwork/reserve-data/lib/migration"
 )
 
 const (
@@ -27,11 +31,18 @@ const (
 	confirmSecretKeyFlag   = "confirm-secret-key"
 	rebalanceAccessKeyFlag = "rebalance-access-key"
 	rebalanceSecretKeyFlag = "rebalance-secret-key"
+	adminAccessKeyFlag     = "admin-access-key"
+	adminSecretKeyFlag     = "admin-secret-key"
+
+	keyReloadIntervalFlag    = "key-reload-interval"
+	defaultKeyReloadInterval = time.Minute
 
 	coreEndpointFlag    = "core-endpoint"
 	settingEndpointFlag = "setting-endpoint"
 
 	noAuthFlag = "no-auth"
+
+	defaultDB = "reserve_data"
 )
 
 var (
@@ -84,6 +95,22 @@ func main() {
 			Usage:  "secret key to access rebalance paths",
 			EnvVar: "REBALANCE_SECRET_KEY",
 		},
+		cli.StringSliceFlag{
+			Name:   adminAccessKeyFlag,
+			Usage:  "access key for managing gateway keys and roles",
+			EnvVar: "ADMIN_ACCESS_KEY",
+		},
+		cli.StringSliceFlag{
+			Name:   adminSecretKeyFlag,
+			Usage:  "secret key for managing gateway keys and roles",
+			EnvVar: "ADMIN_SECRET_KEY",
+		},
+		cli.DurationFlag{
+			Name:   keyReloadIntervalFlag,
+			Usage:  "interval reload keys and roles from database",
+			EnvVar: "KEY_RELOAD_INTERVAL",
+			Value:  defaultKeyReloadInterval,
+		},
 		cli.StringFlag{
 			Name:   coreEndpointFlag,
 			Usage:  "core endpoint url",
@@ -105,35 +132,38 @@ func main() {
 
 	app.Flags = append(app.Flags, httputil.NewHTTPCliFlags(httputil.GatewayPort)...)
 	app.Flags = append(app.Flags, mode.NewCliFlag())
+	app.Flags = append(app.Flags, configuration.NewPostgreSQLFlags(defaultDB)...)
+	app.Flags = append(app.Flags, migration.NewMigrationFolderPathFlag())
 
 	if err := app.Run(os.Args); err != nil {
 		log.Fatal(err)
 	}
 }
 
-func getKeyList(c *cli.Context, accessKeyFlag, secretKeyFlag string) ([]authenticator.KeyPair, error) {
-	var keyPairs []authenticator.KeyPair
+func getKeyList(c *cli.Context, accessKeyFlag, secretKeyFlag, role string) ([]common.Key, error) {
+	var keys []common.Key
 	accessKeys := c.StringSlice(accessKeyFlag)
 	secretKeys := c.StringSlice(secretKeyFlag)
 	if len(accessKeys) != len(secretKeys) {
-		return nil, errors.Errorf("length read access keys (%d) and read secret keys (%d)", len(accessKeys), len(secretKeys))
+		return nil, errors.Errorf("length %s access keys (%d) and %s secret keys (%d)", role, len(accessKeys), role, len(secretKeys))
 	}
 
 	for index := range accessKeys {
-		keyPairs = append(keyPairs, authenticator.KeyPair{
-			AccessKeyID:     accessKeys[index],
-			SecretAccessKey: secretKeys[index],
+		keys = append(keys, common.Key{
+			ID:     accessKeys[index],
+			Secret: secretKeys[index],
+			Role:   role,
 		})
 	}
-	return keyPairs, nil
+	return keys, nil
 }
 
 func run(c *cli.Context) error {
 	var (
-		keyPairs, readKeys, writeKeys, confirmKeys, rebalanceKeys []authenticator.KeyPair
-		err                                                       error
-		auth                                                      *httpsign.Authenticator
-		perm                                                      gin.HandlerFunc
+		staticKeys []common.Key
+		err        error
+		auth       *permission.Authorizer
+		st         storage.Interface
 	)
 	logger, err := libapp.NewLogger(c)
 	if err != nil {
@@ -154,26 +184,21 @@ func run(c *cli.Context) error {
 
 	noAuth := c.Bool(noAuthFlag)
 	if !noAuth {
-		readKeys, err = getKeyList(c, readAccessKeyFlag, readSecretKeyFlag)
-		if err != nil {
-			return errors.Wrap(err, "failed to get read keys")
-		}
-		keyPairs = append(keyPairs, readKeys...)
-		writeKeys, err = getKeyList(c, writeAccessKeyFlag, writeSecretKeyFlag)
-		if err != nil {
-			return errors.Wrap(err, "failed to get write keys")
-		}
-		keyPairs = append(keyPairs, writeKeys...)
-		confirmKeys, err = getKeyList(c, confirmAccessKeyFlag, confirmSecretKeyFlag)
-		if err != nil {
-			return errors.Wrap(err, "failed to get confirm keys")
-		}
-		keyPairs = append(keyPairs, confirmKeys...)
-		rebalanceKeys, err = getKeyList(c, rebalanceAccessKeyFlag, rebalanceSecretKeyFlag)
-		if err != nil {
-			return errors.Wrap(err, "failed to get rebalance keys")
+		for _, k := range []struct {
+			accessKeyFlag, secretKeyFlag, role string
+		}{
+			{readAccessKeyFlag, readSecretKeyFlag, http.RoleRead},
+			{writeAccessKeyFlag, writeSecretKeyFlag, http.RoleWrite},
+			{confirmAccessKeyFlag, confirmSecretKeyFlag, http.RoleConfirm},
+			{rebalanceAccessKeyFlag, rebalanceSecretKeyFlag, http.RoleRebalance},
+			{adminAccessKeyFlag, adminSecretKeyFlag, http.RoleAdmin},
+		} {
+			keys, err := getKeyList(c, k.accessKeyFlag, k.secretKeyFlag, k.role)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get %s keys", k.role)
+			}
+			staticKeys = append(staticKeys, keys...)
 		}
-		keyPairs = append(keyPairs, rebalanceKeys...)
 
 		if err := validation.Validate(c.String(writeAccessKeyFlag), validation.Required); err != nil {
 			return errors.Wrap(err, "write access key error")
@@ -182,19 +207,29 @@ func run(c *cli.Context) error {
 		if err := validation.Validate(c.String(writeSecretKeyFlag), validation.Required); err != nil {
 			return errors.Wrap(err, "secret key error")
 		}
-		auth, err = authenticator.NewAuthenticator(keyPairs...)
+
+		db, err := configuration.NewDBFromContext(c)
+		if err != nil {
+			return errors.Wrap(err, "failed to connect to database")
+		}
+		if _, err := migration.RunMigrationUp(db.DB, migration.NewMigrationPathFromContext(c), configuration.DatabaseNameFromContext(c)); err != nil {
+			return errors.Wrap(err, "failed to run migration")
+		}
+		st, err = postgres.NewStorage(db)
 		if err != nil {
-			return errors.Wrap(err, "authentication object creation error")
+			return errors.Wrap(err, "storage object creation error")
 		}
-		perm, err = http.NewPermissioner(readKeys, writeKeys, confirmKeys, rebalanceKeys)
+		auth, err = permission.NewAuthorizer(st, http.BuiltInRoles(), staticKeys,
+			http.NewSettingPairResolver(c.String(settingEndpointFlag)))
 		if err != nil {
-			return errors.Wrap(err, "permission object creation error")
+			return errors.Wrap(err, "authorizer object creation error")
 		}
+		go auth.Run(c.Duration(keyReloadIntervalFlag))
 	}
 
 	svr, err := http.NewServer(httputil.NewHTTPAddressFromContext(c),
 		auth,
-		perm,
+		st,
 		noAuth,
 		logger,
 		http.WithCoreEndpoint(c.String(coreEndpointFlag)),
@@ -0,0 +1,14 @@
+package common
+
+import "github.com/pkg/errors"
+
+var (
+	// ErrNotFound is the error to return when no record is found in database.
+	ErrNotFound = errors.New("not found")
+	// ErrKeyExists is returned when creating a key with duplicated id.
+	ErrKeyExists = errors.New("key already exists")
+	// ErrKeyInactive is returned when rotating a revoked, expired or already rotated key.
+	ErrKeyInactive = errors.New("key is revoked, expired or already rotated")
+	// ErrRoleInUse is returned when deleting a role that is still bound to active keys.
+	ErrRoleInUse = errors.New("role is in use")
+)
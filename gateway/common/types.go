@@ -0,0 +1,77 @@
+package common
+
+import (
+	"time"
+
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+// Key is an access key of gateway. The key is bound to a role which defines the routes it can
+// call, mutating requests of a key with asset scope are only allowed for the listed assets.
+type Key struct {
+	ID          string           `json:"id"`
+	Secret      string           `json:"secret,omitempty"`
+	Role        string           `json:"role"`
+	Assets      []rtypes.AssetID `json:"assets"`
+	Description string           `json:"description"`
+	Created     time.Time        `json:"created"`
+	ExpiresAt   *time.Time       `json:"expires_at"`
+	RevokedAt   *time.Time       `json:"revoked_at"`
+	RotatedTo   *string          `json:"rotated_to"`
+}
+
+// Active returns true if key is not revoked and not expired at given time.
+func (k Key) Active(at time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || at.Before(*k.ExpiresAt)
+}
+
+// AllowAsset returns true if key is allowed to make change to given asset.
+func (k Key) AllowAsset(asset rtypes.AssetID) bool {
+	if len(k.Assets) == 0 {
+		return true
+	}
+	for _, a := range k.Assets {
+		if a == asset {
+			return true
+		}
+	}
+	return false
+}
+
+// Permission allows a role to call the routes matching Path with methods matching Method.
+// Path uses keyMatch2 syntax (e.g /v3/setting-change-main/:id, /v3/*), Method is a regular
+// expression (e.g GET, (PUT)|(DELETE)).
+type Permission struct {
+	Path   string `json:"path" binding:"required"`
+	Method string `json:"method" binding:"required"`
+}
+
+// Role is a named set of permissions.
+type Role struct {
+	Name        string       `json:"name" binding:"required"`
+	Permissions []Permission `json:"permissions" binding:"required,dive"`
+	BuiltIn     bool         `json:"built_in"`
+}
+
+// AuditRecord is an entry of the gateway audit log, one record is written for each
+// authenticated request.
+type AuditRecord struct {
+	ID       uint64    `json:"id"`
+	Time     time.Time `json:"time"`
+	KeyID    string    `json:"key_id"`
+	Method   string    `json:"method"`
+	Route    string    `json:"route"`
+	BodyHash string    `json:"body_hash"`
+	Status   int       `json:"status"`
+	Result   string    `json:"result"`
+}
+
+// Results of audited requests.
+const (
+	AuditResultSuccess = "success"
+	AuditResultFailure = "failure"
+	AuditResultDenied  = "denied"
+)
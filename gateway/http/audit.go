@@ -0,0 +1,94 @@
+package http
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/gateway/common"
+	"github.com/KyberNetwork/reserve-data/gateway/permission"
+	"github.com/KyberNetwork/reserve-data/gateway/storage"
+)
+
+// maxCapturedResponse is the max size of response body kept to find out the result of request.
+const maxCapturedResponse = 4096
+
+// responseCapture keeps the beginning of response body written to client.
+type responseCapture struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseCapture) Write(data []byte) (int, error) {
+	if remain := maxCapturedResponse - w.body.Len(); remain > 0 {
+		if len(data) < remain {
+			remain = len(data)
+		}
+		w.body.Write(data[:remain])
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// newAuditMW returns a middleware which appends a record to audit log for every authenticated
+// request, including the ones denied by permission check.
+func newAuditMW(st storage.Interface) gin.HandlerFunc {
+	l := zap.S()
+	return func(c *gin.Context) {
+		keyID, err := permission.GetKeyID(c.Request)
+		if err != nil {
+			c.Next()
+			return
+		}
+		var body []byte
+		if c.Request.Body != nil {
+			if body, err = ioutil.ReadAll(c.Request.Body); err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"reason": err.Error()})
+				return
+			}
+			c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+		}
+		hash := sha256.Sum256(body)
+		record := common.AuditRecord{
+			Time:     time.Now(),
+			KeyID:    string(keyID),
+			Method:   c.Request.Method,
+			Route:    c.Request.URL.Path,
+			BodyHash: hex.EncodeToString(hash[:]),
+		}
+
+		w := &responseCapture{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+
+		record.Status = w.Status()
+		record.Result = auditResult(record.Status, w.body.Bytes())
+		if err := st.AddAuditRecord(record); err != nil {
+			l.Errorw("failed to write audit log", "err", err, "key_id", record.KeyID, "route", record.Route)
+		}
+	}
+}
+
+// auditResult returns the result of request from response status and body, services behind
+// gateway respond failures with status 200 and success=false.
+func auditResult(status int, body []byte) string {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return common.AuditResultDenied
+	case status >= http.StatusBadRequest:
+		return common.AuditResultFailure
+	}
+	var resp struct {
+		Success *bool `json:"success"`
+	}
+	if err := json.Unmarshal(body, &resp); err == nil && resp.Success != nil && !*resp.Success {
+		return common.AuditResultFailure
+	}
+	return common.AuditResultSuccess
+}
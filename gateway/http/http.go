@@ -5,9 +5,10 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/KyberNetwork/reserve-data/gateway/permission"
+	"github.com/KyberNetwork/reserve-data/gateway/storage"
 	libhttputil "github.com/KyberNetwork/reserve-data/lib/httputil"
 	"github.com/gin-contrib/cors"
-	"github.com/gin-contrib/httpsign"
 	ginzap "github.com/gin-contrib/zap"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -31,11 +32,12 @@ func newReverseProxyMW(target string) (gin.HandlerFunc, error) {
 	}, nil
 }
 
-// NewServer creates new instance of gateway HTTP server.
+// NewServer creates new instance of gateway HTTP server. Authenticated requests are recorded
+// to audit log of storage, auth and storage are not used if noAuth is set.
 // TODO: add logger
 func NewServer(addr string,
-	auth *httpsign.Authenticator,
-	perm gin.HandlerFunc,
+	auth *permission.Authorizer,
+	st storage.Interface,
 	noAuth bool,
 	logger *zap.Logger,
 	options ...Option,
@@ -44,13 +46,16 @@ func NewServer(addr string,
 	r.Use(libhttputil.MiddlewareHandler) // TODO: remove this as we have already have zap logger?
 	corsConfig := cors.DefaultConfig()
 	corsConfig.AllowAllOrigins = true
-	corsConfig.AddAllowHeaders("Digest", "Authorization", "Signature", "Nonce")
+	corsConfig.AddAllowHeaders("Digest", "Authorization", "Signature", "Nonce", "Idempotency-Key")
 	corsConfig.MaxAge = 5 * time.Minute
 	r.Use(ginzap.Ginzap(logger, time.RFC3339, true))
 	r.Use(cors.New(corsConfig))
 	if !noAuth {
 		r.Use(auth.Authenticated())
-		r.Use(perm)
+		r.Use(newAuditMW(st))
+		r.Use(auth.Permission())
+		km := &keyManager{storage: st, auth: auth, l: zap.S()}
+		km.register(r)
 	}
 
 	server := Server{
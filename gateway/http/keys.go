@@ -0,0 +1,283 @@
+package http
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	v1common "github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/gateway/common"
+	"github.com/KyberNetwork/reserve-data/gateway/permission"
+	"github.com/KyberNetwork/reserve-data/gateway/storage"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+const (
+	keyIDLength         = 16
+	secretLength        = 32
+	defaultGracePeriod  = time.Hour
+	defaultAuditLogTime = 24 * time.Hour
+)
+
+// keyManager serves the runtime management API of gateway keys, roles and audit log.
+type keyManager struct {
+	storage storage.Interface
+	auth    *permission.Authorizer
+	l       *zap.SugaredLogger
+}
+
+func (m *keyManager) register(r *gin.Engine) {
+	g := r.Group("/v3/gateway", m.auth.Restricted("/v3/gateway"))
+	g.GET("/keys", m.getKeys)
+	g.POST("/keys", m.createKey)
+	g.POST("/keys/:id/rotate", m.rotateKey)
+	g.DELETE("/keys/:id", m.revokeKey)
+
+	g.GET("/roles", m.getRoles)
+	g.POST("/roles", m.updateRole)
+	g.DELETE("/roles/:name", m.deleteRole)
+
+	g.GET("/audit-logs", m.getAuditLogs)
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func newKey() (common.Key, error) {
+	id, err := randomHex(keyIDLength)
+	if err != nil {
+		return common.Key{}, err
+	}
+	secret, err := randomHex(secretLength)
+	if err != nil {
+		return common.Key{}, err
+	}
+	return common.Key{ID: id, Secret: secret, Created: time.Now()}, nil
+}
+
+// reload applies the changes to authorizer, failures are only logged as changes are picked up
+// in the next periodic reload.
+func (m *keyManager) reload() {
+	if err := m.auth.Reload(); err != nil {
+		m.l.Errorw("failed to reload gateway keys", "err", err)
+	}
+}
+
+func (m *keyManager) roleExists(name string) (bool, error) {
+	if m.auth.IsBuiltInRole(name) {
+		return true, nil
+	}
+	roles, err := m.storage.GetRoles()
+	if err != nil {
+		return false, err
+	}
+	for _, r := range roles {
+		if r.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *keyManager) getKeys(c *gin.Context) {
+	keys, err := m.storage.GetKeys()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	for i := range keys {
+		keys[i].Secret = ""
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(keys))
+}
+
+type createKeyRequest struct {
+	Role        string           `json:"role" binding:"required"`
+	Assets      []rtypes.AssetID `json:"assets"`
+	Description string           `json:"description"`
+	ExpiresAt   *time.Time       `json:"expires_at"`
+}
+
+func (m *keyManager) createKey(c *gin.Context) {
+	var req createKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	exists, err := m.roleExists(req.Role)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if !exists {
+		httputil.ResponseFailure(c, httputil.WithReason("role does not exist"))
+		return
+	}
+	if req.ExpiresAt != nil && req.ExpiresAt.Before(time.Now()) {
+		httputil.ResponseFailure(c, httputil.WithReason("expires_at is in the past"))
+		return
+	}
+	key, err := newKey()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	key.Role = req.Role
+	key.Assets = req.Assets
+	key.Description = req.Description
+	key.ExpiresAt = req.ExpiresAt
+	if err := m.storage.CreateKey(key); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	m.l.Infow("created gateway key", "id", key.ID, "role", key.Role, "assets", key.Assets)
+	m.reload()
+	httputil.ResponseSuccess(c, httputil.WithData(key))
+}
+
+type rotateKeyRequest struct {
+	// GracePeriod is the duration the old key keeps working, e.g 24h.
+	GracePeriod string `json:"grace_period"`
+}
+
+func (m *keyManager) rotateKey(c *gin.Context) {
+	var req rotateKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	gracePeriod := defaultGracePeriod
+	if req.GracePeriod != "" {
+		var err error
+		if gracePeriod, err = time.ParseDuration(req.GracePeriod); err != nil || gracePeriod < 0 {
+			httputil.ResponseFailure(c, httputil.WithReason("invalid grace period"))
+			return
+		}
+	}
+	key, err := newKey()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	id := c.Param("id")
+	key, err = m.storage.RotateKey(id, key, time.Now().Add(gracePeriod))
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	m.l.Infow("rotated gateway key", "id", id, "new_id", key.ID, "grace_period", gracePeriod)
+	m.reload()
+	httputil.ResponseSuccess(c, httputil.WithData(key))
+}
+
+func (m *keyManager) revokeKey(c *gin.Context) {
+	id := c.Param("id")
+	if err := m.storage.RevokeKey(id); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	m.l.Infow("revoked gateway key", "id", id)
+	m.reload()
+	httputil.ResponseSuccess(c)
+}
+
+func (m *keyManager) getRoles(c *gin.Context) {
+	roles, err := m.storage.GetRoles()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(append(m.auth.BuiltInRoles(), roles...)))
+}
+
+func checkRole(role common.Role) error {
+	if strings.TrimSpace(role.Name) == "" {
+		return errors.New("role name is required")
+	}
+	for _, p := range role.Permissions {
+		if !strings.HasPrefix(p.Path, "/") {
+			return errors.Errorf("invalid path %s", p.Path)
+		}
+		if _, err := regexp.Compile(p.Method); err != nil {
+			return errors.Wrapf(err, "invalid method %s", p.Method)
+		}
+	}
+	return nil
+}
+
+func (m *keyManager) updateRole(c *gin.Context) {
+	var role common.Role
+	if err := c.ShouldBindJSON(&role); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if m.auth.IsBuiltInRole(role.Name) {
+		httputil.ResponseFailure(c, httputil.WithReason("built-in role can not be changed"))
+		return
+	}
+	if err := checkRole(role); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	role.BuiltIn = false
+	if err := m.storage.UpdateRole(role); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	m.l.Infow("updated gateway role", "name", role.Name, "permissions", role.Permissions)
+	m.reload()
+	httputil.ResponseSuccess(c)
+}
+
+func (m *keyManager) deleteRole(c *gin.Context) {
+	name := c.Param("name")
+	if m.auth.IsBuiltInRole(name) {
+		httputil.ResponseFailure(c, httputil.WithReason("built-in role can not be deleted"))
+		return
+	}
+	if err := m.storage.DeleteRole(name); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	m.l.Infow("deleted gateway role", "name", name)
+	m.reload()
+	httputil.ResponseSuccess(c)
+}
+
+func (m *keyManager) getAuditLogs(c *gin.Context) {
+	var query struct {
+		FromTime uint64 `form:"fromTime"`
+		ToTime   uint64 `form:"toTime"`
+		KeyID    string `form:"key_id"`
+	}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	toTime := time.Now()
+	if query.ToTime != 0 {
+		toTime = v1common.MillisToTime(query.ToTime)
+	}
+	fromTime := toTime.Add(-defaultAuditLogTime)
+	if query.FromTime != 0 {
+		fromTime = v1common.MillisToTime(query.FromTime)
+	}
+	records, err := m.storage.GetAuditRecords(fromTime, toTime, query.KeyID)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(records))
+}
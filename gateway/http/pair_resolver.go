@@ -0,0 +1,68 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+// SettingPairResolver gets base asset of trading pairs from setting service, results are cached
+// as base asset of a trading pair never changes.
+type SettingPairResolver struct {
+	endpoint string
+	client   *http.Client
+
+	mu    sync.RWMutex
+	bases map[rtypes.TradingPairID]rtypes.AssetID
+}
+
+// NewSettingPairResolver creates a new SettingPairResolver instance.
+func NewSettingPairResolver(settingEndpoint string) *SettingPairResolver {
+	return &SettingPairResolver{
+		endpoint: settingEndpoint,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		bases:    make(map[rtypes.TradingPairID]rtypes.AssetID),
+	}
+}
+
+// GetBaseAsset implements permission.PairResolver.
+func (r *SettingPairResolver) GetBaseAsset(pair rtypes.TradingPairID) (rtypes.AssetID, error) {
+	r.mu.RLock()
+	base, ok := r.bases[pair]
+	r.mu.RUnlock()
+	if ok {
+		return base, nil
+	}
+
+	resp, err := r.client.Get(fmt.Sprintf("%s/v3/trading-pair/%d?including_deleted=true", r.endpoint, pair))
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	var result struct {
+		Success bool   `json:"success"`
+		Reason  string `json:"reason"`
+		Data    struct {
+			Base rtypes.AssetID `json:"base"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+	if !result.Success {
+		return 0, errors.Errorf("failed to get trading pair: %s", result.Reason)
+	}
+
+	r.mu.Lock()
+	r.bases[pair] = result.Data.Base
+	r.mu.Unlock()
+	return result.Data.Base, nil
+}
@@ -1,110 +1,107 @@
 package http
 
 import (
-	"fmt"
-
-	"github.com/KyberNetwork/reserve-data/gateway/permission"
-	"github.com/casbin/casbin"
-	"github.com/gin-gonic/gin"
-	scas "github.com/qiangmzsx/string-adapter"
-
-	"github.com/KyberNetwork/httpsign-utils/authenticator"
+	"github.com/KyberNetwork/reserve-data/gateway/common"
 )
 
-func addKeyReadPolicy(key string) string {
-	return fmt.Sprintf(`
-p, %[1]s, /*, GET`, key)
-}
-
-func addKeyWritePolicy(key string) string {
-	return fmt.Sprintf(`
-p, %[1]s, /*, GET
-p, %[1]s, /v3/setting-change-update-exchange, POST
-p, %[1]s, /v3/setting-change-target, POST
-p, %[1]s, /v3/setting-change-pwis, POST
-p, %[1]s, /v3/setting-change-rbquadratic, POST
-p, %[1]s, /v3/setting-change-main, POST
-p, %[1]s, /v3/setting-change-stable, POST
-p, %[1]s, /v3/setting-change-feed-configuration, POST
-p, %[1]s, /v3/update-feed-status/:name, PUT`, key)
-}
-
-func addKeyConfirmPolicy(key string) string {
-	return fmt.Sprintf(`
-p, %[1]s, /*, GET
-p, %[1]s, /v3/setting-change-update-exchange/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-target/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-pwis/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-rbquadratic/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-main/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-stable/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-feed-configuration/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/hold-rebalance, POST
-p, %[1]s, /v3/enable-rebalance, POST
-p, %[1]s, /v3/hold-set-rate, POST
-p, %[1]s, /v3/gas-threshold, POST
-p, %[1]s, /v3/set-exchange-enabled/:id, PUT
-p, %[1]s, /v3/enable-set-rate, POST
-p, %[1]s, /v3/rate-trigger-period, POST
-p, %[1]s, /v3/gas-source, POST`, key)
-}
-
-func addKeyRebalancePolicy(key string) string {
-	return fmt.Sprintf(`
-p, %[1]s, /*, GET
-p, %[1]s, /v3/price-factor, POST
-p, %[1]s, /v3/cancel-orders, POST
-p, %[1]s, /v3/cancel-all-orders, POST
-p, %[1]s, /v3/deposit, POST
-p, %[1]s, /v3/withdraw, POST
-p, %[1]s, /v3/trade, POST
-p, %[1]s, /v3/cancel-setrates, POST
-p, %[1]s, /transfer-self, POST
-p, %[1]s, /cex-transfer, POST
-p, %[1]s, /v3/setrates, POST`, key)
-}
-
-// NewPermissioner creates a gin Handle Func to controll permission
-// currently there is only 2 permission for POST/GET requests
-func NewPermissioner(readKeys, writeKeys, confirmKeys, rebalanceKeys []authenticator.KeyPair) (gin.HandlerFunc, error) {
-	const (
-		conf = `
-[request_definition]
-r = sub, obj, act
-
-[policy_definition]
-p = sub, obj, act
-
-[role_definition]
-g = _ , _
-
-[policy_effect]
-e = some(where (p.eft == allow))
-
-[matchers]
-m = g(r.sub, p.sub)  && keyMatch2(r.obj, p.obj) && regexMatch(r.act, p.act)
-`
-	)
-	var pol string
-	for _, key := range readKeys {
-		pol += addKeyReadPolicy(key.AccessKeyID)
-	}
-	for _, key := range writeKeys {
-		pol += addKeyWritePolicy(key.AccessKeyID)
-	}
-	for _, key := range confirmKeys {
-		pol += addKeyConfirmPolicy(key.AccessKeyID)
-	}
-	for _, key := range rebalanceKeys {
-		pol += addKeyRebalancePolicy(key.AccessKeyID)
-	}
+// Built-in roles of gateway, keys given by command line flags are bound to these roles. Keys
+// created at runtime can be bound to built-in roles or roles stored in database.
+const (
+	RoleRead      = "read"
+	RoleWrite     = "write"
+	RoleConfirm   = "confirm"
+	RoleRebalance = "rebalance"
+	RoleAdmin     = "admin"
+)
 
-	sa := scas.NewAdapter(pol)
-	e := casbin.NewEnforcer(casbin.NewModel(conf), sa)
-	if err := e.LoadPolicy(); err != nil {
-		return nil, err
+// BuiltInRoles returns the permissions of built-in roles.
+func BuiltInRoles() []common.Role {
+	return []common.Role{
+		{
+			Name:    RoleRead,
+			BuiltIn: true,
+			Permissions: []common.Permission{
+				{Path: "/*", Method: "GET"},
+			},
+		},
+		{
+			Name:    RoleWrite,
+			BuiltIn: true,
+			Permissions: []common.Permission{
+				{Path: "/*", Method: "GET"},
+				{Path: "/v3/setting-change-update-exchange", Method: "POST"},
+				{Path: "/v3/setting-change-target", Method: "POST"},
+				{Path: "/v3/setting-change-pwis", Method: "POST"},
+				{Path: "/v3/setting-change-rbquadratic", Method: "POST"},
+				{Path: "/v3/setting-change-main", Method: "POST"},
+				{Path: "/v3/setting-change-stable", Method: "POST"},
+				{Path: "/v3/setting-change-feed-configuration", Method: "POST"},
+				{Path: "/v3/setting-change-exchange-info", Method: "POST"},
+				{Path: "/v3/setting-change-risk-limits", Method: "POST"},
+				{Path: "/v3/setting-change-address-allowlist", Method: "POST"},
+				{Path: "/v3/setting-change-pricing-contract", Method: "POST"},
+				{Path: "/v3/setting-change-reserve-admin", Method: "POST"},
+				{Path: "/v3/update-feed-status/:name", Method: "PUT"},
+			},
+		},
+		{
+			Name:    RoleConfirm,
+			BuiltIn: true,
+			Permissions: []common.Permission{
+				{Path: "/*", Method: "GET"},
+				{Path: "/v3/setting-change-update-exchange/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-target/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-pwis/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-rbquadratic/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-main/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-stable/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-feed-configuration/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-exchange-info/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-risk-limits/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-address-allowlist/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-pricing-contract/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/setting-change-reserve-admin/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/kill-switch", Method: "POST"},
+				{Path: "/v3/hold-rebalance", Method: "POST"},
+				{Path: "/v3/enable-rebalance", Method: "POST"},
+				{Path: "/v3/hold-set-rate", Method: "POST"},
+				{Path: "/v3/gas-threshold", Method: "POST"},
+				{Path: "/v3/set-exchange-enabled/:id", Method: "PUT"},
+				{Path: "/v3/enable-set-rate", Method: "POST"},
+				{Path: "/v3/rate-trigger-period", Method: "POST"},
+				{Path: "/v3/gas-source", Method: "POST"},
+				{Path: "/v3/set-rate-gas-policy", Method: "POST"},
+			},
+		},
+		{
+			Name:    RoleRebalance,
+			BuiltIn: true,
+			Permissions: []common.Permission{
+				{Path: "/*", Method: "GET"},
+				{Path: "/v3/price-factor", Method: "POST"},
+				{Path: "/v3/cancel-orders", Method: "POST"},
+				{Path: "/v3/cancel-all-orders", Method: "POST"},
+				{Path: "/v3/deposit", Method: "POST"},
+				{Path: "/v3/withdraw", Method: "POST"},
+				{Path: "/v3/trade", Method: "POST"},
+				{Path: "/v3/cancel-setrates", Method: "POST"},
+				{Path: "/transfer-self", Method: "POST"},
+				{Path: "/cex-transfer", Method: "POST"},
+				{Path: "/v3/setrates", Method: "POST"},
+			},
+		},
+		{
+			Name:    RoleAdmin,
+			BuiltIn: true,
+			Permissions: []common.Permission{
+				{Path: "/*", Method: "GET"},
+				{Path: "/v3/gateway/*", Method: "(GET)|(POST)|(PUT)|(DELETE)"},
+				{Path: "/v3/webhook", Method: "POST"},
+				{Path: "/v3/webhook/:id", Method: "(PUT)|(DELETE)"},
+				{Path: "/v3/webhook-dead-letter/:id/redeliver", Method: "POST"},
+				{Path: "/v3/operator-rotations", Method: "POST"},
+				{Path: "/v3/operator-rotations/:id/resume", Method: "POST"},
+			},
+		},
 	}
-
-	p := permission.NewPermissioner(e)
-	return p, nil
 }
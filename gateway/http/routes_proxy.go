@@ -17,11 +17,14 @@ func WithCoreEndpoint(coreEndpoint string) Option {
 		g.GET("/prices/:base/:quote", coreProxyMW)
 		g.GET("/getrates", coreProxyMW)
 		g.GET("/get-all-rates", coreProxyMW)
+		g.GET("/price-history", coreProxyMW)
 
 		g.GET("/authdata-version", coreProxyMW)
 		g.GET("/authdata", coreProxyMW)
 		g.GET("/activities", coreProxyMW)
 		g.GET("/immediate-pending-activities", coreProxyMW)
+		g.GET("/balance-history", coreProxyMW)
+		g.GET("/balance-reconciliation", coreProxyMW)
 
 		g.GET("/open-orders", coreProxyMW)
 		g.POST("/cancel-orders", coreProxyMW)
@@ -31,6 +34,13 @@ func WithCoreEndpoint(coreEndpoint string) Option {
 		g.POST("/trade", coreProxyMW)
 		g.POST("/setrates", coreProxyMW)
 		g.POST("/cancel-setrates", coreProxyMW)
+		g.GET("/gas-price", coreProxyMW)
+		g.GET("/node-health", coreProxyMW)
+		g.GET("/pricing-contract/token-params", coreProxyMW)
+		g.GET("/operator-rotations", coreProxyMW)
+		g.POST("/operator-rotations", coreProxyMW)
+		g.POST("/operator-rotations/:id/resume", coreProxyMW)
+		g.GET("/security-findings", coreProxyMW)
 		g.GET("/tradehistory", coreProxyMW)
 
 		g.GET("/timeserver", coreProxyMW)
@@ -110,6 +120,50 @@ func WithSettingEndpoint(settingEndpoint string) Option {
 		g.DELETE("/setting-change-feed-configuration/:id", settingProxyMW)
 		g.PUT("/update-feed-status/:name", settingProxyMW)
 
+		g.POST("/setting-change-exchange-info", settingProxyMW)
+		g.GET("/setting-change-exchange-info", settingProxyMW)
+		g.GET("/setting-change-exchange-info/:id", settingProxyMW)
+		g.PUT("/setting-change-exchange-info/:id", settingProxyMW)
+		g.DELETE("/setting-change-exchange-info/:id", settingProxyMW)
+
+		g.POST("/setting-change-risk-limits", settingProxyMW)
+		g.GET("/setting-change-risk-limits", settingProxyMW)
+		g.GET("/setting-change-risk-limits/:id", settingProxyMW)
+		g.PUT("/setting-change-risk-limits/:id", settingProxyMW)
+		g.DELETE("/setting-change-risk-limits/:id", settingProxyMW)
+		g.GET("/risk-limits", settingProxyMW)
+		g.GET("/kill-switch", settingProxyMW)
+
+		g.POST("/setting-change-address-allowlist", settingProxyMW)
+		g.GET("/setting-change-address-allowlist", settingProxyMW)
+		g.GET("/setting-change-address-allowlist/:id", settingProxyMW)
+		g.PUT("/setting-change-address-allowlist/:id", settingProxyMW)
+		g.DELETE("/setting-change-address-allowlist/:id", settingProxyMW)
+		g.GET("/address-allowlist", settingProxyMW)
+
+		g.POST("/setting-change-pricing-contract", settingProxyMW)
+		g.GET("/setting-change-pricing-contract", settingProxyMW)
+		g.GET("/setting-change-pricing-contract/:id", settingProxyMW)
+		g.PUT("/setting-change-pricing-contract/:id", settingProxyMW)
+		g.DELETE("/setting-change-pricing-contract/:id", settingProxyMW)
+
+		g.POST("/setting-change-reserve-admin", settingProxyMW)
+		g.GET("/setting-change-reserve-admin", settingProxyMW)
+		g.GET("/setting-change-reserve-admin/:id", settingProxyMW)
+		g.PUT("/setting-change-reserve-admin/:id", settingProxyMW)
+		g.DELETE("/setting-change-reserve-admin/:id", settingProxyMW)
+
+		g.POST("/kill-switch", settingProxyMW)
+
+		g.POST("/webhook", settingProxyMW)
+		g.GET("/webhook", settingProxyMW)
+		g.GET("/webhook/:id", settingProxyMW)
+		g.PUT("/webhook/:id", settingProxyMW)
+		g.DELETE("/webhook/:id", settingProxyMW)
+		g.GET("/webhook-delivery", settingProxyMW)
+		g.GET("/webhook-dead-letter", settingProxyMW)
+		g.POST("/webhook-dead-letter/:id/redeliver", settingProxyMW)
+
 		g.GET("/rebalance-status", settingProxyMW)
 		g.POST("/hold-rebalance", settingProxyMW)
 		g.POST("/enable-rebalance", settingProxyMW)
@@ -129,6 +183,8 @@ func WithSettingEndpoint(settingEndpoint string) Option {
 
 		g.GET("/gas-source", settingProxyMW)
 		g.POST("/gas-source", settingProxyMW)
+		g.GET("/set-rate-gas-policy", settingProxyMW)
+		g.POST("/set-rate-gas-policy", settingProxyMW)
 
 		return nil
 	}
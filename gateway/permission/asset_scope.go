@@ -0,0 +1,85 @@
+package permission
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/gateway/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+// ErrAssetNotPermit is returned when key with asset scope makes a change to other asset, or to
+// no specific asset.
+var ErrAssetNotPermit = errors.New("you don't have permission for the requested asset")
+
+// PairResolver returns the base asset of a trading pair.
+type PairResolver interface {
+	GetBaseAsset(pair rtypes.TradingPairID) (rtypes.AssetID, error)
+}
+
+// assetRequest contains the fields that refer to an asset in core mutating requests:
+// asset in deposit, withdraw and cex-transfer, pair in trade, rates in setrates.
+type assetRequest struct {
+	Asset *rtypes.AssetID       `json:"asset"`
+	Pair  *rtypes.TradingPairID `json:"pair"`
+	Rates []struct {
+		AssetID rtypes.AssetID `json:"asset_id"`
+	} `json:"rates"`
+}
+
+// checkAssetScope checks that a mutating request of key with asset scope only makes change to
+// assets in scope. Read requests are not limited.
+func (a *Authorizer) checkAssetScope(key common.Key, r *http.Request) error {
+	if len(key.Assets) == 0 || r.Method == http.MethodGet {
+		return nil
+	}
+	assets, err := a.requestAssets(r)
+	if err != nil {
+		return err
+	}
+	if len(assets) == 0 {
+		return ErrAssetNotPermit
+	}
+	for _, asset := range assets {
+		if !key.AllowAsset(asset) {
+			return ErrAssetNotPermit
+		}
+	}
+	return nil
+}
+
+func (a *Authorizer) requestAssets(r *http.Request) ([]rtypes.AssetID, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+	var req assetRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		// not an asset request
+		return nil, nil
+	}
+	var assets []rtypes.AssetID
+	if req.Asset != nil {
+		assets = append(assets, *req.Asset)
+	}
+	if req.Pair != nil {
+		base, err := a.resolver.GetBaseAsset(*req.Pair)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get base asset of trading pair %d", *req.Pair)
+		}
+		assets = append(assets, base)
+	}
+	for _, rate := range req.Rates {
+		assets = append(assets, rate.AssetID)
+	}
+	return assets, nil
+}
@@ -4,16 +4,45 @@ import (
 	"errors"
 	"net/http"
 	"regexp"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/KyberNetwork/httpsign-utils/authenticator"
 	"github.com/casbin/casbin"
+	"github.com/casbin/casbin/util"
+	"github.com/gin-contrib/httpsign"
+	"github.com/gin-contrib/httpsign/crypto"
+	"github.com/gin-contrib/httpsign/validator"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/gateway/common"
+	"github.com/KyberNetwork/reserve-data/gateway/storage"
 )
 
 const (
 	authorizationHeader = "Authorization"
 	signatureHeader     = "Signature"
 	keyIDHeader         = "keyId"
+
+	// model binds keys to roles with g and grants roles access to routes with p.
+	model = `
+[request_definition]
+r = sub, obj, act
+
+[policy_definition]
+p = sub, obj, act
+
+[role_definition]
+g = _ , _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = g(r.sub, p.sub)  && keyMatch2(r.obj, p.obj) && regexMatch(r.act, p.act)
+`
 )
 
 var (
@@ -28,33 +57,195 @@ var (
 // KeyID is the abstract key needed for authentication
 type KeyID string
 
-// Permissioner is struct for control permission
-type Permissioner struct {
+// Authorizer authenticates requests signed with static keys given at start up or keys stored
+// in database and checks whether the role of the key permits the request.
+type Authorizer struct {
+	storage      storage.Interface
+	builtInRoles []common.Role
+	staticKeys   []common.Key
+	resolver     PairResolver
+	l            *zap.SugaredLogger
+
+	mu       sync.RWMutex
+	keys     map[KeyID]common.Key
+	roles    map[string][]common.Permission
+	auth     *httpsign.Authenticator
 	enforcer *casbin.Enforcer
 }
 
-// NewPermissioner return a gin HandleFunc middleware
-func NewPermissioner(e *casbin.Enforcer) gin.HandlerFunc {
-	p := &Permissioner{enforcer: e}
+// NewAuthorizer creates a new Authorizer and loads keys, roles from storage.
+func NewAuthorizer(storage storage.Interface, builtInRoles []common.Role, staticKeys []common.Key, resolver PairResolver) (*Authorizer, error) {
+	a := &Authorizer{
+		storage:      storage,
+		builtInRoles: builtInRoles,
+		staticKeys:   staticKeys,
+		resolver:     resolver,
+		l:            zap.S(),
+	}
+	if err := a.Reload(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// BuiltInRoles returns the roles defined in code, they can not be changed at runtime.
+func (a *Authorizer) BuiltInRoles() []common.Role {
+	return a.builtInRoles
+}
+
+// IsBuiltInRole returns true if name is a built-in role.
+func (a *Authorizer) IsBuiltInRole(name string) bool {
+	for _, r := range a.builtInRoles {
+		if r.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Reload rebuilds authenticator and permission policies from static keys and the keys, roles
+// currently stored in database.
+func (a *Authorizer) Reload() error {
+	storedKeys, err := a.storage.GetKeys()
+	if err != nil {
+		return err
+	}
+	storedRoles, err := a.storage.GetRoles()
+	if err != nil {
+		return err
+	}
+
+	e := casbin.NewEnforcer(casbin.NewModel(model))
+	e.EnableAutoBuildRoleLinks(false)
+	roles := make(map[string][]common.Permission)
+	for _, r := range append(storedRoles, a.builtInRoles...) {
+		roles[r.Name] = r.Permissions
+		for _, p := range r.Permissions {
+			e.AddPolicy(r.Name, p.Path, p.Method)
+		}
+	}
+
+	var (
+		now     = time.Now()
+		keys    = make(map[KeyID]common.Key)
+		secrets = make(httpsign.Secrets)
+	)
+	for _, k := range append(storedKeys, a.staticKeys...) {
+		if !k.Active(now) {
+			continue
+		}
+		keys[KeyID(k.ID)] = k
+		secrets[httpsign.KeyID(k.ID)] = &httpsign.Secret{
+			Key:       k.Secret,
+			Algorithm: &crypto.HmacSha512{},
+		}
+		e.AddGroupingPolicy(k.ID, k.Role)
+	}
+	e.BuildRoleLinks()
+
+	auth := httpsign.NewAuthenticator(
+		secrets,
+		httpsign.WithValidator(
+			authenticator.NewNonceValidator(),
+			validator.NewDigestValidator(),
+		),
+		httpsign.WithRequiredHeaders(
+			[]string{"(request-target)", "nonce", "digest"},
+		),
+	)
+
+	a.mu.Lock()
+	a.keys = keys
+	a.roles = roles
+	a.auth = auth
+	a.enforcer = e
+	a.mu.Unlock()
+	return nil
+}
+
+// Run reloads keys and roles at every interval, so changes made by other gateway instances and
+// key expiries take effect. It never returns.
+func (a *Authorizer) Run(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for range t.C {
+		if err := a.Reload(); err != nil {
+			a.l.Errorw("failed to reload gateway keys", "err", err)
+		}
+	}
+}
+
+// Authenticated returns a gin middleware which verifies request signature.
+func (a *Authorizer) Authenticated() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		if !p.checkPermission(c.Request) {
-			err := ErrNotPermit
+		a.mu.RLock()
+		auth := a.auth
+		a.mu.RUnlock()
+		auth.Authenticated()(c)
+	}
+}
+
+// Permission returns a gin middleware which checks if the key of request is allowed to call
+// the route and, for key with asset scope, to make change to the requested assets.
+func (a *Authorizer) Permission() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := a.checkPermission(c.Request); err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"reason": err.Error()})
-			zap.S().Errorw("Abort with error get error", "err", err)
+			a.l.Errorw("Abort with error get error", "err", err)
+			return
+		}
+	}
+}
+
+func (a *Authorizer) checkPermission(r *http.Request) error {
+	keyID, err := GetKeyID(r)
+	if err != nil {
+		return err
+	}
+	a.mu.RLock()
+	key, ok := a.keys[keyID]
+	e := a.enforcer
+	a.mu.RUnlock()
+	if !ok || !key.Active(time.Now()) {
+		return ErrNotPermit
+	}
+	if !e.Enforce(string(keyID), r.URL.Path, r.Method) {
+		return ErrNotPermit
+	}
+	return a.checkAssetScope(key, r)
+}
+
+// Restricted returns a gin middleware which only permits the request if role of the key has a
+// permission with path under prefix that matches the request, wildcard permissions of other paths
+// like /* are not enough.
+func (a *Authorizer) Restricted(prefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !a.hasExplicitPermission(c.Request, prefix) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"reason": ErrNotPermit.Error()})
 			return
 		}
 	}
 }
 
-// checkPermission return a gin middleware which check if a request is authorize to continue or not
-func (p *Permissioner) checkPermission(r *http.Request) bool {
-	keyID, err := getKeyID(r)
+func (a *Authorizer) hasExplicitPermission(r *http.Request, prefix string) bool {
+	keyID, err := GetKeyID(r)
 	if err != nil {
 		return false
 	}
-	method := r.Method
-	path := r.URL.Path
-	return p.enforcer.Enforce(string(keyID), path, method)
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	key, ok := a.keys[keyID]
+	if !ok {
+		return false
+	}
+	for _, p := range a.roles[key.Role] {
+		if strings.HasPrefix(p.Path, prefix) &&
+			util.KeyMatch2(r.URL.Path, p.Path) &&
+			util.RegexMatch(r.Method, p.Method) {
+			return true
+		}
+	}
+	return false
 }
 
 func extractKeyID(s string) (KeyID, error) {
@@ -71,7 +262,8 @@ func extractKeyID(s string) (KeyID, error) {
 	return KeyID(""), ErrCouldNotGetKeyID
 }
 
-func getKeyID(r *http.Request) (KeyID, error) {
+// GetKeyID returns the key id of signed request.
+func GetKeyID(r *http.Request) (KeyID, error) {
 	if s := r.Header.Get(authorizationHeader); len(s) > 0 {
 		return extractKeyID(s)
 	}
@@ -1,10 +1,19 @@
 package permission
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/gateway/common"
+	"github.com/KyberNetwork/reserve-data/gateway/storage"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
 )
 
 func TestExtractKeyID(t *testing.T) {
@@ -36,3 +45,79 @@ func TestExtractKeyID(t *testing.T) {
 		assert.Equal(t, tc.keyID, string(actualKeyID))
 	}
 }
+
+type mockStorage struct {
+	storage.Interface
+	keys  []common.Key
+	roles []common.Role
+}
+
+func (s *mockStorage) GetKeys() ([]common.Key, error) {
+	return s.keys, nil
+}
+
+func (s *mockStorage) GetRoles() ([]common.Role, error) {
+	return s.roles, nil
+}
+
+type mockPairResolver map[rtypes.TradingPairID]rtypes.AssetID
+
+func (r mockPairResolver) GetBaseAsset(pair rtypes.TradingPairID) (rtypes.AssetID, error) {
+	return r[pair], nil
+}
+
+func newSignedRequest(keyID, method, path, body string) *http.Request {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	r.Header.Set(authorizationHeader, fmt.Sprintf(`Signature keyId="%s",algorithm="hmac-sha512"`, keyID))
+	return r
+}
+
+func TestAuthorizer_CheckPermission(t *testing.T) {
+	expired := time.Now().Add(-time.Minute)
+	st := &mockStorage{
+		keys: []common.Key{
+			{ID: "trader", Secret: "s", Role: "trader", Assets: []rtypes.AssetID{2}},
+			{ID: "expired", Secret: "s", Role: "trader", ExpiresAt: &expired},
+		},
+		roles: []common.Role{
+			{Name: "trader", Permissions: []common.Permission{
+				{Path: "/*", Method: "GET"},
+				{Path: "/v3/trade", Method: "POST"},
+				{Path: "/v3/withdraw", Method: "POST"},
+			}},
+		},
+	}
+	builtIn := []common.Role{
+		{Name: "admin", BuiltIn: true, Permissions: []common.Permission{
+			{Path: "/*", Method: "GET"},
+			{Path: "/v3/gateway/*", Method: "(GET)|(POST)"},
+		}},
+	}
+	a, err := NewAuthorizer(st, builtIn, []common.Key{{ID: "admin", Secret: "s", Role: "admin"}},
+		mockPairResolver{1: 2, 3: 4})
+	require.NoError(t, err)
+
+	var tests = []struct {
+		keyID, method, path, body string
+		err                       error
+	}{
+		{"trader", http.MethodGet, "/v3/prices", "", nil},
+		{"trader", http.MethodPost, "/v3/trade", `{"pair":1}`, nil},
+		{"trader", http.MethodPost, "/v3/trade", `{"pair":3}`, ErrAssetNotPermit},
+		{"trader", http.MethodPost, "/v3/withdraw", `{"asset":2}`, nil},
+		{"trader", http.MethodPost, "/v3/withdraw", `{"asset":4}`, ErrAssetNotPermit},
+		{"trader", http.MethodPost, "/v3/withdraw", `{}`, ErrAssetNotPermit},
+		{"trader", http.MethodPost, "/v3/deposit", `{"asset":2}`, ErrNotPermit},
+		{"expired", http.MethodGet, "/v3/prices", "", ErrNotPermit},
+		{"unknown", http.MethodGet, "/v3/prices", "", ErrNotPermit},
+		{"admin", http.MethodPost, "/v3/gateway/keys", `{}`, nil},
+		{"admin", http.MethodPost, "/v3/trade", `{"pair":1}`, ErrNotPermit},
+	}
+	for _, tc := range tests {
+		err := a.checkPermission(newSignedRequest(tc.keyID, tc.method, tc.path, tc.body))
+		assert.Equal(t, tc.err, err, "%s %s %s", tc.keyID, tc.method, tc.path)
+	}
+
+	assert.True(t, a.hasExplicitPermission(newSignedRequest("admin", http.MethodGet, "/v3/gateway/keys", ""), "/v3/gateway"))
+	assert.False(t, a.hasExplicitPermission(newSignedRequest("trader", http.MethodGet, "/v3/gateway/keys", ""), "/v3/gateway"))
+}
@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/KyberNetwork/reserve-data/gateway/common"
+)
+
+// Interface is the persistent storage of gateway keys, roles and audit log.
+type Interface interface {
+	CreateKey(key common.Key) error
+	GetKey(id string) (common.Key, error)
+	GetKeys() ([]common.Key, error)
+	// RevokeKey marks the key as revoked, a revoked key can not be used anymore.
+	RevokeKey(id string) error
+	// RotateKey creates newKey with the same role and asset scope of the key with given id,
+	// the old key keeps working until expiresAt.
+	RotateKey(id string, newKey common.Key, expiresAt time.Time) (common.Key, error)
+
+	// UpdateRole creates a role or replaces permissions of existing role.
+	UpdateRole(role common.Role) error
+	GetRoles() ([]common.Role, error)
+	DeleteRole(name string) error
+
+	// AddAuditRecord appends a record to audit log, records are never updated or deleted.
+	AddAuditRecord(record common.AuditRecord) error
+	GetAuditRecords(fromTime, toTime time.Time, keyID string) ([]common.AuditRecord, error)
+}
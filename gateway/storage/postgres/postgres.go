@@ -0,0 +1,370 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/common/postgres"
+	"github.com/KyberNetwork/reserve-data/gateway/common"
+	"github.com/KyberNetwork/reserve-data/gateway/storage"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+const uniqueViolation = "unique_violation"
+
+// Storage is an implementation of storage.Interface that use PostgreSQL as database system.
+type Storage struct {
+	db    *sqlx.DB
+	stmts preparedStmts
+}
+
+type preparedStmts struct {
+	newKey         *sqlx.NamedStmt
+	getKey         *sqlx.Stmt
+	getKeys        *sqlx.Stmt
+	revokeKey      *sqlx.Stmt
+	expireKey      *sqlx.Stmt
+	newRole        *sqlx.Stmt
+	deletePerms    *sqlx.Stmt
+	newPermission  *sqlx.Stmt
+	getPermissions *sqlx.Stmt
+	countRoleKeys  *sqlx.Stmt
+	deleteRole     *sqlx.Stmt
+
+	newAuditRecord  *sqlx.NamedStmt
+	getAuditRecords *sqlx.Stmt
+}
+
+// NewStorage creates a new Storage instance.
+func NewStorage(db *sqlx.DB) (storage.Interface, error) {
+	s := &Storage{db: db}
+	if err := s.initStmts(); err != nil {
+		return nil, errors.Wrap(err, "failed to prepare statements")
+	}
+	return s, nil
+}
+
+func (s *Storage) initStmts() error {
+	var err error
+	s.stmts.newKey, err = s.db.PrepareNamed(`INSERT INTO "gateway_keys"
+		(id, secret, role, assets, description, created, expires_at)
+		VALUES (:id, :secret, :role, :assets, :description, :created, :expires_at)`)
+	if err != nil {
+		return err
+	}
+	const selectKeys = `SELECT id, secret, role, assets, description, created, expires_at, revoked_at, rotated_to
+		FROM "gateway_keys"`
+	s.stmts.getKey, err = s.db.Preparex(selectKeys + ` WHERE id = $1`)
+	if err != nil {
+		return err
+	}
+	s.stmts.getKeys, err = s.db.Preparex(selectKeys + ` ORDER BY created`)
+	if err != nil {
+		return err
+	}
+	s.stmts.revokeKey, err = s.db.Preparex(`UPDATE "gateway_keys" SET revoked_at = now()
+		WHERE id = $1 AND revoked_at IS NULL RETURNING id`)
+	if err != nil {
+		return err
+	}
+	s.stmts.expireKey, err = s.db.Preparex(`UPDATE "gateway_keys" SET expires_at = $2, rotated_to = $3
+		WHERE id = $1 RETURNING id`)
+	if err != nil {
+		return err
+	}
+	s.stmts.newRole, err = s.db.Preparex(`INSERT INTO "gateway_roles" (name) VALUES ($1)
+		ON CONFLICT (name) DO NOTHING`)
+	if err != nil {
+		return err
+	}
+	s.stmts.deletePerms, err = s.db.Preparex(`DELETE FROM "gateway_role_permissions" WHERE role = $1`)
+	if err != nil {
+		return err
+	}
+	s.stmts.newPermission, err = s.db.Preparex(`INSERT INTO "gateway_role_permissions" (role, path, method)
+		VALUES ($1, $2, $3) ON CONFLICT (role, path, method) DO NOTHING`)
+	if err != nil {
+		return err
+	}
+	s.stmts.getPermissions, err = s.db.Preparex(`SELECT r.name AS role, p.path, p.method
+		FROM "gateway_roles" AS r LEFT JOIN "gateway_role_permissions" AS p ON r.name = p.role
+		ORDER BY r.name, p.id`)
+	if err != nil {
+		return err
+	}
+	s.stmts.countRoleKeys, err = s.db.Preparex(`SELECT count(*) FROM "gateway_keys"
+		WHERE role = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > now())`)
+	if err != nil {
+		return err
+	}
+	s.stmts.deleteRole, err = s.db.Preparex(`DELETE FROM "gateway_roles" WHERE name = $1 RETURNING name`)
+	if err != nil {
+		return err
+	}
+	s.stmts.newAuditRecord, err = s.db.PrepareNamed(`INSERT INTO "gateway_audit_log"
+		(time, key_id, method, route, body_hash, status, result)
+		VALUES (:time, :key_id, :method, :route, :body_hash, :status, :result)`)
+	if err != nil {
+		return err
+	}
+	s.stmts.getAuditRecords, err = s.db.Preparex(`SELECT id, time, key_id, method, route, body_hash, status, result
+		FROM "gateway_audit_log"
+		WHERE time >= $1 AND time <= $2 AND ($3::TEXT = '' OR key_id = $3)
+		ORDER BY id`)
+	return err
+}
+
+type keyDB struct {
+	ID          string         `db:"id"`
+	Secret      string         `db:"secret"`
+	Role        string         `db:"role"`
+	Assets      pq.Int64Array  `db:"assets"`
+	Description string         `db:"description"`
+	Created     time.Time      `db:"created"`
+	ExpiresAt   pq.NullTime    `db:"expires_at"`
+	RevokedAt   pq.NullTime    `db:"revoked_at"`
+	RotatedTo   sql.NullString `db:"rotated_to"`
+}
+
+func newKeyDB(k common.Key) keyDB {
+	r := keyDB{
+		ID:          k.ID,
+		Secret:      k.Secret,
+		Role:        k.Role,
+		Assets:      pq.Int64Array{},
+		Description: k.Description,
+		Created:     k.Created,
+	}
+	for _, a := range k.Assets {
+		r.Assets = append(r.Assets, int64(a))
+	}
+	if k.ExpiresAt != nil {
+		r.ExpiresAt = pq.NullTime{Time: *k.ExpiresAt, Valid: true}
+	}
+	return r
+}
+
+func (k keyDB) ToCommon() common.Key {
+	r := common.Key{
+		ID:          k.ID,
+		Secret:      k.Secret,
+		Role:        k.Role,
+		Description: k.Description,
+		Created:     k.Created,
+	}
+	for _, a := range k.Assets {
+		r.Assets = append(r.Assets, rtypes.AssetID(a))
+	}
+	if k.ExpiresAt.Valid {
+		r.ExpiresAt = &k.ExpiresAt.Time
+	}
+	if k.RevokedAt.Valid {
+		r.RevokedAt = &k.RevokedAt.Time
+	}
+	if k.RotatedTo.Valid {
+		r.RotatedTo = &k.RotatedTo.String
+	}
+	return r
+}
+
+// CreateKey stores a new key.
+func (s *Storage) CreateKey(key common.Key) error {
+	return s.createKey(s.stmts.newKey, key)
+}
+
+func (s *Storage) createKey(stmt *sqlx.NamedStmt, key common.Key) error {
+	if _, err := stmt.Exec(newKeyDB(key)); err != nil {
+		if pErr, ok := err.(*pq.Error); ok && pErr.Code.Name() == uniqueViolation {
+			return common.ErrKeyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// GetKey returns the key with given id.
+func (s *Storage) GetKey(id string) (common.Key, error) {
+	var k keyDB
+	if err := s.stmts.getKey.Get(&k, id); err != nil {
+		if err == sql.ErrNoRows {
+			return common.Key{}, common.ErrNotFound
+		}
+		return common.Key{}, err
+	}
+	return k.ToCommon(), nil
+}
+
+// GetKeys returns all keys, including the revoked and expired ones.
+func (s *Storage) GetKeys() ([]common.Key, error) {
+	var keys []keyDB
+	if err := s.stmts.getKeys.Select(&keys); err != nil {
+		return nil, err
+	}
+	result := make([]common.Key, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, k.ToCommon())
+	}
+	return result, nil
+}
+
+// RevokeKey revokes the key with given id.
+func (s *Storage) RevokeKey(id string) error {
+	var revoked string
+	if err := s.stmts.revokeKey.Get(&revoked, id); err != nil {
+		if err == sql.ErrNoRows {
+			return common.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// RotateKey creates newKey with role and asset scope of key id and sets expiry of the old key.
+func (s *Storage) RotateKey(id string, newKey common.Key, expiresAt time.Time) (common.Key, error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return common.Key{}, err
+	}
+	defer postgres.RollbackUnlessCommitted(tx)
+
+	var old keyDB
+	if err := tx.Stmtx(s.stmts.getKey).Get(&old, id); err != nil {
+		if err == sql.ErrNoRows {
+			return common.Key{}, common.ErrNotFound
+		}
+		return common.Key{}, err
+	}
+	oldKey := old.ToCommon()
+	if !oldKey.Active(time.Now()) || oldKey.RotatedTo != nil {
+		return common.Key{}, common.ErrKeyInactive
+	}
+	newKey.Role = oldKey.Role
+	newKey.Assets = oldKey.Assets
+	newKey.Description = oldKey.Description
+	if err := s.createKey(tx.NamedStmt(s.stmts.newKey), newKey); err != nil {
+		return common.Key{}, err
+	}
+	if oldKey.ExpiresAt != nil && oldKey.ExpiresAt.Before(expiresAt) {
+		expiresAt = *oldKey.ExpiresAt
+	}
+	var updated string
+	if err := tx.Stmtx(s.stmts.expireKey).Get(&updated, id, expiresAt, newKey.ID); err != nil {
+		return common.Key{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return common.Key{}, err
+	}
+	return newKey, nil
+}
+
+// UpdateRole creates the role if not exists and replaces its permissions.
+func (s *Storage) UpdateRole(role common.Role) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer postgres.RollbackUnlessCommitted(tx)
+
+	if _, err := tx.Stmtx(s.stmts.newRole).Exec(role.Name); err != nil {
+		return err
+	}
+	if _, err := tx.Stmtx(s.stmts.deletePerms).Exec(role.Name); err != nil {
+		return err
+	}
+	for _, p := range role.Permissions {
+		if _, err := tx.Stmtx(s.stmts.newPermission).Exec(role.Name, p.Path, p.Method); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetRoles returns all roles stored in database.
+func (s *Storage) GetRoles() ([]common.Role, error) {
+	var records []struct {
+		Role   string         `db:"role"`
+		Path   sql.NullString `db:"path"`
+		Method sql.NullString `db:"method"`
+	}
+	if err := s.stmts.getPermissions.Select(&records); err != nil {
+		return nil, err
+	}
+	var result []common.Role
+	for _, r := range records {
+		if len(result) == 0 || result[len(result)-1].Name != r.Role {
+			result = append(result, common.Role{Name: r.Role, Permissions: []common.Permission{}})
+		}
+		if r.Path.Valid {
+			last := &result[len(result)-1]
+			last.Permissions = append(last.Permissions, common.Permission{Path: r.Path.String, Method: r.Method.String})
+		}
+	}
+	return result, nil
+}
+
+// DeleteRole deletes a role, it fails if the role is still used by an active key.
+func (s *Storage) DeleteRole(name string) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer postgres.RollbackUnlessCommitted(tx)
+
+	var count int
+	if err := tx.Stmtx(s.stmts.countRoleKeys).Get(&count, name); err != nil {
+		return err
+	}
+	if count != 0 {
+		return common.ErrRoleInUse
+	}
+	var deleted string
+	if err := tx.Stmtx(s.stmts.deleteRole).Get(&deleted, name); err != nil {
+		if err == sql.ErrNoRows {
+			return common.ErrNotFound
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+type auditRecordDB struct {
+	ID       uint64    `db:"id"`
+	Time     time.Time `db:"time"`
+	KeyID    string    `db:"key_id"`
+	Method   string    `db:"method"`
+	Route    string    `db:"route"`
+	BodyHash string    `db:"body_hash"`
+	Status   int       `db:"status"`
+	Result   string    `db:"result"`
+}
+
+// AddAuditRecord appends a record to audit log.
+func (s *Storage) AddAuditRecord(record common.AuditRecord) error {
+	_, err := s.stmts.newAuditRecord.Exec(auditRecordDB{
+		Time:     record.Time,
+		KeyID:    record.KeyID,
+		Method:   record.Method,
+		Route:    record.Route,
+		BodyHash: record.BodyHash,
+		Status:   record.Status,
+		Result:   record.Result,
+	})
+	return err
+}
+
+// GetAuditRecords returns audit records in given time range, filtered by key id if it is not empty.
+func (s *Storage) GetAuditRecords(fromTime, toTime time.Time, keyID string) ([]common.AuditRecord, error) {
+	var records []auditRecordDB
+	if err := s.stmts.getAuditRecords.Select(&records, fromTime, toTime, keyID); err != nil {
+		return nil, err
+	}
+	result := make([]common.AuditRecord, 0, len(records))
+	for _, r := range records {
+		result = append(result, common.AuditRecord(r))
+	}
+	return result, nil
+}
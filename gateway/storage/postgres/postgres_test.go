@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+	"github.com/KyberNetwork/reserve-data/gateway/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+const migrationPath = "../../../cmd/migrations"
+
+func TestStorage_Keys(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		assert.NoError(t, tearDown())
+	}()
+	s, err := NewStorage(db)
+	require.NoError(t, err)
+
+	key := common.Key{
+		ID:      "key1",
+		Secret:  "secret1",
+		Role:    "rebalance",
+		Assets:  []rtypes.AssetID{1, 2},
+		Created: time.Now().Truncate(time.Millisecond),
+	}
+	require.NoError(t, s.CreateKey(key))
+	require.Equal(t, common.ErrKeyExists, s.CreateKey(key))
+
+	stored, err := s.GetKey(key.ID)
+	require.NoError(t, err)
+	require.Equal(t, key.Assets, stored.Assets)
+	require.True(t, stored.Active(time.Now()))
+
+	expiresAt := time.Now().Add(time.Hour)
+	rotated, err := s.RotateKey(key.ID, common.Key{ID: "key2", Secret: "secret2", Created: time.Now()}, expiresAt)
+	require.NoError(t, err)
+	require.Equal(t, key.Role, rotated.Role)
+	require.Equal(t, key.Assets, rotated.Assets)
+
+	stored, err = s.GetKey(key.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored.ExpiresAt)
+	require.Equal(t, "key2", *stored.RotatedTo)
+	_, err = s.RotateKey(key.ID, common.Key{ID: "key3", Secret: "secret3", Created: time.Now()}, expiresAt)
+	require.Equal(t, common.ErrKeyInactive, err)
+
+	require.NoError(t, s.RevokeKey("key2"))
+	require.Equal(t, common.ErrNotFound, s.RevokeKey("key2"))
+	stored, err = s.GetKey("key2")
+	require.NoError(t, err)
+	require.False(t, stored.Active(time.Now()))
+
+	keys, err := s.GetKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+}
+
+func TestStorage_Roles(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		assert.NoError(t, tearDown())
+	}()
+	s, err := NewStorage(db)
+	require.NoError(t, err)
+
+	role := common.Role{
+		Name: "trader",
+		Permissions: []common.Permission{
+			{Path: "/*", Method: "GET"},
+			{Path: "/v3/trade", Method: "POST"},
+		},
+	}
+	require.NoError(t, s.UpdateRole(role))
+	roles, err := s.GetRoles()
+	require.NoError(t, err)
+	require.Equal(t, []common.Role{role}, roles)
+
+	role.Permissions = role.Permissions[:1]
+	require.NoError(t, s.UpdateRole(role))
+	roles, err = s.GetRoles()
+	require.NoError(t, err)
+	require.Equal(t, []common.Role{role}, roles)
+
+	require.NoError(t, s.CreateKey(common.Key{ID: "key1", Secret: "secret", Role: role.Name, Created: time.Now()}))
+	require.Equal(t, common.ErrRoleInUse, s.DeleteRole(role.Name))
+	require.NoError(t, s.RevokeKey("key1"))
+	require.NoError(t, s.DeleteRole(role.Name))
+	require.Equal(t, common.ErrNotFound, s.DeleteRole(role.Name))
+}
+
+func TestStorage_AuditLog(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		assert.NoError(t, tearDown())
+	}()
+	s, err := NewStorage(db)
+	require.NoError(t, err)
+
+	now := time.Now()
+	for i, keyID := range []string{"key1", "key2"} {
+		require.NoError(t, s.AddAuditRecord(common.AuditRecord{
+			Time:     now.Add(time.Duration(i) * time.Minute),
+			KeyID:    keyID,
+			Method:   "POST",
+			Route:    "/v3/withdraw",
+			BodyHash: "hash",
+			Status:   200,
+			Result:   common.AuditResultSuccess,
+		}))
+	}
+	records, err := s.GetAuditRecords(now.Add(-time.Minute), now.Add(time.Hour), "")
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	records, err = s.GetAuditRecords(now.Add(-time.Minute), now.Add(time.Hour), "key2")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	_, err = db.Exec(`DELETE FROM gateway_audit_log`)
+	require.Error(t, err)
+}
@@ -53,7 +53,6 @@ require (
 	github.com/oschwald/maxminddb-golang v1.3.1 // indirect
 	github.com/pkg/errors v0.9.1
 	github.com/prometheus/tsdb v0.8.0 // indirect
-	github.com/qiangmzsx/string-adapter v0.0.0-20180323073508-38f25303bb0c
 	github.com/robfig/cron v1.2.0
 	github.com/rs/cors v1.7.0 // indirect
 	github.com/spaolacci/murmur3 v1.0.1-0.20190317074736-539464a789e9 // indirect
@@ -6,7 +6,7 @@ import (
 
 	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/http/httputil"
-	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	v3common "github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
 
 const (
@@ -26,8 +26,11 @@ func (s *Server) GetAddresses(c *gin.Context) {
 	)
 	addresses[pricingOPAddressName] = s.blockchain.GetPricingOPAddress()
 	addresses[depositOPAddressName] = s.blockchain.GetDepositOPAddress()
-	if h := common.SupportedExchanges[rtypes.Huobi]; h != nil {
-		addresses[intermediateOPAddressName] = s.blockchain.GetIntermediatorOPAddress()
+	for id := range common.SupportedExchanges {
+		// intermediate operator is only used for Huobi account
+		if ex, err := s.settingStorage.GetExchange(id); err == nil && ex.Venue == v3common.VenueHuobi {
+			addresses[intermediateOPAddressName] = s.blockchain.GetIntermediatorOPAddress()
+		}
 	}
 	addresses[wrapper] = s.blockchain.GetWrapperAddress()
 	addresses[network] = s.blockchain.GetProxyAddress()
@@ -2,6 +2,9 @@ package http
 
 import (
 	ethereum "github.com/ethereum/go-ethereum/common"
+
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+	v3common "github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
 
 // Blockchain is used in http server as the caller to blockchain for information.
@@ -17,4 +20,7 @@ type Blockchain interface {
 	GetReserveAddress() ethereum.Address
 	GetRateQueryHelperAddress() ethereum.Address
 	ListedTokens() []ethereum.Address
+	NodePool() *blockchain.NodePool
+	GetStepFunctions(token ethereum.Address) (v3common.PricingStepFunctions, error)
+	GetTokenControlInfo(token ethereum.Address) (v3common.PricingControlInfo, error)
 }
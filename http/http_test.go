@@ -58,10 +58,13 @@ func TestGetActivities(t *testing.T) {
 		s,   // storage
 		nil, // fetcher
 		nil, // storageControllerRunner
-		nil, // archive
+		nil, // archives
+		nil, // retention
 		nil, // globalStorage
 		nil, // exchanges
 		nil, // settingStorage
+		nil, // priceHistory
+		nil, // reconciler
 	)
 
 	rCore := core.NewReserveCore(
@@ -69,6 +72,7 @@ func TestGetActivities(t *testing.T) {
 		s,
 		nil,
 		nil,
+		nil,
 	)
 
 	sv := NewHTTPServer(
@@ -80,6 +84,9 @@ func TestGetActivities(t *testing.T) {
 		nil,                    // storage
 		nil,
 		nil,
+		nil, // idempotency storage
+		nil, // operator rotator
+		nil, // security finding storage
 	)
 
 	sv.register()
@@ -0,0 +1,139 @@
+package http
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	idempotentResponseContent = "application/json; charset=utf-8"
+
+	// idempotencyInProgressTimeout is how long a key stays in progress before a retry can take
+	// it over, it is longer than the longest request.
+	idempotencyInProgressTimeout = 10 * time.Minute
+	// idempotencyKeyTTL is how long a key is kept, retries after it are executed again.
+	idempotencyKeyTTL           = 7 * 24 * time.Hour
+	idempotencyKeyPruneInterval = time.Hour
+)
+
+// IdempotencyStorage stores the outcome of requests sent with Idempotency-Key header.
+type IdempotencyStorage interface {
+	ReserveIdempotencyKey(req common.IdempotentRequest, staleBefore time.Time) (common.IdempotentRequest, bool, error)
+	CompleteIdempotentRequest(key string, status int, response []byte, activityID *common.ActivityID) error
+	ReleaseIdempotencyKey(key string) error
+	PruneIdempotencyKeys(before time.Time) (int64, error)
+}
+
+// bodyRecorder keeps a copy of response body written to client.
+type bodyRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bodyRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// idempotent returns a middleware which executes a request with Idempotency-Key header at most
+// once. Retries of a succeeded request get the original response, retries while the request is
+// in progress and reuses of the key for a different request are rejected. The key of a failed
+// request is released so it can be retried, a request that did not complete, e.g the server
+// crashed, keeps its key in progress until idempotencyInProgressTimeout. Requests without the
+// header are executed as usual.
+func (s *Server) idempotent(c *gin.Context) {
+	key := c.GetHeader(idempotencyKeyHeader)
+	if key == "" || s.idempotencyStorage == nil {
+		return
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		httputil.ResponseFailure(c, httputil.WithReason("idempotency key is too long"))
+		c.Abort()
+		return
+	}
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		c.Abort()
+		return
+	}
+	c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	hash := sha256.Sum256(body)
+	req := common.IdempotentRequest{
+		Key:         key,
+		Route:       c.Request.URL.Path,
+		RequestHash: hex.EncodeToString(hash[:]),
+	}
+
+	stored, reserved, err := s.idempotencyStorage.ReserveIdempotencyKey(req, time.Now().Add(-idempotencyInProgressTimeout))
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		c.Abort()
+		return
+	}
+	if !reserved {
+		switch {
+		case stored.Route != req.Route || stored.RequestHash != req.RequestHash:
+			httputil.ResponseFailure(c, httputil.WithReason("idempotency key was used for a different request"))
+		case !stored.Completed:
+			httputil.ResponseFailure(c, httputil.WithReason("request with the same idempotency key is in progress"))
+		default:
+			s.l.Infow("replay idempotent request", "key", key, "route", req.Route, "activity_id", stored.ActivityID)
+			c.Header(idempotentReplayedHeader, "true")
+			c.Data(stored.Status, idempotentResponseContent, stored.Response)
+		}
+		c.Abort()
+		return
+	}
+
+	w := &bodyRecorder{ResponseWriter: c.Writer}
+	c.Writer = w
+	c.Next()
+
+	var resp struct {
+		Success bool               `json:"success"`
+		ID      *common.ActivityID `json:"id"`
+	}
+	if err := json.Unmarshal(w.body.Bytes(), &resp); err != nil {
+		// response of the request does not contain an activity id
+		resp.ID = nil
+	}
+	// a failed request which recorded an activity may have been executed, it is not retried
+	if !resp.Success && resp.ID == nil {
+		if err := s.idempotencyStorage.ReleaseIdempotencyKey(key); err != nil {
+			s.l.Errorw("failed to release idempotency key", "key", key, "route", req.Route, "err", err)
+		}
+		return
+	}
+	if err := s.idempotencyStorage.CompleteIdempotentRequest(key, w.Status(), w.body.Bytes(), resp.ID); err != nil {
+		s.l.Errorw("failed to store idempotent request outcome", "key", key, "route", req.Route, "err", err)
+	}
+}
+
+// pruneIdempotencyKeys removes the idempotency keys older than idempotencyKeyTTL every prune
+// interval, it never returns.
+func (s *Server) pruneIdempotencyKeys() {
+	ticker := time.NewTicker(idempotencyKeyPruneInterval)
+	defer ticker.Stop()
+	for {
+		pruned, err := s.idempotencyStorage.PruneIdempotencyKeys(time.Now().Add(-idempotencyKeyTTL))
+		if err != nil {
+			s.l.Errorw("failed to prune idempotency keys", "err", err)
+		} else if pruned != 0 {
+			s.l.Infow("pruned idempotency keys", "count", pruned)
+		}
+		<-ticker.C
+	}
+}
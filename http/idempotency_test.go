@@ -0,0 +1,139 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+type memoryIdempotencyStorage struct {
+	requests map[string]common.IdempotentRequest
+	created  map[string]time.Time
+}
+
+func newMemoryIdempotencyStorage() *memoryIdempotencyStorage {
+	return &memoryIdempotencyStorage{
+		requests: make(map[string]common.IdempotentRequest),
+		created:  make(map[string]time.Time),
+	}
+}
+
+func (s *memoryIdempotencyStorage) ReserveIdempotencyKey(req common.IdempotentRequest, staleBefore time.Time) (common.IdempotentRequest, bool, error) {
+	if stored, ok := s.requests[req.Key]; ok && (stored.Completed || !s.created[req.Key].Before(staleBefore)) {
+		return stored, false, nil
+	}
+	s.requests[req.Key] = req
+	s.created[req.Key] = time.Now()
+	return req, true, nil
+}
+
+func (s *memoryIdempotencyStorage) CompleteIdempotentRequest(key string, status int, response []byte, activityID *common.ActivityID) error {
+	req := s.requests[key]
+	req.Completed = true
+	req.Status = status
+	req.Response = response
+	req.ActivityID = activityID
+	s.requests[key] = req
+	return nil
+}
+
+func (s *memoryIdempotencyStorage) ReleaseIdempotencyKey(key string) error {
+	if !s.requests[key].Completed {
+		delete(s.requests, key)
+		delete(s.created, key)
+	}
+	return nil
+}
+
+func (s *memoryIdempotencyStorage) PruneIdempotencyKeys(before time.Time) (int64, error) {
+	var pruned int64
+	for key, created := range s.created {
+		if created.Before(before) {
+			delete(s.requests, key)
+			delete(s.created, key)
+			pruned++
+		}
+	}
+	return pruned, nil
+}
+
+func TestIdempotent(t *testing.T) {
+	st := newMemoryIdempotencyStorage()
+	s := &Server{idempotencyStorage: st, l: zap.S()}
+	executed := 0
+	failing := false
+	r := gin.New()
+	r.POST("/v3/withdraw", s.idempotent, func(c *gin.Context) {
+		executed++
+		if failing {
+			httputil.ResponseFailure(c, httputil.WithReason("exchange is not available"))
+			return
+		}
+		httputil.ResponseSuccess(c, httputil.WithField("id", common.ActivityID{Timepoint: 1, EID: "eid"}))
+	})
+
+	do := func(key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v3/withdraw", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(idempotencyKeyHeader, key)
+		}
+		resp := httptest.NewRecorder()
+		r.ServeHTTP(resp, req)
+		return resp
+	}
+
+	first := do("key", `{"asset":1}`)
+	require.Equal(t, 1, executed)
+	require.Equal(t, &common.ActivityID{Timepoint: 1, EID: "eid"}, st.requests["key"].ActivityID)
+
+	replayed := do("key", `{"asset":1}`)
+	assert.Equal(t, 1, executed)
+	assert.Equal(t, first.Body.String(), replayed.Body.String())
+	assert.Equal(t, "true", replayed.Header().Get(idempotentReplayedHeader))
+
+	mismatched := do("key", `{"asset":2}`)
+	assert.Equal(t, 1, executed)
+	assert.Contains(t, mismatched.Body.String(), "different request")
+
+	st.requests["pending"] = common.IdempotentRequest{Key: "pending", Route: "/v3/withdraw", RequestHash: st.requests["key"].RequestHash}
+	st.created["pending"] = time.Now()
+	inProgress := do("pending", `{"asset":1}`)
+	assert.Equal(t, 1, executed)
+	assert.Contains(t, inProgress.Body.String(), "in progress")
+
+	// a request interrupted long ago is executed again
+	st.created["pending"] = time.Now().Add(-2 * idempotencyInProgressTimeout)
+	do("pending", `{"asset":1}`)
+	assert.Equal(t, 2, executed)
+	assert.True(t, st.requests["pending"].Completed)
+
+	// a failed request can be retried with the same key
+	failing = true
+	do("failed", `{"asset":1}`)
+	assert.Equal(t, 3, executed)
+	_, stored := st.requests["failed"]
+	assert.False(t, stored)
+	failing = false
+	do("failed", `{"asset":1}`)
+	assert.Equal(t, 4, executed)
+	assert.True(t, st.requests["failed"].Completed)
+
+	do("", `{"asset":1}`)
+	do("", `{"asset":1}`)
+	assert.Equal(t, 6, executed)
+
+	pruned, err := st.PruneIdempotencyKeys(time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), pruned)
+	assert.Empty(t, st.requests)
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+// OperatorRotator rotates keys of operators.
+type OperatorRotator interface {
+	Rotations() ([]common.OperatorRotation, error)
+	Start(op string) (common.OperatorRotation, error)
+	Resume(id uint64) (common.OperatorRotation, error)
+}
+
+func (s *Server) requireRotator(c *gin.Context) bool {
+	if s.rotator == nil {
+		httputil.ResponseFailure(c, httputil.WithReason("operator rotation is not configured"))
+		return false
+	}
+	return true
+}
+
+// getOperatorRotations returns all operator rotations with their steps, the latest first.
+func (s *Server) getOperatorRotations(c *gin.Context) {
+	if !s.requireRotator(c) {
+		return
+	}
+	rotations, err := s.rotator.Rotations()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(rotations))
+}
+
+// startOperatorRotation starts rotating an operator to its rotation key, the steps run in background.
+func (s *Server) startOperatorRotation(c *gin.Context) {
+	if !s.requireRotator(c) {
+		return
+	}
+	var request struct {
+		Operator string `json:"operator" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	rotation, err := s.rotator.Start(request.Operator)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(rotation))
+}
+
+// resumeOperatorRotation resumes a failed operator rotation from its failed step.
+func (s *Server) resumeOperatorRotation(c *gin.Context) {
+	if !s.requireRotator(c) {
+		return
+	}
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	rotation, err := s.rotator.Resume(id)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(rotation))
+}
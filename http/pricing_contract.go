@@ -0,0 +1,132 @@
+package http
+
+import (
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	v3common "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// getPricingTokenParams returns the step functions and control info of a token in the pricing contract.
+func (s *Server) getPricingTokenParams(c *gin.Context) {
+	var query struct {
+		Token string `form:"token" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if !ethereum.IsHexAddress(query.Token) {
+		httputil.ResponseFailure(c, httputil.WithReason("token is not a valid address"))
+		return
+	}
+	token := ethereum.HexToAddress(query.Token)
+	stepFunctions, err := s.blockchain.GetStepFunctions(token)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	controlInfo, err := s.blockchain.GetTokenControlInfo(token)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithMultipleFields(gin.H{
+		"step_functions": stepFunctions,
+		"control_info":   controlInfo,
+	}))
+}
+
+type pricingContractRequest struct {
+	SettingChangeID rtypes.SettingChangeID `json:"setting_change_id" binding:"required"`
+}
+
+// pricingContract sets the step functions and control info of a pending setting change in the
+// pricing contract, it is called by the setting server while the change is being confirmed. Txs
+// already sent by a previous call are not sent again. It fails until every tx is mined and the
+// values read back from the pricing contract are the ones of the change, so the change stays
+// pending until they are verified.
+func (s *Server) pricingContract(c *gin.Context) {
+	var request pricingContractRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	change, err := s.pendingSettingChange(v3common.ChangeCatalogPricingContract, request.SettingChangeID)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	var ids []common.ActivityID
+	for i, o := range change.ChangeList {
+		sent, err := s.setPricingContractEntry(change.ID, i, o.Data)
+		ids = append(ids, sent...)
+		if err != nil {
+			httputil.ResponseFailure(c, httputil.WithError(errors.Wrapf(err, "position %d", i)),
+				httputil.WithField("ids", ids))
+			return
+		}
+	}
+	httputil.ResponseSuccess(c, httputil.WithField("ids", ids))
+}
+
+// setPricingContractEntry asks core to set a step function or control info entry at position of
+// setting change id, other entries are ignored.
+func (s *Server) setPricingContractEntry(id rtypes.SettingChangeID, position int, data v3common.SettingChangeType) ([]common.ActivityID, error) {
+	switch e := data.(type) {
+	case *v3common.SetStepFunctionEntry:
+		asset, err := s.settingStorage.GetAsset(e.AssetID)
+		if err != nil {
+			return nil, err
+		}
+		return s.core.SetStepFunctions(id, position, asset, toPricingStepFunctions(asset, *e))
+	case *v3common.SetTokenControlInfoEntry:
+		asset, err := s.settingStorage.GetAsset(e.AssetID)
+		if err != nil {
+			return nil, err
+		}
+		return s.core.SetTokenControlInfo(id, position, asset, toPricingControlInfo(asset, *e))
+	}
+	return nil, nil
+}
+
+func toPricingStepFunction(decimals int64, f v3common.StepFunction) v3common.PricingStepFunction {
+	result := v3common.PricingStepFunction{
+		X: make([]*big.Int, 0, len(f.X)),
+		Y: make([]*big.Int, 0, len(f.Y)),
+	}
+	for _, x := range f.X {
+		result.X = append(result.X, common.FloatToBigInt(x, decimals))
+	}
+	for _, y := range f.Y {
+		result.Y = append(result.Y, big.NewInt(y))
+	}
+	return result
+}
+
+func toPricingStepFunctions(asset v3common.Asset, e v3common.SetStepFunctionEntry) v3common.PricingStepFunctions {
+	decimals := int64(asset.Decimals)
+	return v3common.PricingStepFunctions{
+		Token:         asset.Address,
+		QtyBuy:        toPricingStepFunction(decimals, e.QtyBuy),
+		QtySell:       toPricingStepFunction(decimals, e.QtySell),
+		ImbalanceBuy:  toPricingStepFunction(decimals, e.ImbalanceBuy),
+		ImbalanceSell: toPricingStepFunction(decimals, e.ImbalanceSell),
+	}
+}
+
+func toPricingControlInfo(asset v3common.Asset, e v3common.SetTokenControlInfoEntry) v3common.PricingControlInfo {
+	decimals := int64(asset.Decimals)
+	return v3common.PricingControlInfo{
+		Token:                   asset.Address,
+		MinimalRecordResolution: common.FloatToBigInt(e.MinimalRecordResolution, decimals),
+		MaxPerBlockImbalance:    common.FloatToBigInt(e.MaxPerBlockImbalance, decimals),
+		MaxTotalImbalance:       common.FloatToBigInt(e.MaxTotalImbalance, decimals),
+	}
+}
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	v3common "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type reserveAdminRequest struct {
+	SettingChangeID rtypes.SettingChangeID `json:"setting_change_id" binding:"required"`
+}
+
+// reserveAdmin sends the reserve admin operations of a pending setting change, it is called by the
+// setting server while the change is being confirmed so operations never run without approval.
+// Operations already sent by a previous call are skipped, so a failed call can be retried.
+func (s *Server) reserveAdmin(c *gin.Context) {
+	var request reserveAdminRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	change, err := s.pendingSettingChange(v3common.ChangeCatalogReserveAdmin, request.SettingChangeID)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	var ids []common.ActivityID
+	for i, o := range change.ChangeList {
+		entry, ok := o.Data.(*v3common.ReserveAdminEntry)
+		if !ok {
+			continue
+		}
+		var asset v3common.Asset
+		if entry.NeedsAsset() {
+			if asset, err = s.settingStorage.GetAsset(entry.AssetID); err != nil {
+				httputil.ResponseFailure(c, httputil.WithError(errors.Wrapf(err, "position %d", i)),
+					httputil.WithField("ids", ids))
+				return
+			}
+		}
+		s.l.Infow("reserve admin", "setting_change_id", change.ID, "operation", entry.Operation)
+		id, err := s.core.ReserveAdmin(change.ID, i, *entry, asset)
+		if err != nil {
+			httputil.ResponseFailure(c, httputil.WithError(errors.Wrapf(err, "position %d", i)),
+				httputil.WithField("ids", ids))
+			return
+		}
+		ids = append(ids, id)
+	}
+	httputil.ResponseSuccess(c, httputil.WithField("ids", ids))
+}
+
+// pendingSettingChange returns the setting change with id if it is a pending change of catalog.
+func (s *Server) pendingSettingChange(catalog v3common.ChangeCatalog, id rtypes.SettingChangeID) (v3common.SettingChangeResponse, error) {
+	pendings, err := s.settingStorage.GetSettingChanges(catalog, v3common.ChangeStatusPending)
+	if err != nil {
+		return v3common.SettingChangeResponse{}, err
+	}
+	for _, change := range pendings {
+		if change.ID == id {
+			return change, nil
+		}
+	}
+	return v3common.SettingChangeResponse{}, errors.Errorf("setting change %d is not a pending %s change", id, catalog)
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+// SecurityFindingStorage returns findings of the security monitor.
+type SecurityFindingStorage interface {
+	GetSecurityFindings(from, to time.Time, severities []string) ([]common.SecurityFinding, error)
+}
+
+// getSecurityFindings returns findings of admin activity on the reserve contracts not initiated by
+// our operators, the latest first.
+func (s *Server) getSecurityFindings(c *gin.Context) {
+	if s.securityFindingStorage == nil {
+		httputil.ResponseFailure(c, httputil.WithReason("security monitor is not configured"))
+		return
+	}
+	var query struct {
+		From     uint64 `form:"from"`
+		To       uint64 `form:"to"`
+		Severity string `form:"severity"`
+	}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if query.To == 0 {
+		query.To = common.TimeToMillis(time.Now())
+	}
+	if query.From == 0 {
+		query.From = query.To - defaultTimeRange
+	}
+	severities, err := common.SeveritiesAtLeast(query.Severity)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	findings, err := s.securityFindingStorage.GetSecurityFindings(common.MillisToTime(query.From),
+		common.MillisToTime(query.To), severities)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(findings))
+}
@@ -43,6 +43,10 @@ type Server struct {
 	l                  *zap.SugaredLogger
 	gasInfo            *gasinfo.GasPriceInfo
 	binanceMainAccount *binance.Endpoint
+	idempotencyStorage IdempotencyStorage
+	rotator            OperatorRotator
+
+	securityFindingStorage SecurityFindingStorage
 }
 
 func getTimePoint(c *gin.Context, l *zap.SugaredLogger) uint64 {
@@ -183,6 +187,124 @@ func (s *Server) GetRates(c *gin.Context) {
 	}
 }
 
+// GetGasPrice returns the gas price used by core with the quotes of all gas price sources and why it was chosen.
+func (s *Server) GetGasPrice(c *gin.Context) {
+	if s.gasInfo == nil {
+		httputil.ResponseFailure(c, httputil.WithReason("gas price info is not available"))
+		return
+	}
+	decision, err := s.gasInfo.GasPriceDecision()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err), httputil.WithField("decision", decision))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(decision))
+}
+
+// GetNodeHealth returns block lag, latency, error rate and score of the Ethereum nodes, healthiest first.
+func (s *Server) GetNodeHealth(c *gin.Context) {
+	pool := s.blockchain.NodePool()
+	if pool == nil {
+		httputil.ResponseFailure(c, httputil.WithReason("node pool is not available"))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(pool.Health()))
+}
+
+// GetPriceHistory returns candles of mid, best bid/ask, spread and depth of a trading pair
+func (s *Server) GetPriceHistory(c *gin.Context) {
+	var query struct {
+		Pair     rtypes.TradingPairID `form:"pair" binding:"required"`
+		From     uint64               `form:"from"`
+		To       uint64               `form:"to"`
+		Interval string               `form:"interval"`
+	}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if query.To == 0 {
+		query.To = common.TimeToMillis(time.Now())
+	}
+	if query.From == 0 {
+		query.From = query.To - defaultTimeRange
+	}
+	interval := time.Hour
+	if query.Interval != "" {
+		var err error
+		if interval, err = time.ParseDuration(query.Interval); err != nil {
+			httputil.ResponseFailure(c, httputil.WithError(err))
+			return
+		}
+	}
+	data, err := s.app.GetPriceHistory(query.Pair, query.From, query.To, interval)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(data))
+}
+
+type balanceHistoryQuery struct {
+	Asset       rtypes.AssetID `form:"asset"`
+	From        uint64         `form:"from"`
+	To          uint64         `form:"to"`
+	FlaggedOnly bool           `form:"flagged"`
+}
+
+func bindBalanceHistoryQuery(c *gin.Context) (balanceHistoryQuery, error) {
+	var query balanceHistoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		return query, err
+	}
+	if query.To == 0 {
+		query.To = common.TimeToMillis(time.Now())
+	}
+	if query.From == 0 {
+		query.From = query.To - defaultTimeRange
+	}
+	return query, nil
+}
+
+// GetBalanceHistory returns hourly balances of assets in reserve and exchanges
+func (s *Server) GetBalanceHistory(c *gin.Context) {
+	query, err := bindBalanceHistoryQuery(c)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	data, err := s.app.GetBalanceHistory(query.Asset, query.From, query.To)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(data))
+}
+
+// GetBalanceReconciliations returns reconciliations of balance changes against activities
+func (s *Server) GetBalanceReconciliations(c *gin.Context) {
+	query, err := bindBalanceHistoryQuery(c)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	data, err := s.app.GetBalanceReconciliations(query.From, query.To, query.FlaggedOnly)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if query.Asset != 0 {
+		filtered := data[:0]
+		for _, r := range data {
+			if r.AssetID == query.Asset {
+				filtered = append(filtered, r)
+			}
+		}
+		data = filtered
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(data))
+}
+
 // TransferSelfRequest form
 type TransferSelfRequest struct {
 	Nonce    uint64 `json:"nonce" binding:"required"`
@@ -319,7 +441,7 @@ func (s *Server) OpenOrders(c *gin.Context) {
 		openOrders, err := exchange.OpenOrders(pair)
 		if err != nil {
 			logger.Errorw("failed to get open orders",
-				"exchange", exchange.ID().String(),
+				"exchange", exchange.Name(),
 				"base", pair.BaseSymbol,
 				"quote", pair.QuoteSymbol,
 				"error", err)
@@ -351,7 +473,7 @@ func (s *Server) CancelOrder(c *gin.Context) {
 		httputil.ResponseFailure(c, httputil.WithError(errors.Errorf("exchange %v is not supported", request.ExchangeID)))
 		return
 	}
-	s.l.Infow("Cancel order", "order", request.Orders, "from", exchange.ID().String())
+	s.l.Infow("Cancel order", "order", request.Orders, "from", exchange.Name())
 	result := s.core.CancelOrders(request.Orders, exchange)
 	httputil.ResponseSuccess(c, httputil.WithData(result))
 }
@@ -383,7 +505,7 @@ func (s *Server) Withdraw(c *gin.Context) {
 		return
 	}
 	s.l.Infow("Withdraw", "amount", request.Amount.Text(10), "asset_id", asset.ID,
-		"asset_symbol", asset.Symbol, "exchange", exchange.ID().String())
+		"asset_symbol", asset.Symbol, "exchange", exchange.Name())
 	id, err := s.core.Withdraw(exchange, asset, request.Amount)
 	if err != nil {
 		httputil.ResponseFailure(c, httputil.WithError(err))
@@ -392,12 +514,15 @@ func (s *Server) Withdraw(c *gin.Context) {
 	httputil.ResponseSuccess(c, httputil.WithField("id", id))
 }
 
+// transferRequest moves fund from exchange account to either another account on the same
+// venue given by to_exchange, or between raw from_account and to_account ids.
 type transferRequest struct {
-	ExchangeID  rtypes.ExchangeID `json:"exchange"`
-	Asset       rtypes.AssetID    `json:"asset"`
-	Amount      *big.Int          `json:"amount"`
-	FromAccount string            `json:"from_account"`
-	ToAccount   string            `json:"to_account"`
+	ExchangeID   rtypes.ExchangeID `json:"exchange"`
+	ToExchangeID rtypes.ExchangeID `json:"to_exchange"`
+	Asset        rtypes.AssetID    `json:"asset"`
+	Amount       *big.Int          `json:"amount"`
+	FromAccount  string            `json:"from_account"`
+	ToAccount    string            `json:"to_account"`
 }
 
 // Withdraw asset to reserve from cex
@@ -414,13 +539,22 @@ func (s *Server) cexTransfer(c *gin.Context) {
 		return
 	}
 
+	if request.ToExchangeID != 0 {
+		fromAccount, toAccount, err := s.transferAccounts(exh, request.ToExchangeID)
+		if err != nil {
+			httputil.ResponseFailure(c, httputil.WithError(err))
+			return
+		}
+		request.FromAccount, request.ToAccount = fromAccount, toAccount
+	}
+
 	asset, err := s.settingStorage.GetAsset(request.Asset)
 	if err != nil {
 		httputil.ResponseFailure(c, httputil.WithError(err))
 		return
 	}
 	s.l.Infow("cexTransfer", "amount", request.Amount.Text(10), "asset_id", asset.ID,
-		"asset_symbol", asset.Symbol, "exchange", exh.ID().String(), "from_account", request.FromAccount,
+		"asset_symbol", asset.Symbol, "exchange", exh.Name(), "from_account", request.FromAccount,
 		"to_account", request.ToAccount)
 	id, err := s.core.Transfer(request.FromAccount, request.ToAccount, asset, request.Amount, exh)
 	if err != nil {
@@ -430,6 +564,34 @@ func (s *Server) cexTransfer(c *gin.Context) {
 	httputil.ResponseSuccess(c, httputil.WithField("id", id))
 }
 
+// transferAccounts returns account ids of two exchange accounts on the same venue.
+func (s *Server) transferAccounts(from common.Exchange, toID rtypes.ExchangeID) (string, string, error) {
+	to, ok := common.SupportedExchanges[toID]
+	if !ok {
+		return "", "", errors.Errorf("exchange %v is not supported", toID)
+	}
+	fromSetting, err := s.settingStorage.GetExchange(from.ID())
+	if err != nil {
+		return "", "", err
+	}
+	toSetting, err := s.settingStorage.GetExchange(toID)
+	if err != nil {
+		return "", "", err
+	}
+	if fromSetting.Venue != toSetting.Venue {
+		return "", "", errors.Errorf("cannot transfer between %s and %s exchanges", fromSetting.Venue, toSetting.Venue)
+	}
+	fromAccount, ok := from.(common.AccountExchange)
+	if !ok {
+		return "", "", errors.Errorf("exchange %v does not support transfer", from.ID())
+	}
+	toAccount, ok := to.(common.AccountExchange)
+	if !ok {
+		return "", "", errors.Errorf("exchange %v does not support transfer", toID)
+	}
+	return fromAccount.AccountID(), toAccount.AccountID(), nil
+}
+
 func (s *Server) getBinanceMainAccountInfo(c *gin.Context) {
 	resp, err := s.binanceMainAccount.GetInfo()
 	if err != nil {
@@ -467,7 +629,7 @@ func (s *Server) Deposit(c *gin.Context) {
 	}
 
 	s.l.Infow("Depositing", "amount", request.Amount.Text(10), "asset_id", asset.ID,
-		"asset_symbol", asset.Symbol, "exchange", exchange.ID().String())
+		"asset_symbol", asset.Symbol, "exchange", exchange.Name())
 	id, err := s.core.Deposit(exchange, asset, request.Amount, getTimePoint(c, s.l))
 	if err != nil {
 		httputil.ResponseFailure(c, httputil.WithError(err))
@@ -559,6 +721,7 @@ func (s *Server) ValidateTimeInput(c *gin.Context) (uint64, uint64, bool) {
 func (s *Server) updateTokenIndice(c *gin.Context) {
 	if err := s.blockchain.LoadAndSetTokenIndices(); err != nil {
 		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
 	}
 	httputil.ResponseSuccess(c)
 }
@@ -615,21 +778,26 @@ func (s *Server) register() {
 		g.GET("/prices/:base/:quote", s.Price)
 		g.GET("/getrates", s.GetRate)
 		g.GET("/get-all-rates", s.GetRates)
+		g.GET("/price-history", s.GetPriceHistory)
 
 		g.GET("/authdata-version", s.AuthDataVersion)
 		g.GET("/authdata", s.AuthData)
 		g.GET("/activities", s.GetActivities)
 		g.GET("/immediate-pending-activities", s.ImmediatePendingActivities)
+		g.GET("/balance-history", s.GetBalanceHistory)
+		g.GET("/balance-reconciliation", s.GetBalanceReconciliations)
 
 		g.GET("/open-orders", s.OpenOrders)
-		g.POST("/cancel-orders", s.CancelOrder)
-		g.POST("/cancel-all-orders", s.CancelAllOrders)
-		g.POST("/deposit", s.Deposit)
-		g.POST("/withdraw", s.Withdraw)
-		g.POST("/trade", s.Trade)
-		g.POST("/transfer-self", s.transferSelf)
-		g.POST("/setrates", s.SetRate)
-		g.POST("/cancel-setrates", s.cancelSetRate)
+		g.POST("/cancel-orders", s.idempotent, s.CancelOrder)
+		g.POST("/cancel-all-orders", s.idempotent, s.CancelAllOrders)
+		g.POST("/deposit", s.idempotent, s.Deposit)
+		g.POST("/withdraw", s.idempotent, s.Withdraw)
+		g.POST("/trade", s.idempotent, s.Trade)
+		g.POST("/transfer-self", s.idempotent, s.transferSelf)
+		g.POST("/setrates", s.idempotent, s.SetRate)
+		g.POST("/cancel-setrates", s.idempotent, s.cancelSetRate)
+		g.GET("/gas-price", s.GetGasPrice)
+		g.GET("/node-health", s.GetNodeHealth)
 		g.GET("/tradehistory", s.GetTradeHistory)
 
 		g.GET("/timeserver", s.GetTimeServer)
@@ -640,10 +808,17 @@ func (s *Server) register() {
 
 		g.GET("/addresses", s.GetAddresses)
 
-		g.PUT("/update-token-indice", s.updateTokenIndice)
+		g.PUT("/update-token-indice", s.idempotent, s.updateTokenIndice)
 		g.GET("/check-token-indice", s.checkTokenIndice)
+		g.GET("/pricing-contract/token-params", s.getPricingTokenParams)
+		g.POST("/pricing-contract", s.idempotent, s.pricingContract)
+		g.POST("/reserve-admin", s.idempotent, s.reserveAdmin)
+		g.GET("/operator-rotations", s.getOperatorRotations)
+		g.POST("/operator-rotations", s.idempotent, s.startOperatorRotation)
+		g.POST("/operator-rotations/:id/resume", s.idempotent, s.resumeOperatorRotation)
+		g.GET("/security-findings", s.getSecurityFindings)
 		g.GET("/token-rate-trigger", s.getTriggers)
-		g.POST("/cex-transfer", s.cexTransfer)
+		g.POST("/cex-transfer", s.idempotent, s.cexTransfer)
 		g.GET("/binance/main", s.getBinanceMainAccountInfo)
 	}
 }
@@ -651,6 +826,9 @@ func (s *Server) register() {
 // Run the server
 func (s *Server) Run() {
 	s.register()
+	if s.idempotencyStorage != nil {
+		go s.pruneIdempotencyKeys()
+	}
 	if err := s.r.Run(s.host); err != nil {
 		log.Panic(err)
 	}
@@ -671,6 +849,9 @@ func NewHTTPServer(
 	settingStorage storage.Interface,
 	gasInfo *gasinfo.GasPriceInfo,
 	binanceMainAccount *binance.Endpoint,
+	idempotencyStorage IdempotencyStorage,
+	rotator OperatorRotator,
+	securityFindingStorage SecurityFindingStorage,
 ) *Server {
 	r := gin.Default()
 	sentryCli, err := raven.NewWithTags(
@@ -697,5 +878,9 @@ func NewHTTPServer(
 		l:                  zap.S(),
 		gasInfo:            gasInfo,
 		binanceMainAccount: binanceMainAccount,
+		idempotencyStorage: idempotencyStorage,
+		rotator:            rotator,
+
+		securityFindingStorage: securityFindingStorage,
 	}
 }
@@ -2,10 +2,13 @@ package reserve
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/data/balancehistory"
+	"github.com/KyberNetwork/reserve-data/data/pricehistory"
 	"github.com/KyberNetwork/reserve-data/lib/rtypes"
 	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
@@ -24,6 +27,8 @@ type Data interface {
 	CurrentPriceVersion(timestamp uint64) (common.Version, error)
 	GetAllPrices(timestamp uint64) (common.AllPriceResponse, error)
 	GetOnePrice(id rtypes.TradingPairID, timestamp uint64) (common.OnePriceResponse, error)
+	// GetPriceHistory returns candles of interval of a trading pair in [fromTime, toTime).
+	GetPriceHistory(pairID rtypes.TradingPairID, fromTime, toTime uint64, interval time.Duration) ([]pricehistory.Candle, error)
 
 	CurrentAuthDataVersion(timestamp uint64) (common.Version, error)
 	GetAuthData(timestamp uint64) (common.AuthDataResponseV3, error)
@@ -33,6 +38,11 @@ type Data interface {
 	// GetRates returns list of valid rates for all tokens that is collected between [fromTime, toTime).
 	GetRates(fromTime, toTime uint64) ([]common.AllRateResponse, error)
 
+	// GetBalanceHistory returns balances of an asset, or all assets if assetID is 0, in [fromTime, toTime).
+	GetBalanceHistory(assetID rtypes.AssetID, fromTime, toTime uint64) ([]balancehistory.Balance, error)
+	// GetBalanceReconciliations returns reconciliations of periods ending in [fromTime, toTime).
+	GetBalanceReconciliations(fromTime, toTime uint64, flaggedOnly bool) ([]balancehistory.Reconciliation, error)
+
 	GetRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error)
 	GetPendingActivities() ([]common.ActivityRecord, error)
 
@@ -76,4 +86,7 @@ type Core interface {
 	SetRates(tokens []commonv3.Asset, buys, sells []*big.Int, block *big.Int, afpMid []*big.Int, msgs []string, triggers []bool) (common.ActivityID, error)
 	CancelSetRate() (common.ActivityID, error)
 	TransferToSelf(op string, nonce uint64, withGasPrice float64) (*types.Transaction, error)
+	ReserveAdmin(id rtypes.SettingChangeID, position int, entry commonv3.ReserveAdminEntry, asset commonv3.Asset) (common.ActivityID, error)
+	SetStepFunctions(id rtypes.SettingChangeID, position int, asset commonv3.Asset, fns commonv3.PricingStepFunctions) ([]common.ActivityID, error)
+	SetTokenControlInfo(id rtypes.SettingChangeID, position int, asset commonv3.Asset, info commonv3.PricingControlInfo) ([]common.ActivityID, error)
 }
@@ -1,17 +1,23 @@
 package coreclient
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	ethereum "github.com/ethereum/go-ethereum/common"
 
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
 	"github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
 
 const (
 	defaultTimeout = 5 * time.Second
+	// pricingContractTimeout covers broadcasting pricing contract txs and reading the values back
+	// from the pricing contract once they are mined.
+	pricingContractTimeout = 30 * time.Second
 )
 
 //Client is client for core
@@ -67,3 +73,64 @@ func (c *Client) UpdateTokenIndice() error {
 	}
 	return nil
 }
+
+// ExecutePricingContract call to core to set the step functions and control info of a pending
+// setting change in pricing contract. Core sends every tx at most once and fails until all txs
+// are mined and the values are verified on chain, the change should stay pending until then. The
+// setting change id is the idempotency key like ExecuteReserveAdmin.
+func (c *Client) ExecutePricingContract(id rtypes.SettingChangeID) error {
+	endpoint := fmt.Sprintf("%s/v3/pricing-contract", c.endpoint)
+	data := map[string]rtypes.SettingChangeID{"setting_change_id": id}
+	return c.post(endpoint, pricingContractTimeout, fmt.Sprintf("pricing-contract-%d", id), data)
+}
+
+// ExecuteReserveAdmin call to core to send the reserve admin operations of a pending setting
+// change. Core sends every operation at most once, a change whose operations failed to be sent
+// can be confirmed again to send the remaining ones. The setting change id is the idempotency key
+// so a retry is rejected while the change is being sent, the key is released if sending fails.
+func (c *Client) ExecuteReserveAdmin(id rtypes.SettingChangeID) error {
+	endpoint := fmt.Sprintf("%s/v3/reserve-admin", c.endpoint)
+	data := map[string]rtypes.SettingChangeID{"setting_change_id": id}
+	return c.post(endpoint, defaultTimeout, fmt.Sprintf("reserve-admin-%d", id), data)
+}
+
+// post sends data to a core endpoint which responds with success and reason, the request is
+// sent with Idempotency-Key header if idempotencyKey is not empty.
+func (c *Client) post(endpoint string, timeout time.Duration, idempotencyKey string, data interface{}) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request to %s: %s", endpoint, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	client := http.Client{
+		Timeout: timeout,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request %s: %s", endpoint, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s failed, status code: %d", endpoint, resp.StatusCode)
+	}
+	var result struct {
+		Success bool   `json:"success"`
+		Reason  string `json:"reason"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %s", endpoint, err)
+	}
+	if !result.Success {
+		return fmt.Errorf("%s failed: %s", endpoint, result.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Package eventbus is an in-process publish/subscribe bus of internal state changes, events can be
+// forwarded to a message broker or webhook so other services react without polling.
+package eventbus
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Type is type of an event.
+type Type string
+
+const (
+	// PriceUpdated is published when prices are stored, data is VersionData.
+	PriceUpdated Type = "price_updated"
+	// AuthDataUpdated is published when auth data is stored, data is VersionData.
+	AuthDataUpdated Type = "auth_data_updated"
+	// ActivityCreated is published when an activity is recorded, data is the activity record.
+	ActivityCreated Type = "activity_created"
+	// ActivityStatusChanged is published when exchange or mining status of an activity changes,
+	// data is the activity status change.
+	ActivityStatusChanged Type = "activity_status_changed"
+	// SettingChangeCreated is published when a setting change is created, data is the setting change.
+	SettingChangeCreated Type = "setting_change_created"
+	// SettingChangeApplied is published when a setting change is confirmed, data is the setting change.
+	SettingChangeApplied Type = "setting_change_applied"
+	// SetRateSubmitted is published when a set rate transaction is sent, data is the activity record.
+	SetRateSubmitted Type = "set_rate_submitted"
+)
+
+// AllTypes returns all event types.
+func AllTypes() []Type {
+	return []Type{PriceUpdated, AuthDataUpdated, ActivityCreated, ActivityStatusChanged, SettingChangeCreated, SettingChangeApplied,
+		SetRateSubmitted}
+}
+
+// subscriberBuffer is the number of events queued for a subscriber before new events are dropped.
+const subscriberBuffer = 1024
+
+// VersionData is data of events about a new version of fetched data.
+type VersionData struct {
+	Version uint64 `json:"version"`
+	Block   uint64 `json:"block"`
+	Valid   bool   `json:"valid"`
+}
+
+// Event is a state change.
+type Event struct {
+	Type Type        `json:"type"`
+	Time uint64      `json:"time"` // in milliseconds
+	Data interface{} `json:"data"`
+}
+
+// Handler handles an event.
+type Handler func(Event)
+
+type subscriber struct {
+	name  string
+	types map[Type]bool
+	ch    chan Event
+}
+
+// Bus delivers published events to subscribers, a nil Bus discards all events.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers []*subscriber
+	l           *zap.SugaredLogger
+}
+
+// New creates a new Bus.
+func New() *Bus {
+	return &Bus{l: zap.S()}
+}
+
+// Subscribe calls handler with published events of types, or all events if types is empty, in order
+// of publishing. Handler is called in its own goroutine so a slow handler does not block publishers,
+// events are dropped if it falls too far behind. The returned function cancels the subscription.
+func (b *Bus) Subscribe(name string, handler Handler, types ...Type) func() {
+	if b == nil {
+		return func() {}
+	}
+	sub := &subscriber{name: name, ch: make(chan Event, subscriberBuffer)}
+	if len(types) != 0 {
+		sub.types = make(map[Type]bool, len(types))
+		for _, t := range types {
+			sub.types[t] = true
+		}
+	}
+	b.mu.Lock()
+	b.subscribers = append(b.subscribers, sub)
+	b.mu.Unlock()
+	go func() {
+		for e := range sub.ch {
+			handler(e)
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			for i, s := range b.subscribers {
+				if s == sub {
+					b.subscribers = append(b.subscribers[:i], b.subscribers[i+1:]...)
+					break
+				}
+			}
+			close(sub.ch)
+		})
+	}
+}
+
+// Publish publishes an event of type t with data to subscribers, it never blocks.
+func (b *Bus) Publish(t Type, data interface{}) {
+	if b == nil {
+		return
+	}
+	e := Event{Type: t, Time: uint64(time.Now().UnixNano() / int64(time.Millisecond)), Data: data}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, sub := range b.subscribers {
+		if sub.types != nil && !sub.types[t] {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+			b.l.Warnw("event dropped, subscriber is too slow", "subscriber", sub.name, "type", t)
+		}
+	}
+}
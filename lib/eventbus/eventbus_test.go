@@ -0,0 +1,99 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (r *recorder) handle(e Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) types() []Type {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var types []Type
+	for _, e := range r.events {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func TestBusSubscribe(t *testing.T) {
+	b := New()
+	var all, activities recorder
+	b.Subscribe("all", all.handle)
+	cancel := b.Subscribe("activities", activities.handle, ActivityCreated, ActivityStatusChanged)
+
+	b.Publish(PriceUpdated, VersionData{Version: 1})
+	b.Publish(ActivityCreated, "a")
+	b.Publish(ActivityStatusChanged, "b")
+	require.Eventually(t, func() bool { return len(activities.types()) == 2 }, time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return len(all.types()) == 3 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []Type{PriceUpdated, ActivityCreated, ActivityStatusChanged}, all.types())
+	assert.Equal(t, []Type{ActivityCreated, ActivityStatusChanged}, activities.types())
+
+	cancel()
+	cancel()
+	b.Publish(ActivityCreated, "c")
+	require.Eventually(t, func() bool { return len(all.types()) == 4 }, time.Second, 10*time.Millisecond)
+	assert.Len(t, activities.types(), 2)
+}
+
+func TestNilBus(t *testing.T) {
+	var b *Bus
+	b.Publish(PriceUpdated, nil)
+	b.Subscribe("nil", func(Event) {})()
+}
+
+func TestForward(t *testing.T) {
+	b := New()
+	var p MemoryPublisher
+	Forward(b, "memory", &p, "reserve.", SetRateSubmitted)
+
+	b.Publish(PriceUpdated, VersionData{Version: 1})
+	b.Publish(SetRateSubmitted, map[string]string{"tx": "0x1"})
+	require.Eventually(t, func() bool { return len(p.Messages()) == 1 }, time.Second, 10*time.Millisecond)
+
+	msg := p.Messages()[0]
+	assert.Equal(t, "reserve.set_rate_submitted", msg.Subject)
+	var e struct {
+		Type Type              `json:"type"`
+		Data map[string]string `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(msg.Data, &e))
+	assert.Equal(t, SetRateSubmitted, e.Type)
+	assert.Equal(t, "0x1", e.Data["tx"])
+}
+
+func TestWebhookPublisher(t *testing.T) {
+	received := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Get("X-Event-Type")
+	}))
+	defer srv.Close()
+
+	p := NewWebhookPublisher(srv.URL, srv.Client())
+	require.NoError(t, p.Publish(string(PriceUpdated), []byte(`{}`)))
+	assert.Equal(t, string(PriceUpdated), <-received)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	assert.Error(t, NewWebhookPublisher(failing.URL, failing.Client()).Publish("x", nil))
+}
@@ -0,0 +1,53 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// Publisher sends encoded events to a message broker or webhook.
+type Publisher interface {
+	Publish(subject string, data []byte) error
+}
+
+// Forward subscribes publisher to events of types, or all events if types is empty. Events are sent
+// as JSON to subject prefix + event type, failures are logged.
+func Forward(b *Bus, name string, publisher Publisher, prefix string, types ...Type) func() {
+	return b.Subscribe(name, func(e Event) {
+		data, err := json.Marshal(e)
+		if err != nil {
+			b.l.Errorw("failed to encode event", "type", e.Type, "err", err)
+			return
+		}
+		if err = publisher.Publish(prefix+string(e.Type), data); err != nil {
+			b.l.Warnw("failed to forward event", "forwarder", name, "type", e.Type, "err", err)
+		}
+	}, types...)
+}
+
+// Message is an event sent to a MemoryPublisher.
+type Message struct {
+	Subject string
+	Data    []byte
+}
+
+// MemoryPublisher keeps sent events in memory, it is a local stand-in for a message broker.
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+// Publish implements Publisher.
+func (p *MemoryPublisher) Publish(subject string, data []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, Message{Subject: subject, Data: data})
+	return nil
+}
+
+// Messages returns all sent events.
+func (p *MemoryPublisher) Messages() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Message(nil), p.messages...)
+}
@@ -0,0 +1,69 @@
+package eventbus
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNATSPublisher(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() {
+		_ = ln.Close()
+	}()
+
+	lines := make(chan string, 10)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer func() {
+			_ = conn.Close()
+		}()
+		_, _ = fmt.Fprint(conn, "INFO {\"server_id\":\"test\"}\r\n")
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			lines <- strings.TrimSpace(line)
+			if strings.HasPrefix(line, "PUB") {
+				var (
+					subject string
+					size    int
+				)
+				_, _ = fmt.Sscanf(line, "PUB %s %d", &subject, &size)
+				payload := make([]byte, size+2)
+				if _, err := io.ReadFull(r, payload); err != nil {
+					return
+				}
+				lines <- string(payload[:size])
+			}
+		}
+	}()
+
+	p, err := NewNATSPublisher("nats://user:secret@" + ln.Addr().String())
+	require.NoError(t, err)
+	require.NoError(t, p.Publish("reserve.price_updated", []byte(`{"version":1}`)))
+
+	connect := <-lines
+	assert.True(t, strings.HasPrefix(connect, "CONNECT "))
+	assert.Contains(t, connect, `"user":"user"`)
+	assert.Contains(t, connect, `"pass":"secret"`)
+	assert.Equal(t, "PUB reserve.price_updated 13", <-lines)
+	assert.Equal(t, `{"version":1}`, <-lines)
+}
+
+func TestNewNATSPublisherInvalidURL(t *testing.T) {
+	_, err := NewNATSPublisher("http://localhost:4222")
+	assert.Error(t, err)
+}
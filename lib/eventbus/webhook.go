@@ -0,0 +1,39 @@
+package eventbus
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+)
+
+// WebhookPublisher posts events as JSON to a URL, event type is sent in header X-Event-Type.
+type WebhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookPublisher creates a new WebhookPublisher.
+func NewWebhookPublisher(url string, client *http.Client) *WebhookPublisher {
+	return &WebhookPublisher{url: url, client: client}
+}
+
+// Publish implements Publisher.
+func (p *WebhookPublisher) Publish(subject string, data []byte) error {
+	req, err := http.NewRequest(http.MethodPost, p.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Type", subject)
+	rsp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rsp.Body.Close()
+	}()
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s returned status %d", p.url, rsp.StatusCode)
+	}
+	return nil
+}
@@ -10,12 +10,11 @@ func _() {
 	var x [1]struct{}
 	_ = x[Binance-1]
 	_ = x[Huobi-2]
-	_ = x[Binance2-3]
 }
 
-const _ExchangeID_name = "binancehuobibinance_2"
+const _ExchangeID_name = "binancehuobi"
 
-var _ExchangeID_index = [...]uint8{0, 7, 12, 21}
+var _ExchangeID_index = [...]uint8{0, 7, 12}
 
 func (i ExchangeID) String() string {
 	i -= 1
@@ -1,6 +1,8 @@
 package rtypes
 
-// ExchangeID is the name of exchanges of which core will use to rebalance.
+// ExchangeID identifies an exchange account of which core will use to rebalance.
+// Accounts other than the built-in ones are defined in setting database, their names are only
+// known from the exchange records.
 //go:generate stringer -type=ExchangeID -linecomment
 type ExchangeID uint64
 
@@ -9,8 +11,6 @@ const (
 	Binance ExchangeID = iota + 1 // binance
 	// Huobi is the enumerated key for huobi
 	Huobi // huobi
-	// Binance2 is second binance exchange
-	Binance2 // binance_2
 )
 
 type AssetID uint64
@@ -25,9 +25,11 @@ import (
 	marketdatacli "github.com/KyberNetwork/reserve-data/lib/market-data"
 	"github.com/KyberNetwork/reserve-data/lib/migration"
 	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+	"github.com/KyberNetwork/reserve-data/reservesetting/exchangeinfo"
 	settinghttp "github.com/KyberNetwork/reserve-data/reservesetting/http"
-	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
 	"github.com/KyberNetwork/reserve-data/reservesetting/storage/postgres"
+	"github.com/KyberNetwork/reserve-data/reservesetting/webhook"
 )
 
 const (
@@ -40,10 +42,25 @@ const (
 	marketDataURLFlag    = "market-data-url"
 	defaultMarketDataURL = "http://localhost:8080"
 
-	intervalUpdateWithdrawFeeDBFlag      = "interval-update-withdraw-fee-db"
-	defaultIntervalUpdateWithdrawFeeDB   = 10 * time.Minute
 	intervalUpdateWithdrawFeeLiveFlag    = "interval-update-withdraw-fee-live"
 	defaultIntervalUpdateWithdrawFeeLive = 5 * time.Minute
+
+	// intervalUpdateWithdrawFeeDBFlag is deprecated, withdraw fee on db is synced every
+	// interval-sync-exchange-info. It is kept so that existing launch scripts still work.
+	intervalUpdateWithdrawFeeDBFlag = "interval-update-withdraw-fee-db"
+
+	intervalSyncExchangeInfoFlag    = "interval-sync-exchange-info"
+	defaultIntervalSyncExchangeInfo = 10 * time.Minute
+	amountLimitToleranceFlag        = "exchange-info-amount-limit-tolerance"
+	defaultAmountLimitTolerance     = 0.1
+	priceLimitToleranceFlag         = "exchange-info-price-limit-tolerance"
+	defaultPriceLimitTolerance      = 0.1
+	minNotionalToleranceFlag        = "exchange-info-min-notional-tolerance"
+	defaultMinNotionalTolerance     = 0.1
+	withdrawFeeToleranceFlag        = "exchange-info-withdraw-fee-tolerance"
+	defaultWithdrawFeeTolerance     = 0.5
+
+	webhookTimeout = 10 * time.Second
 )
 
 func main() {
@@ -61,6 +78,7 @@ func main() {
 	app.Flags = append(app.Flags, libapp.NewSentryFlags()...)
 	app.Flags = append(app.Flags, coreclient.NewCoreFlag())
 	app.Flags = append(app.Flags, migration.NewMigrationFolderPathFlag())
+	app.Flags = append(app.Flags, configuration.NewEventBusCliFlags()...)
 
 	app.Flags = append(app.Flags,
 		cli.StringFlag{
@@ -73,11 +91,41 @@ func main() {
 			Usage:  "binance secret key",
 			EnvVar: "BINANCE_SECRET_KEY",
 		},
+		cli.DurationFlag{
+			Name:   intervalSyncExchangeInfoFlag,
+			Usage:  "interval sync trading pairs and withdraw fee on db with live exchange info",
+			Value:  defaultIntervalSyncExchangeInfo,
+			EnvVar: "INTERVAL_SYNC_EXCHANGE_INFO",
+		},
+		cli.Float64Flag{
+			Name:   amountLimitToleranceFlag,
+			Usage:  "max relative change of trading pair amount limits applied without setting change",
+			Value:  defaultAmountLimitTolerance,
+			EnvVar: "EXCHANGE_INFO_AMOUNT_LIMIT_TOLERANCE",
+		},
+		cli.Float64Flag{
+			Name:   priceLimitToleranceFlag,
+			Usage:  "max relative change of trading pair price limits applied without setting change",
+			Value:  defaultPriceLimitTolerance,
+			EnvVar: "EXCHANGE_INFO_PRICE_LIMIT_TOLERANCE",
+		},
+		cli.Float64Flag{
+			Name:   minNotionalToleranceFlag,
+			Usage:  "max relative change of trading pair min notional applied without setting change",
+			Value:  defaultMinNotionalTolerance,
+			EnvVar: "EXCHANGE_INFO_MIN_NOTIONAL_TOLERANCE",
+		},
+		cli.Float64Flag{
+			Name:   withdrawFeeToleranceFlag,
+			Usage:  "max relative change of withdraw fee applied without setting change",
+			Value:  defaultWithdrawFeeTolerance,
+			EnvVar: "EXCHANGE_INFO_WITHDRAW_FEE_TOLERANCE",
+		},
 		cli.DurationFlag{
 			Name:   intervalUpdateWithdrawFeeDBFlag,
-			Usage:  "interval update withdraw fee on db",
-			Value:  defaultIntervalUpdateWithdrawFeeDB,
+			Usage:  "deprecated, use " + intervalSyncExchangeInfoFlag,
 			EnvVar: "INTERVAL_UPDATE_WITHDRAW_FEE_DB",
+			Hidden: true,
 		},
 		cli.DurationFlag{
 			Name:   intervalUpdateWithdrawFeeLiveFlag,
@@ -113,6 +161,10 @@ func run(c *cli.Context) error {
 		flusher()
 	}()
 	zap.ReplaceGlobals(sugar.Desugar())
+	if c.IsSet(intervalUpdateWithdrawFeeDBFlag) {
+		sugar.Warnw("flag is deprecated and ignored", "flag", intervalUpdateWithdrawFeeDBFlag,
+			"use", intervalSyncExchangeInfoFlag)
+	}
 
 	host := httputil.NewHTTPAddressFromContext(c)
 	db, err := configuration.NewDBFromContext(c)
@@ -129,7 +181,12 @@ func run(c *cli.Context) error {
 		return err
 	}
 
-	enableExchanges, err := configuration.NewExchangesFromContext(c)
+	sr, err := postgres.NewStorage(db)
+	if err != nil {
+		return err
+	}
+
+	enableExchanges, err := configuration.NewExchangesFromContext(c, sr)
 	if err != nil {
 		return fmt.Errorf("failed to get enabled exchanges: %s", err)
 	}
@@ -139,14 +196,18 @@ func run(c *cli.Context) error {
 
 	binanceSigner := binance.NewSigner(c.String(binanceAPIKeyFlag), c.String(binanceSecretKeyFlag))
 	httpClient := &http.Client{Timeout: time.Second * 30}
-	binanceEndpoint := binance.NewBinanceEndpoint(binanceSigner, bi, dpl, httpClient, rtypes.Binance, "", "", "", nil)
 	hi := configuration.NewhuobiInterfaceFromContext(c)
 
 	// dummy signer as live infos does not need to sign
 	huobiSigner := huobi.NewSigner("", "")
-	huobiEndpoint := huobi.NewHuobiEndpoint(huobiSigner, hi, httpClient, "")
 	// TODO: binance exchange here must be use public function only.
-	liveExchanges, err := getLiveExchanges(enableExchanges, binanceEndpoint, huobiEndpoint, c.Duration(intervalUpdateWithdrawFeeLiveFlag))
+	newBinanceEndpoint := func(id rtypes.ExchangeID) exchange.BinanceInterface {
+		return binance.NewBinanceEndpoint(binanceSigner, bi, dpl, httpClient, id, "", "", "", nil)
+	}
+	newHuobiEndpoint := func(id rtypes.ExchangeID) exchange.HuobiInterface {
+		return huobi.NewHuobiEndpoint(huobiSigner, hi, httpClient, "", id)
+	}
+	liveExchanges, err := getLiveExchanges(enableExchanges, newBinanceEndpoint, newHuobiEndpoint, c.Duration(intervalUpdateWithdrawFeeLiveFlag))
 	if err != nil {
 		return fmt.Errorf("failed to initiate live exchanges: %s", err)
 	}
@@ -156,19 +217,15 @@ func run(c *cli.Context) error {
 		sugar.Error("core endpoint is not provided, if you create new asset, you cannot update token indice, please provide.")
 		return err
 	}
-	sr, err := postgres.NewStorage(db)
-	if err != nil {
-		return err
-	}
-
-	// run interval update
+	// run interval sync
 	if len(enableExchanges) > 0 {
-		go func() {
-			t := time.NewTicker(c.Duration(intervalUpdateWithdrawFeeDBFlag))
-			for range t.C {
-				runUpdateWithdrawFee(sugar, liveExchanges, sr)
-			}
-		}()
+		syncer := exchangeinfo.NewSyncer(sr, liveExchanges, exchangeinfo.Tolerance{
+			AmountLimit: c.Float64(amountLimitToleranceFlag),
+			PriceLimit:  c.Float64(priceLimitToleranceFlag),
+			MinNotional: c.Float64(minNotionalToleranceFlag),
+			WithdrawFee: c.Float64(withdrawFeeToleranceFlag),
+		})
+		go syncer.Run(c.Duration(intervalSyncExchangeInfoFlag))
 	}
 
 	sentryDSN := libapp.SentryDSNFromFlag(c)
@@ -177,51 +234,38 @@ func run(c *cli.Context) error {
 	if profiler.IsEnableProfilerFromContext(c) {
 		server.EnableProfiler()
 	}
+	eventBus, err := configuration.NewEventBusFromContext(c)
+	if err != nil {
+		return err
+	}
+	server.SetEventBus(eventBus)
+	webhookDispatcher := webhook.NewDispatcher(sr, &http.Client{Timeout: webhookTimeout})
+	webhookDispatcher.Attach(eventBus)
+	server.SetWebhookDispatcher(webhookDispatcher)
 	server.Run()
 	return nil
 }
 
-func getLiveExchanges(enabledExchanges []rtypes.ExchangeID,
-	bi exchange.BinanceInterface,
-	hi exchange.HuobiInterface,
+// getLiveExchanges creates live exchanges of the stored exchange accounts, each account has its own
+// endpoint of its venue.
+func getLiveExchanges(enabledExchanges []common.Exchange,
+	newBinanceEndpoint func(id rtypes.ExchangeID) exchange.BinanceInterface,
+	newHuobiEndpoint func(id rtypes.ExchangeID) exchange.HuobiInterface,
 	intervalUpdateWithdrawFee time.Duration) (map[rtypes.ExchangeID]v1common.LiveExchange, error) {
 	var (
 		liveExchanges = make(map[rtypes.ExchangeID]v1common.LiveExchange)
 	)
-	for _, exchangeID := range enabledExchanges {
-		switch exchangeID {
-		case rtypes.Binance, rtypes.Binance2:
-			binanceLive := exchange.NewBinanceLive(bi)
+	for _, ex := range enabledExchanges {
+		switch ex.Venue {
+		case common.VenueBinance:
+			binanceLive := exchange.NewBinanceLive(newBinanceEndpoint(ex.ID))
 			go binanceLive.RunUpdateAssetDetails(intervalUpdateWithdrawFee)
-			liveExchanges[exchangeID] = binanceLive
-		case rtypes.Huobi:
-			huobiLive := exchange.NewHuobiLive(hi)
+			liveExchanges[ex.ID] = binanceLive
+		case common.VenueHuobi:
+			huobiLive := exchange.NewHuobiLive(newHuobiEndpoint(ex.ID))
 			go huobiLive.RunUpdateAssetDetails(intervalUpdateWithdrawFee)
-			liveExchanges[exchangeID] = huobiLive
+			liveExchanges[ex.ID] = huobiLive
 		}
 	}
 	return liveExchanges, nil
 }
-
-func runUpdateWithdrawFee(sugar *zap.SugaredLogger, exchanges map[rtypes.ExchangeID]v1common.LiveExchange, s storage.Interface) {
-	assets, err := s.GetAssets()
-	if err != nil {
-		sugar.Errorw("cannot get assets", "err", err)
-		return
-	}
-	for _, asset := range assets {
-		for _, ae := range asset.Exchanges {
-			if le, ok := exchanges[ae.ExchangeID]; ok {
-				withdrawFee, err := le.GetLiveWithdrawFee(ae.Symbol)
-				if err != nil {
-					sugar.Warnw("cannot get live withdraw fee", "err", err, "exchange symbol", ae.Symbol, "token symbol", asset.Symbol)
-					continue
-				}
-				if err := s.UpdateAssetExchangeWithdrawFee(withdrawFee, ae.ID); err != nil {
-					sugar.Errorw("cannot update asset exchange", "err", err)
-					return
-				}
-			}
-		}
-	}
-}
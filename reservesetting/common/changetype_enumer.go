@@ -8,9 +8,9 @@ import (
 	"fmt"
 )
 
-const _ChangeTypeName = "create_assetupdate_assetcreate_asset_exchangeupdate_asset_exchangecreate_trading_pairupdate_exchangechange_asset_addrdelete_trading_pairdelete_asset_exchangeupdate_stable_token_paramsset_feed_configuration"
+const _ChangeTypeName = "create_assetupdate_assetcreate_asset_exchangeupdate_asset_exchangecreate_trading_pairupdate_exchangechange_asset_addrdelete_trading_pairdelete_asset_exchangeupdate_stable_token_paramsset_feed_configurationupdate_trading_pairupdate_risk_limitsadd_allowed_addressremove_allowed_addresscreate_exchangeset_step_functionset_token_control_inforeserve_admin"
 
-var _ChangeTypeIndex = [...]uint8{0, 12, 24, 45, 66, 85, 100, 117, 136, 157, 183, 205}
+var _ChangeTypeIndex = [...]uint16{0, 12, 24, 45, 66, 85, 100, 117, 136, 157, 183, 205, 224, 242, 261, 283, 298, 315, 337, 350}
 
 func (i ChangeType) String() string {
 	if i < 0 || i >= ChangeType(len(_ChangeTypeIndex)-1) {
@@ -19,7 +19,7 @@ func (i ChangeType) String() string {
 	return _ChangeTypeName[_ChangeTypeIndex[i]:_ChangeTypeIndex[i+1]]
 }
 
-var _ChangeTypeValues = []ChangeType{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+var _ChangeTypeValues = []ChangeType{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}
 
 var _ChangeTypeNameToValueMap = map[string]ChangeType{
 	_ChangeTypeName[0:12]:    0,
@@ -33,6 +33,14 @@ var _ChangeTypeNameToValueMap = map[string]ChangeType{
 	_ChangeTypeName[136:157]: 8,
 	_ChangeTypeName[157:183]: 9,
 	_ChangeTypeName[183:205]: 10,
+	_ChangeTypeName[205:224]: 11,
+	_ChangeTypeName[224:242]: 12,
+	_ChangeTypeName[242:261]: 13,
+	_ChangeTypeName[261:283]: 14,
+	_ChangeTypeName[283:298]: 15,
+	_ChangeTypeName[298:315]: 16,
+	_ChangeTypeName[315:337]: 17,
+	_ChangeTypeName[337:350]: 18,
 }
 
 // ChangeTypeString retrieves an enum value from the enum constants string name.
@@ -58,4 +58,8 @@ var (
 	ErrNormalUpdaterPerPeriodNotPositive = errors.New("normal update per period is not positive")
 	// ErrMaxImbalanceRatioNotPositive is return if max imbalance ratio <= 0
 	ErrMaxImbalanceRatioNotPositive = errors.New("max imbalance ratio is not positive")
+	// ErrAllowedAddressExists is returned when adding an address already in the allowlist.
+	ErrAllowedAddressExists = errors.New("address already in allowlist")
+	// ErrExchangeExists is returned when creating an exchange account with duplicated name.
+	ErrExchangeExists = errors.New("exchange already exists")
 )
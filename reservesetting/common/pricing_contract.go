@@ -0,0 +1,167 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+const (
+	// MaxStepFunctionSteps is the max number of steps of a step function in the conversion rates contract.
+	MaxStepFunctionSteps = 10
+	// MinStepFunctionBps is the lowest rate adjustment of a step, a step can not make the rate negative.
+	MinStepFunctionBps = -10000
+)
+
+// operations of the pricing contract txs sent for step function and control info changes.
+const (
+	// PricingContractSetQtyStepFunction sets the quantity step functions of a token.
+	PricingContractSetQtyStepFunction = "set_qty_step_function"
+	// PricingContractSetImbalanceStepFunction sets the imbalance step functions of a token.
+	PricingContractSetImbalanceStepFunction = "set_imbalance_step_function"
+	// PricingContractSetTokenControlInfo sets the control info of a token.
+	PricingContractSetTokenControlInfo = "set_token_control_info"
+)
+
+// StepFunction is a step function of the conversion rates contract, the rate is adjusted by Y[i] bps
+// when the quantity or imbalance (in token unit) is X[i] or less. A step function without steps
+// does not adjust the rate.
+type StepFunction struct {
+	X []float64 `json:"x"`
+	Y []int64   `json:"y"`
+}
+
+// Validate returns an error if the contract would reject the step function.
+func (f StepFunction) Validate() error {
+	if len(f.X) != len(f.Y) {
+		return fmt.Errorf("x has %d steps but y has %d", len(f.X), len(f.Y))
+	}
+	if len(f.X) > MaxStepFunctionSteps {
+		return fmt.Errorf("%d steps exceed max %d", len(f.X), MaxStepFunctionSteps)
+	}
+	for i := range f.X {
+		if i > 0 && f.X[i] <= f.X[i-1] {
+			return fmt.Errorf("x must be increasing, x[%d]=%v after %v", i, f.X[i], f.X[i-1])
+		}
+		if f.Y[i] < MinStepFunctionBps {
+			return fmt.Errorf("y[%d]=%d is less than %d bps", i, f.Y[i], MinStepFunctionBps)
+		}
+	}
+	return nil
+}
+
+// SetStepFunctionEntry sets the quantity and imbalance step functions of an asset in the pricing contract.
+type SetStepFunctionEntry struct {
+	settingChangeMarker
+	AssetID       rtypes.AssetID `json:"asset_id" binding:"required"`
+	QtyBuy        StepFunction   `json:"qty_buy"`
+	QtySell       StepFunction   `json:"qty_sell"`
+	ImbalanceBuy  StepFunction   `json:"imbalance_buy"`
+	ImbalanceSell StepFunction   `json:"imbalance_sell"`
+}
+
+// Validate returns an error if any step function is invalid.
+func (e SetStepFunctionEntry) Validate() error {
+	for name, f := range map[string]StepFunction{
+		"qty_buy":        e.QtyBuy,
+		"qty_sell":       e.QtySell,
+		"imbalance_buy":  e.ImbalanceBuy,
+		"imbalance_sell": e.ImbalanceSell,
+	} {
+		if err := f.Validate(); err != nil {
+			return fmt.Errorf("invalid %s step function: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// SetTokenControlInfoEntry sets the imbalance control of an asset in the pricing contract, amounts
+// are in token unit. Imbalances are recorded in multiples of MinimalRecordResolution and trades are
+// rejected once the imbalance of a block or in total exceeds its max.
+type SetTokenControlInfoEntry struct {
+	settingChangeMarker
+	AssetID                 rtypes.AssetID `json:"asset_id" binding:"required"`
+	MinimalRecordResolution float64        `json:"minimal_record_resolution"`
+	MaxPerBlockImbalance    float64        `json:"max_per_block_imbalance"`
+	MaxTotalImbalance       float64        `json:"max_total_imbalance"`
+}
+
+// Validate returns an error if the control info is invalid.
+func (e SetTokenControlInfoEntry) Validate() error {
+	if e.MinimalRecordResolution <= 0 {
+		return fmt.Errorf("minimal_record_resolution must be positive, got %v", e.MinimalRecordResolution)
+	}
+	if e.MaxPerBlockImbalance < e.MinimalRecordResolution {
+		return fmt.Errorf("max_per_block_imbalance %v must not be less than minimal_record_resolution %v",
+			e.MaxPerBlockImbalance, e.MinimalRecordResolution)
+	}
+	if e.MaxTotalImbalance < e.MaxPerBlockImbalance {
+		return fmt.Errorf("max_total_imbalance %v must not be less than max_per_block_imbalance %v",
+			e.MaxTotalImbalance, e.MaxPerBlockImbalance)
+	}
+	return nil
+}
+
+// PricingStepFunction is a step function in pricing contract units, X in token wei and Y in bps.
+type PricingStepFunction struct {
+	X []*big.Int `json:"x"`
+	Y []*big.Int `json:"y"`
+}
+
+// Equal returns true if both step functions have the same steps.
+func (f PricingStepFunction) Equal(other PricingStepFunction) bool {
+	return equalBigInts(f.X, other.X) && equalBigInts(f.Y, other.Y)
+}
+
+// PricingStepFunctions are the step functions of a token in the pricing contract.
+type PricingStepFunctions struct {
+	Token         ethereum.Address    `json:"token"`
+	QtyBuy        PricingStepFunction `json:"qty_buy"`
+	QtySell       PricingStepFunction `json:"qty_sell"`
+	ImbalanceBuy  PricingStepFunction `json:"imbalance_buy"`
+	ImbalanceSell PricingStepFunction `json:"imbalance_sell"`
+}
+
+// Equal returns true if all step functions are equal.
+func (f PricingStepFunctions) Equal(other PricingStepFunctions) bool {
+	return f.Token == other.Token &&
+		f.QtyBuy.Equal(other.QtyBuy) && f.QtySell.Equal(other.QtySell) &&
+		f.ImbalanceBuy.Equal(other.ImbalanceBuy) && f.ImbalanceSell.Equal(other.ImbalanceSell)
+}
+
+// PricingControlInfo is the control info of a token in the pricing contract, in token wei.
+type PricingControlInfo struct {
+	Token                   ethereum.Address `json:"token"`
+	MinimalRecordResolution *big.Int         `json:"minimal_record_resolution"`
+	MaxPerBlockImbalance    *big.Int         `json:"max_per_block_imbalance"`
+	MaxTotalImbalance       *big.Int         `json:"max_total_imbalance"`
+}
+
+// Equal returns true if both control infos have the same values.
+func (i PricingControlInfo) Equal(other PricingControlInfo) bool {
+	return i.Token == other.Token && equalBigInts(
+		[]*big.Int{i.MinimalRecordResolution, i.MaxPerBlockImbalance, i.MaxTotalImbalance},
+		[]*big.Int{other.MinimalRecordResolution, other.MaxPerBlockImbalance, other.MaxTotalImbalance},
+	)
+}
+
+func equalBigInts(a, b []*big.Int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] == nil || b[i] == nil {
+			if a[i] != b[i] {
+				return false
+			}
+			continue
+		}
+		if a[i].Cmp(b[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
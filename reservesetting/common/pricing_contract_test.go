@@ -0,0 +1,52 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepFunctionValidate(t *testing.T) {
+	require.NoError(t, StepFunction{}.Validate())
+	require.NoError(t, StepFunction{X: []float64{-10, 0, 10}, Y: []int64{-20, 0, 30}}.Validate())
+
+	require.Error(t, StepFunction{X: []float64{1, 2}, Y: []int64{1}}.Validate())
+	require.Error(t, StepFunction{X: []float64{2, 1}, Y: []int64{1, 2}}.Validate())
+	require.Error(t, StepFunction{X: []float64{1}, Y: []int64{MinStepFunctionBps - 1}}.Validate())
+	tooMany := StepFunction{}
+	for i := 0; i <= MaxStepFunctionSteps; i++ {
+		tooMany.X = append(tooMany.X, float64(i))
+		tooMany.Y = append(tooMany.Y, 0)
+	}
+	require.Error(t, tooMany.Validate())
+
+	entry := SetStepFunctionEntry{AssetID: 1, ImbalanceSell: StepFunction{X: []float64{1}}}
+	require.Error(t, entry.Validate())
+}
+
+func TestSetTokenControlInfoEntryValidate(t *testing.T) {
+	require.NoError(t, SetTokenControlInfoEntry{
+		AssetID: 1, MinimalRecordResolution: 0.001, MaxPerBlockImbalance: 100, MaxTotalImbalance: 200,
+	}.Validate())
+	require.Error(t, SetTokenControlInfoEntry{
+		AssetID: 1, MinimalRecordResolution: 0, MaxPerBlockImbalance: 100, MaxTotalImbalance: 200,
+	}.Validate())
+	require.Error(t, SetTokenControlInfoEntry{
+		AssetID: 1, MinimalRecordResolution: 0.001, MaxPerBlockImbalance: 300, MaxTotalImbalance: 200,
+	}.Validate())
+}
+
+func TestPricingStepFunctionsEqual(t *testing.T) {
+	a := PricingStepFunctions{
+		QtyBuy: PricingStepFunction{X: []*big.Int{big.NewInt(10)}, Y: []*big.Int{big.NewInt(-5)}},
+	}
+	b := PricingStepFunctions{
+		QtyBuy:  PricingStepFunction{X: []*big.Int{big.NewInt(10)}, Y: []*big.Int{big.NewInt(-5)}},
+		QtySell: PricingStepFunction{X: []*big.Int{}, Y: []*big.Int{}},
+	}
+	require.True(t, a.Equal(b))
+
+	b.QtyBuy.Y[0] = big.NewInt(-6)
+	require.False(t, a.Equal(b))
+}
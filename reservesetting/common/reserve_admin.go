@@ -0,0 +1,78 @@
+package common
+
+import (
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+// Admin operations of the reserve contract.
+const (
+	// ReserveAdminEnableTrade enables trades of the reserve.
+	ReserveAdminEnableTrade = "enable_trade"
+	// ReserveAdminDisableTrade disables trades of the reserve, the reserve admin must be an alerter.
+	ReserveAdminDisableTrade = "disable_trade"
+	// ReserveAdminApproveWithdrawAddress approves or revokes an address that an asset can be withdrawn to.
+	ReserveAdminApproveWithdrawAddress = "approve_withdraw_address"
+	// ReserveAdminWithdrawToAdmin withdraws an asset from the reserve to the reserve admin.
+	ReserveAdminWithdrawToAdmin = "withdraw_to_admin"
+	// ReserveAdminSetContracts sets the network, conversion rates and sanity rates contracts of the reserve.
+	ReserveAdminSetContracts = "set_contracts"
+)
+
+// ReserveAdminEntry is an admin operation of the reserve contract, core sends it with the reserve
+// admin operator when the setting change is confirmed.
+type ReserveAdminEntry struct {
+	settingChangeMarker
+	Operation string `json:"operation" binding:"required"`
+	// AssetID is the asset of approve_withdraw_address and withdraw_to_admin.
+	AssetID rtypes.AssetID `json:"asset_id,omitempty"`
+	// Address is the withdraw address of approve_withdraw_address, Approve false revokes it.
+	Address ethereum.Address `json:"address,omitempty"`
+	Approve bool             `json:"approve,omitempty"`
+	// Amount is the amount in token unit of withdraw_to_admin.
+	Amount float64 `json:"amount,omitempty"`
+	// Network, ConversionRates and SanityRates are the contracts of set_contracts, SanityRates
+	// may be zero.
+	Network         ethereum.Address `json:"network,omitempty"`
+	ConversionRates ethereum.Address `json:"conversion_rates,omitempty"`
+	SanityRates     ethereum.Address `json:"sanity_rates,omitempty"`
+}
+
+// Validate returns an error if the operation is unknown or misses its parameters.
+func (e ReserveAdminEntry) Validate() error {
+	switch e.Operation {
+	case ReserveAdminEnableTrade, ReserveAdminDisableTrade:
+		return nil
+	case ReserveAdminApproveWithdrawAddress:
+		if e.AssetID == 0 {
+			return fmt.Errorf("asset_id is required for %s", e.Operation)
+		}
+		if IsZeroAddress(e.Address) {
+			return ErrAddressMissing
+		}
+		return nil
+	case ReserveAdminWithdrawToAdmin:
+		if e.AssetID == 0 {
+			return fmt.Errorf("asset_id is required for %s", e.Operation)
+		}
+		if e.Amount <= 0 {
+			return fmt.Errorf("amount must be positive, got %v", e.Amount)
+		}
+		return nil
+	case ReserveAdminSetContracts:
+		if IsZeroAddress(e.Network) || IsZeroAddress(e.ConversionRates) {
+			return fmt.Errorf("network and conversion_rates are required for %s: %w", e.Operation, ErrAddressMissing)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown reserve admin operation %q", e.Operation)
+	}
+}
+
+// NeedsAsset returns true if the operation applies to an asset.
+func (e ReserveAdminEntry) NeedsAsset() bool {
+	return e.Operation == ReserveAdminApproveWithdrawAddress || e.Operation == ReserveAdminWithdrawToAdmin
+}
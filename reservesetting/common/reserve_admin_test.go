@@ -0,0 +1,22 @@
+package common
+
+import (
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReserveAdminEntryValidate(t *testing.T) {
+	addr := ethereum.HexToAddress("0x3f105f78359ad80562b4c34296a87b8e66c584c5")
+
+	require.NoError(t, ReserveAdminEntry{Operation: ReserveAdminDisableTrade}.Validate())
+	require.NoError(t, ReserveAdminEntry{Operation: ReserveAdminApproveWithdrawAddress, AssetID: 1, Address: addr}.Validate())
+	require.NoError(t, ReserveAdminEntry{Operation: ReserveAdminWithdrawToAdmin, AssetID: 1, Amount: 1}.Validate())
+	require.NoError(t, ReserveAdminEntry{Operation: ReserveAdminSetContracts, Network: addr, ConversionRates: addr}.Validate())
+
+	require.Error(t, ReserveAdminEntry{Operation: "withdraw"}.Validate())
+	require.Error(t, ReserveAdminEntry{Operation: ReserveAdminApproveWithdrawAddress, AssetID: 1}.Validate())
+	require.Error(t, ReserveAdminEntry{Operation: ReserveAdminWithdrawToAdmin, AssetID: 1, Amount: -1}.Validate())
+	require.Error(t, ReserveAdminEntry{Operation: ReserveAdminSetContracts, Network: addr}.Validate())
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+)
+
+// AssetGasPolicy decides how much a set rate tx may pay for gas based on how stale the
+// on-chain rate of an asset is.
+//
+// The urgency of an update is the larger of deviation/DeviationThreshold and
+// blocks/BlocksThreshold, where deviation is the relative difference between the computed
+// and the on-chain rate and blocks is the number of blocks since the on-chain rate was set.
+// An urgency of 1 or more means the update is urgent.
+type AssetGasPolicy struct {
+	// DeviationThreshold is the relative rate deviation that makes an update urgent, e.g 0.01 for 1%.
+	DeviationThreshold float64 `json:"deviation_threshold"`
+	// BlocksThreshold is the number of blocks since the last update that makes an update urgent.
+	BlocksThreshold uint64 `json:"blocks_threshold"`
+	// MinMultiplier is applied to the recommended gas price for an update with no urgency.
+	MinMultiplier float64 `json:"min_multiplier"`
+	// MaxMultiplier is applied to the recommended gas price for an urgent update.
+	MaxMultiplier float64 `json:"max_multiplier"`
+	// TrivialMaxGasPrice caps the gas price (gwei) of an update that is not urgent, 0 means no cap.
+	TrivialMaxGasPrice float64 `json:"trivial_max_gas_price"`
+}
+
+// Validate returns an error if the policy can not be used.
+func (p AssetGasPolicy) Validate() error {
+	if p.DeviationThreshold <= 0 {
+		return fmt.Errorf("deviation_threshold must be positive, got %v", p.DeviationThreshold)
+	}
+	if p.BlocksThreshold == 0 {
+		return fmt.Errorf("blocks_threshold must be positive")
+	}
+	if p.MinMultiplier <= 0 {
+		return fmt.Errorf("min_multiplier must be positive, got %v", p.MinMultiplier)
+	}
+	if p.MaxMultiplier < p.MinMultiplier {
+		return fmt.Errorf("max_multiplier %v must not be less than min_multiplier %v", p.MaxMultiplier, p.MinMultiplier)
+	}
+	if p.TrivialMaxGasPrice < 0 {
+		return fmt.Errorf("trivial_max_gas_price must not be negative, got %v", p.TrivialMaxGasPrice)
+	}
+	return nil
+}
+
+// Urgency returns the urgency of an update with given relative deviation and number of
+// blocks since the last update.
+func (p AssetGasPolicy) Urgency(deviation float64, blocks uint64) float64 {
+	return math.Max(
+		math.Abs(deviation)/p.DeviationThreshold,
+		float64(blocks)/float64(p.BlocksThreshold),
+	)
+}
+
+// Multiplier returns the gas price multiplier for urgency, it grows linearly from
+// MinMultiplier at urgency 0 to MaxMultiplier at urgency 1.
+func (p AssetGasPolicy) Multiplier(urgency float64) float64 {
+	return p.MinMultiplier + (p.MaxMultiplier-p.MinMultiplier)*math.Min(math.Max(urgency, 0), 1)
+}
+
+// MaxGasPrice returns the highest gas price (gwei) allowed for urgency, bounded by highBound.
+func (p AssetGasPolicy) MaxGasPrice(urgency, highBound float64) float64 {
+	if urgency >= 1 || p.TrivialMaxGasPrice == 0 {
+		return highBound
+	}
+	return math.Min(p.TrivialMaxGasPrice, highBound)
+}
+
+// SetRateGasPolicy is the set rate gas policy of all assets, assets without their own policy
+// use the default one.
+type SetRateGasPolicy struct {
+	Default AssetGasPolicy                    `json:"default"`
+	Assets  map[rtypes.AssetID]AssetGasPolicy `json:"assets"`
+}
+
+// Validate returns an error if any policy is invalid.
+func (p SetRateGasPolicy) Validate() error {
+	if err := p.Default.Validate(); err != nil {
+		return fmt.Errorf("invalid default policy: %w", err)
+	}
+	for assetID, policy := range p.Assets {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid policy of asset %d: %w", assetID, err)
+		}
+	}
+	return nil
+}
+
+// AssetPolicy returns the policy of asset.
+func (p SetRateGasPolicy) AssetPolicy(assetID rtypes.AssetID) AssetGasPolicy {
+	if policy, ok := p.Assets[assetID]; ok {
+		return policy
+	}
+	return p.Default
+}
@@ -8,10 +8,23 @@ import (
 	rtypes "github.com/KyberNetwork/reserve-data/lib/rtypes"
 )
 
-// Exchange represents a centralized exchange in database.
+// Venue names of supported centralized exchanges, an exchange account always belongs to one of them.
+const (
+	VenueBinance = "binance"
+	VenueHuobi   = "huobi"
+)
+
+// ValidVenue returns true if venue is a supported centralized exchange.
+func ValidVenue(venue string) bool {
+	return venue == VenueBinance || venue == VenueHuobi
+}
+
+// Exchange represents an account on a centralized exchange in database.
 type Exchange struct {
 	ID              rtypes.ExchangeID `json:"id"`
 	Name            string            `json:"name"`
+	Venue           string            `json:"venue"`
+	Credentials     string            `json:"credentials"`
 	TradingFeeMaker float64           `json:"trading_fee_maker"`
 	TradingFeeTaker float64           `json:"trading_fee_taker"`
 	Disable         bool              `json:"disable"`
@@ -178,6 +191,7 @@ type UpdateAssetExchangeEntry struct {
 	Symbol            *string                `json:"symbol"`
 	DepositAddress    *ethereum.Address      `json:"deposit_address"`
 	MinDeposit        *float64               `json:"min_deposit"`
+	WithdrawFee       *float64               `json:"withdraw_fee"`
 	TargetRecommended *float64               `json:"target_recommended"`
 	TargetRatio       *float64               `json:"target_ratio"`
 }
@@ -237,6 +251,17 @@ type UpdateExchangeEntry struct {
 	Disable         *bool             `json:"disable"`
 }
 
+// CreateExchangeEntry defines a new account on a centralized exchange,
+// credentials is the name of the key pair in core config used by this account.
+type CreateExchangeEntry struct {
+	settingChangeMarker
+	Name            string   `json:"name" binding:"required"`
+	Venue           string   `json:"venue" binding:"required"`
+	Credentials     string   `json:"credentials" binding:"required"`
+	TradingFeeMaker *float64 `json:"trading_fee_maker"`
+	TradingFeeTaker *float64 `json:"trading_fee_taker"`
+}
+
 // CreateTradingPairEntry represents an trading pair in central exchange.
 // this is use when create new trading pair in separate step(not when define Asset), so ExchangeID is required.
 type CreateTradingPairEntry struct {
@@ -246,6 +271,77 @@ type CreateTradingPairEntry struct {
 	ExchangeID rtypes.ExchangeID `json:"exchange_id"`
 }
 
+// UpdateTradingPairEntry is the precision and limits of a trading pair to be update.
+type UpdateTradingPairEntry struct {
+	settingChangeMarker
+	ID              rtypes.TradingPairID `json:"id"`
+	PricePrecision  *uint64              `json:"price_precision"`
+	AmountPrecision *uint64              `json:"amount_precision"`
+	AmountLimitMin  *float64             `json:"amount_limit_min"`
+	AmountLimitMax  *float64             `json:"amount_limit_max"`
+	PriceLimitMin   *float64             `json:"price_limit_min"`
+	PriceLimitMax   *float64             `json:"price_limit_max"`
+	MinNotional     *float64             `json:"min_notional"`
+}
+
+// RiskLimits are the limits core checks before placing an order, withdrawing or depositing. Maps
+// are keyed by asset id, a zero or missing limit disables the check.
+type RiskLimits struct {
+	// MaxOrderNotional is the max rate * amount of an order, keyed by quote asset.
+	MaxOrderNotional map[rtypes.AssetID]float64 `json:"max_order_notional"`
+	// MaxHourlyNotional is the max total notional of orders in the last hour, keyed by quote asset.
+	MaxHourlyNotional map[rtypes.AssetID]float64 `json:"max_hourly_notional"`
+	// MaxPriceDeviation is the max relative difference between order rate and mid price of the
+	// latest exchange price, e.g 0.02 for 2%.
+	MaxPriceDeviation float64 `json:"max_price_deviation"`
+	// MaxDailyLoss is the max loss of orders in the last 24 hours valued at current mid price,
+	// keyed by quote asset.
+	MaxDailyLoss map[rtypes.AssetID]float64 `json:"max_daily_loss"`
+	// MaxDailyWithdraw is the max total amount withdrawn in the last 24 hours, keyed by asset.
+	MaxDailyWithdraw map[rtypes.AssetID]float64 `json:"max_daily_withdraw"`
+}
+
+// UpdateRiskLimitsEntry replaces the risk limits of core.
+type UpdateRiskLimitsEntry struct {
+	settingChangeMarker
+	RiskLimits
+}
+
+// Types of allowed address, a deposit address receives funds deposited to an exchange and a
+// withdraw address receives funds withdrawn from an exchange.
+const (
+	AllowedAddressDeposit  = "deposit"
+	AllowedAddressWithdraw = "withdraw"
+)
+
+// AllowedAddress is an address core is allowed to send an asset to when depositing to or
+// withdrawing from an exchange.
+type AllowedAddress struct {
+	ID          uint64            `json:"id"`
+	AssetID     rtypes.AssetID    `json:"asset_id"`
+	ExchangeID  rtypes.ExchangeID `json:"exchange_id"`
+	Type        string            `json:"type"`
+	Address     ethereum.Address  `json:"address"`
+	Description string            `json:"description"`
+	Created     time.Time         `json:"created"`
+}
+
+// AddAllowedAddressEntry adds an address to the allowlist of an asset on an exchange.
+type AddAllowedAddressEntry struct {
+	settingChangeMarker
+	AssetID     rtypes.AssetID    `json:"asset_id" binding:"required"`
+	ExchangeID  rtypes.ExchangeID `json:"exchange_id" binding:"required"`
+	Type        string            `json:"type" binding:"required"`
+	Address     ethereum.Address  `json:"address" binding:"required"`
+	Description string            `json:"description"`
+}
+
+// RemoveAllowedAddressEntry removes an address from the allowlist.
+type RemoveAllowedAddressEntry struct {
+	settingChangeMarker
+	ID uint64 `json:"id" binding:"required"`
+}
+
 // ChangeAssetAddressEntry present data to create a change asset address
 type ChangeAssetAddressEntry struct {
 	settingChangeMarker
@@ -275,6 +371,11 @@ const (
 	ChangeCatalogUpdateExchange                          // update_exchange
 	ChangeCatalogMain                                    // main
 	ChangeCatalogFeedConfiguration                       // set_feed_configuration
+	ChangeCatalogExchangeInfo                            // exchange_info
+	ChangeCatalogRiskLimits                              // risk_limits
+	ChangeCatalogAddressAllowlist                        // address_allowlist
+	ChangeCatalogPricingContract                         // pricing_contract
+	ChangeCatalogReserveAdmin                            // reserve_admin
 )
 
 // ChangeType represent type of change type entry in list change
@@ -304,6 +405,22 @@ const (
 	ChangeTypeUpdateStableTokenParams // update_stable_token_params
 	// ChangeTypeSetFeedConfiguration is used when set feed confuguration
 	ChangeTypeSetFeedConfiguration // set_feed_configuration
+	// ChangeTypeUpdateTradingPair is used when update precision and limits of a trading pair
+	ChangeTypeUpdateTradingPair // update_trading_pair
+	// ChangeTypeUpdateRiskLimits is used when update risk limits of core
+	ChangeTypeUpdateRiskLimits // update_risk_limits
+	// ChangeTypeAddAllowedAddress is used when add an address to address allowlist
+	ChangeTypeAddAllowedAddress // add_allowed_address
+	// ChangeTypeRemoveAllowedAddress is used when remove an address from address allowlist
+	ChangeTypeRemoveAllowedAddress // remove_allowed_address
+	// ChangeTypeCreateExchange is used when create a new exchange account
+	ChangeTypeCreateExchange // create_exchange
+	// ChangeTypeSetStepFunction is used when set step functions of an asset in pricing contract
+	ChangeTypeSetStepFunction // set_step_function
+	// ChangeTypeSetTokenControlInfo is used when set control info of an asset in pricing contract
+	ChangeTypeSetTokenControlInfo // set_token_control_info
+	// ChangeTypeReserveAdmin is used when send an admin operation of reserve contract
+	ChangeTypeReserveAdmin // reserve_admin
 )
 
 // ChangeStatus represent status of change
@@ -387,4 +504,5 @@ type PreferGasSource struct {
 // AdditionalDataReturn ...
 type AdditionalDataReturn struct {
 	AddedTradingPairs []rtypes.TradingPairID
+	AddedExchanges    []rtypes.ExchangeID
 }
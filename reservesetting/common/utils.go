@@ -57,6 +57,8 @@ func SettingChangeFromType(t ChangeType) (SettingChangeType, error) {
 		i = &CreateTradingPairEntry{}
 	case ChangeTypeUpdateExchange:
 		i = &UpdateExchangeEntry{}
+	case ChangeTypeCreateExchange:
+		i = &CreateExchangeEntry{}
 	case ChangeTypeChangeAssetAddr:
 		i = &ChangeAssetAddressEntry{}
 	case ChangeTypeDeleteTradingPair:
@@ -67,6 +69,20 @@ func SettingChangeFromType(t ChangeType) (SettingChangeType, error) {
 		i = &UpdateStableTokenParamsEntry{}
 	case ChangeTypeSetFeedConfiguration:
 		i = &SetFeedConfigurationEntry{}
+	case ChangeTypeUpdateTradingPair:
+		i = &UpdateTradingPairEntry{}
+	case ChangeTypeUpdateRiskLimits:
+		i = &UpdateRiskLimitsEntry{}
+	case ChangeTypeAddAllowedAddress:
+		i = &AddAllowedAddressEntry{}
+	case ChangeTypeRemoveAllowedAddress:
+		i = &RemoveAllowedAddressEntry{}
+	case ChangeTypeSetStepFunction:
+		i = &SetStepFunctionEntry{}
+	case ChangeTypeSetTokenControlInfo:
+		i = &SetTokenControlInfoEntry{}
+	case ChangeTypeReserveAdmin:
+		i = &ReserveAdminEntry{}
 	}
 	return i, nil
 }
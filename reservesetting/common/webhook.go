@@ -0,0 +1,94 @@
+package common
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Events sent to webhook subscriptions.
+const (
+	WebhookDepositCompleted       = "deposit_completed"
+	WebhookWithdrawalFailed       = "withdrawal_failed"
+	WebhookTradeFilled            = "trade_filled"
+	WebhookSettingChangeCreated   = "setting_change_created"
+	WebhookSettingChangeConfirmed = "setting_change_confirmed"
+)
+
+// WebhookEvents returns all events sent to webhook subscriptions.
+func WebhookEvents() []string {
+	return []string{
+		WebhookDepositCompleted,
+		WebhookWithdrawalFailed,
+		WebhookTradeFilled,
+		WebhookSettingChangeCreated,
+		WebhookSettingChangeConfirmed,
+	}
+}
+
+// IsWebhookEvent returns true if event is sent to webhook subscriptions.
+func IsWebhookEvent(event string) bool {
+	for _, e := range WebhookEvents() {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSubscription is an URL notified of events, requests are signed with the key pair of the subscription.
+type WebhookSubscription struct {
+	ID      uint64    `json:"id"`
+	URL     string    `json:"url"`
+	Events  []string  `json:"events"`
+	KeyID   string    `json:"key_id"`
+	Secret  string    `json:"-"`
+	Active  bool      `json:"active"`
+	Created time.Time `json:"created"`
+}
+
+// Subscribed returns true if the subscription is active and subscribed to event.
+func (s WebhookSubscription) Subscribed(event string) bool {
+	if !s.Active {
+		return false
+	}
+	for _, e := range s.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateWebhookSubscriptionOpts are the fields of a webhook subscription to update, nil fields are unchanged.
+type UpdateWebhookSubscriptionOpts struct {
+	URL    *string  `json:"url"`
+	Events []string `json:"events"`
+	KeyID  *string  `json:"key_id"`
+	Secret *string  `json:"secret"`
+	Active *bool    `json:"active"`
+}
+
+// WebhookDelivery is an attempt to send an event to a webhook subscription.
+type WebhookDelivery struct {
+	ID             uint64    `json:"id"`
+	SubscriptionID uint64    `json:"subscription_id"`
+	EventID        string    `json:"event_id"`
+	Event          string    `json:"event"`
+	Attempt        int       `json:"attempt"`
+	StatusCode     int       `json:"status_code"`
+	Error          string    `json:"error"`
+	Success        bool      `json:"success"`
+	Created        time.Time `json:"created"`
+}
+
+// WebhookDeadLetter is an event that could not be sent to a webhook subscription after all attempts.
+type WebhookDeadLetter struct {
+	ID             uint64          `json:"id"`
+	SubscriptionID uint64          `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	Event          string          `json:"event"`
+	Payload        json.RawMessage `json:"payload"`
+	Attempts       int             `json:"attempts"`
+	Error          string          `json:"error"`
+	Created        time.Time       `json:"created"`
+}
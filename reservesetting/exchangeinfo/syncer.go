@@ -0,0 +1,295 @@
+package exchangeinfo
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	v1common "github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
+)
+
+const syncMessage = "exchange info diverged from stored configuration"
+
+// Tolerance is the maximum relative difference between a stored and a live value that
+// is applied directly without a setting change. A zero tolerance means every change of
+// the field goes through a pending setting change. Precision changes always do.
+type Tolerance struct {
+	AmountLimit float64
+	PriceLimit  float64
+	MinNotional float64
+	WithdrawFee float64
+}
+
+// Syncer periodically compares trading pairs and withdraw fees stored in database with
+// live information from exchanges.
+type Syncer struct {
+	storage   storage.Interface
+	exchanges map[rtypes.ExchangeID]v1common.LiveExchange
+	tolerance Tolerance
+	l         *zap.SugaredLogger
+}
+
+// NewSyncer creates a new Syncer instance.
+func NewSyncer(storage storage.Interface, exchanges map[rtypes.ExchangeID]v1common.LiveExchange, tolerance Tolerance) *Syncer {
+	return &Syncer{
+		storage:   storage,
+		exchanges: exchanges,
+		tolerance: tolerance,
+		l:         zap.S(),
+	}
+}
+
+// Run syncs exchange info at every interval, it never returns.
+func (s *Syncer) Run(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for range t.C {
+		if err := s.Sync(); err != nil {
+			s.l.Errorw("failed to sync exchange info", "err", err)
+		}
+	}
+}
+
+// Sync applies the differences within tolerance and creates a pending setting change in
+// exchange_info catalog for the others.
+func (s *Syncer) Sync() error {
+	var (
+		proposals []common.SettingChangeEntry
+		complete  = true
+	)
+	exchangeIDs := make([]rtypes.ExchangeID, 0, len(s.exchanges))
+	for exchangeID := range s.exchanges {
+		exchangeIDs = append(exchangeIDs, exchangeID)
+	}
+	sort.Slice(exchangeIDs, func(i, j int) bool { return exchangeIDs[i] < exchangeIDs[j] })
+	for _, exchangeID := range exchangeIDs {
+		entries, err := s.syncTradingPairs(exchangeID, s.exchanges[exchangeID])
+		if err != nil {
+			// other exchanges and withdraw fees are still synced
+			s.l.Errorw("failed to sync trading pairs", "exchange", exchangeID.String(), "err", err)
+			complete = false
+			continue
+		}
+		proposals = append(proposals, entries...)
+	}
+	entries, withdrawFeesComplete, err := s.syncWithdrawFees()
+	if err != nil {
+		s.l.Errorw("failed to sync withdraw fees", "err", err)
+	}
+	complete = complete && withdrawFeesComplete && err == nil
+	proposals = append(proposals, entries...)
+	return s.propose(proposals, complete)
+}
+
+func (s *Syncer) syncTradingPairs(exchangeID rtypes.ExchangeID, le v1common.LiveExchange) ([]common.SettingChangeEntry, error) {
+	pairs, err := s.storage.GetTradingPairs(exchangeID)
+	if err != nil {
+		return nil, err
+	}
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+	exInfo, err := le.GetLiveExchangeInfos(pairs)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].ID < pairs[j].ID })
+	var result []common.SettingChangeEntry
+	for _, pair := range pairs {
+		info, ok := exInfo[pair.ID]
+		if !ok {
+			s.l.Warnw("no live exchange info for trading pair", "pair_id", pair.ID, "exchange", exchangeID.String())
+			continue
+		}
+		auto, pending := diffTradingPair(pair.TradingPair, info, s.tolerance)
+		if auto != nil {
+			s.l.Infow("applying exchange info within tolerance", "pair_id", pair.ID, "exchange", exchangeID.String())
+			if err := s.storage.UpdateTradingPair(pair.ID, *auto); err != nil {
+				return nil, err
+			}
+		}
+		if pending != nil {
+			result = append(result, common.SettingChangeEntry{
+				Type: common.ChangeTypeUpdateTradingPair,
+				Data: pending,
+			})
+		}
+	}
+	return result, nil
+}
+
+// syncWithdrawFees returns the withdraw fee changes that need approval, ordered by asset exchange
+// ID, and false if the live withdraw fee of any asset exchange could not be fetched.
+func (s *Syncer) syncWithdrawFees() ([]common.SettingChangeEntry, bool, error) {
+	assets, err := s.storage.GetAssets()
+	if err != nil {
+		return nil, false, err
+	}
+	var (
+		result   []common.SettingChangeEntry
+		complete = true
+	)
+	for _, asset := range assets {
+		for _, ae := range asset.Exchanges {
+			le, ok := s.exchanges[ae.ExchangeID]
+			if !ok {
+				continue
+			}
+			withdrawFee, err := le.GetLiveWithdrawFee(ae.Symbol)
+			if err != nil {
+				s.l.Warnw("cannot get live withdraw fee", "err", err, "exchange symbol", ae.Symbol, "token symbol", asset.Symbol)
+				complete = false
+				continue
+			}
+			if withdrawFee == ae.WithdrawFee {
+				continue
+			}
+			if withinTolerance(ae.WithdrawFee, withdrawFee, s.tolerance.WithdrawFee) {
+				if err := s.storage.UpdateAssetExchangeWithdrawFee(withdrawFee, ae.ID); err != nil {
+					return result, false, err
+				}
+				continue
+			}
+			result = append(result, common.SettingChangeEntry{
+				Type: common.ChangeTypeUpdateAssetExchange,
+				Data: &common.UpdateAssetExchangeEntry{
+					ID:          ae.ID,
+					WithdrawFee: common.FloatPointer(withdrawFee),
+				},
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Data.(*common.UpdateAssetExchangeEntry).ID < result[j].Data.(*common.UpdateAssetExchangeEntry).ID
+	})
+	return result, complete, nil
+}
+
+// propose creates a setting change for the given entries. Stale pending changes created by
+// previous syncs are rejected, an identical pending change is kept as is. If the sync is not
+// complete because an exchange failed, pending changes are kept and a new one is only created
+// if there is none.
+func (s *Syncer) propose(entries []common.SettingChangeEntry, complete bool) error {
+	pendings, err := s.storage.GetSettingChanges(common.ChangeCatalogExchangeInfo, common.ChangeStatusPending)
+	if err != nil && err != common.ErrNotFound {
+		return err
+	}
+	if !complete && len(pendings) != 0 {
+		s.l.Infow("keeping pending exchange info setting changes as sync is not complete", "pending", len(pendings))
+		return nil
+	}
+	for _, p := range pendings {
+		if len(entries) != 0 && sameChangeList(p.ChangeList, entries) {
+			return nil
+		}
+		s.l.Infow("rejecting stale exchange info setting change", "id", p.ID)
+		if err := s.storage.RejectSettingChange(p.ID); err != nil {
+			return err
+		}
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	id, err := s.storage.CreateSettingChange(common.ChangeCatalogExchangeInfo, common.SettingChange{
+		ChangeList: entries,
+		Message:    syncMessage,
+	})
+	if err != nil {
+		return err
+	}
+	// test confirm, same as setting changes created through API.
+	if _, err := s.storage.ConfirmSettingChange(id, false); err != nil {
+		if rErr := s.storage.RejectSettingChange(id); rErr != nil {
+			s.l.Errorw("failed to clean up with reject setting change", "err", rErr)
+		}
+		return err
+	}
+	s.l.Infow("created exchange info setting change", "id", id, "changes", len(entries))
+	return nil
+}
+
+// sameChangeList returns true if a and b have the same entries regardless of their order.
+func sameChangeList(a, b []common.SettingChangeEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := make(map[string]int, len(a))
+	for _, e := range a {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return false
+		}
+		count[string(data)]++
+	}
+	for _, e := range b {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return false
+		}
+		if count[string(data)] == 0 {
+			return false
+		}
+		count[string(data)]--
+	}
+	return true
+}
+
+// diffTradingPair compares stored trading pair with live info. It returns the changes
+// within tolerance and the changes that need approval, each is nil if there is no such change.
+func diffTradingPair(stored common.TradingPair, live v1common.ExchangePrecisionLimit, tol Tolerance) (*common.UpdateTradingPairEntry, *common.UpdateTradingPairEntry) {
+	var (
+		auto       = &common.UpdateTradingPairEntry{ID: stored.ID}
+		pending    = &common.UpdateTradingPairEntry{ID: stored.ID}
+		hasAuto    bool
+		hasPending bool
+	)
+	setFloat := func(storedValue, liveValue, tolerance float64, autoField, pendingField **float64) {
+		if storedValue == liveValue {
+			return
+		}
+		if withinTolerance(storedValue, liveValue, tolerance) {
+			*autoField = common.FloatPointer(liveValue)
+			hasAuto = true
+			return
+		}
+		*pendingField = common.FloatPointer(liveValue)
+		hasPending = true
+	}
+	setFloat(stored.AmountLimitMin, live.AmountLimit.Min, tol.AmountLimit, &auto.AmountLimitMin, &pending.AmountLimitMin)
+	setFloat(stored.AmountLimitMax, live.AmountLimit.Max, tol.AmountLimit, &auto.AmountLimitMax, &pending.AmountLimitMax)
+	setFloat(stored.PriceLimitMin, live.PriceLimit.Min, tol.PriceLimit, &auto.PriceLimitMin, &pending.PriceLimitMin)
+	setFloat(stored.PriceLimitMax, live.PriceLimit.Max, tol.PriceLimit, &auto.PriceLimitMax, &pending.PriceLimitMax)
+	setFloat(stored.MinNotional, live.MinNotional, tol.MinNotional, &auto.MinNotional, &pending.MinNotional)
+
+	if pricePrecision := uint64(live.Precision.Price); pricePrecision != stored.PricePrecision {
+		pending.PricePrecision = common.Uint64Pointer(pricePrecision)
+		hasPending = true
+	}
+	if amountPrecision := uint64(live.Precision.Amount); amountPrecision != stored.AmountPrecision {
+		pending.AmountPrecision = common.Uint64Pointer(amountPrecision)
+		hasPending = true
+	}
+
+	if !hasAuto {
+		auto = nil
+	}
+	if !hasPending {
+		pending = nil
+	}
+	return auto, pending
+}
+
+// withinTolerance returns true if relative difference of live value to stored value is not
+// greater than tolerance.
+func withinTolerance(storedValue, liveValue, tolerance float64) bool {
+	if tolerance <= 0 || storedValue == 0 {
+		return storedValue == liveValue
+	}
+	return math.Abs(liveValue-storedValue)/math.Abs(storedValue) <= tolerance
+}
@@ -0,0 +1,83 @@
+package exchangeinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1common "github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func TestDiffTradingPair(t *testing.T) {
+	stored := common.TradingPair{
+		ID:              1,
+		PricePrecision:  6,
+		AmountPrecision: 2,
+		AmountLimitMin:  1,
+		AmountLimitMax:  1000,
+		PriceLimitMin:   0.0001,
+		PriceLimitMax:   1,
+		MinNotional:     0.01,
+	}
+	live := v1common.ExchangePrecisionLimit{
+		Precision:   v1common.TokenPairPrecision{Amount: 2, Price: 6},
+		AmountLimit: v1common.TokenPairAmountLimit{Min: 1, Max: 1000},
+		PriceLimit:  v1common.TokenPairPriceLimit{Min: 0.0001, Max: 1},
+		MinNotional: 0.01,
+	}
+	tol := Tolerance{AmountLimit: 0.1, PriceLimit: 0.1, MinNotional: 0.1}
+
+	auto, pending := diffTradingPair(stored, live, tol)
+	require.Nil(t, auto)
+	require.Nil(t, pending)
+
+	live.AmountLimit.Max = 1050
+	live.MinNotional = 0.02
+	live.Precision.Price = 5
+	auto, pending = diffTradingPair(stored, live, tol)
+	require.Equal(t, &common.UpdateTradingPairEntry{
+		ID:             1,
+		AmountLimitMax: common.FloatPointer(1050),
+	}, auto)
+	require.Equal(t, &common.UpdateTradingPairEntry{
+		ID:             1,
+		PricePrecision: common.Uint64Pointer(5),
+		MinNotional:    common.FloatPointer(0.02),
+	}, pending)
+}
+
+func TestWithinTolerance(t *testing.T) {
+	require.True(t, withinTolerance(1, 1, 0))
+	require.False(t, withinTolerance(1, 1.01, 0))
+	require.True(t, withinTolerance(1, 1.05, 0.1))
+	require.False(t, withinTolerance(1, 0.8, 0.1))
+	require.False(t, withinTolerance(0, 0.1, 0.5))
+}
+
+func TestSameChangeList(t *testing.T) {
+	pair := common.SettingChangeEntry{
+		Type: common.ChangeTypeUpdateTradingPair,
+		Data: &common.UpdateTradingPairEntry{ID: 1, MinNotional: common.FloatPointer(0.02)},
+	}
+	otherPair := common.SettingChangeEntry{
+		Type: common.ChangeTypeUpdateTradingPair,
+		Data: &common.UpdateTradingPairEntry{ID: 2, PricePrecision: common.Uint64Pointer(5)},
+	}
+	fee := common.SettingChangeEntry{
+		Type: common.ChangeTypeUpdateAssetExchange,
+		Data: &common.UpdateAssetExchangeEntry{ID: 3, WithdrawFee: common.FloatPointer(0.1)},
+	}
+	require.True(t, sameChangeList(
+		[]common.SettingChangeEntry{pair, otherPair, fee},
+		[]common.SettingChangeEntry{fee, otherPair, pair}))
+	require.False(t, sameChangeList(
+		[]common.SettingChangeEntry{pair, otherPair},
+		[]common.SettingChangeEntry{pair, fee}))
+	require.False(t, sameChangeList(
+		[]common.SettingChangeEntry{pair, pair},
+		[]common.SettingChangeEntry{pair, otherPair}))
+	require.False(t, sameChangeList(
+		[]common.SettingChangeEntry{pair},
+		[]common.SettingChangeEntry{pair, otherPair}))
+}
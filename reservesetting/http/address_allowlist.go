@@ -0,0 +1,17 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+func (s *Server) getAddressAllowlist(c *gin.Context) {
+	allowlist, err := s.storage.GetAddressAllowlist()
+	if err != nil {
+		s.l.Errorw("failed to get address allowlist", "err", err)
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(allowlist))
+}
@@ -12,10 +12,12 @@ import (
 	v1common "github.com/KyberNetwork/reserve-data/common"
 	gaspricedataclient "github.com/KyberNetwork/reserve-data/common/gaspricedata-client"
 	coreclient "github.com/KyberNetwork/reserve-data/lib/core-client"
+	"github.com/KyberNetwork/reserve-data/lib/eventbus"
 	marketdatacli "github.com/KyberNetwork/reserve-data/lib/market-data"
 	"github.com/KyberNetwork/reserve-data/lib/rtypes"
 	"github.com/KyberNetwork/reserve-data/reservesetting/common"
 	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
+	"github.com/KyberNetwork/reserve-data/reservesetting/webhook"
 )
 
 // Server is the HTTP server of token V3.
@@ -28,6 +30,8 @@ type Server struct {
 	coreClient         *coreclient.Client
 	gasClient          gaspricedataclient.Client
 	marketDataClient   *marketdatacli.Client
+	eventBus           *eventbus.Bus
+	webhookDispatcher  *webhook.Dispatcher
 }
 
 // NewServer creates new HTTP server for reservesetting APIs.
@@ -111,6 +115,38 @@ func NewServer(storage storage.Interface, host string, supportedExchanges map[rt
 	g.DELETE("/setting-change-feed-configuration/:id", server.rejectSettingChange)
 	g.PUT("/update-feed-status/:name", server.updateFeedStatus)
 
+	g.POST("/setting-change-exchange-info", server.createSettingChangeWithType(common.ChangeCatalogExchangeInfo))
+	g.GET("/setting-change-exchange-info", server.getSettingChangeWithType(common.ChangeCatalogExchangeInfo))
+	g.GET("/setting-change-exchange-info/:id", server.getSettingChange)
+	g.PUT("/setting-change-exchange-info/:id", server.confirmSettingChange)
+	g.DELETE("/setting-change-exchange-info/:id", server.rejectSettingChange)
+
+	g.POST("/setting-change-risk-limits", server.createSettingChangeWithType(common.ChangeCatalogRiskLimits))
+	g.GET("/setting-change-risk-limits", server.getSettingChangeWithType(common.ChangeCatalogRiskLimits))
+	g.GET("/setting-change-risk-limits/:id", server.getSettingChange)
+	g.PUT("/setting-change-risk-limits/:id", server.confirmSettingChange)
+	g.DELETE("/setting-change-risk-limits/:id", server.rejectSettingChange)
+	g.GET("/risk-limits", server.getRiskLimits)
+
+	g.POST("/setting-change-address-allowlist", server.createSettingChangeWithType(common.ChangeCatalogAddressAllowlist))
+	g.GET("/setting-change-address-allowlist", server.getSettingChangeWithType(common.ChangeCatalogAddressAllowlist))
+	g.GET("/setting-change-address-allowlist/:id", server.getSettingChange)
+	g.PUT("/setting-change-address-allowlist/:id", server.confirmSettingChange)
+	g.DELETE("/setting-change-address-allowlist/:id", server.rejectSettingChange)
+	g.GET("/address-allowlist", server.getAddressAllowlist)
+
+	g.POST("/setting-change-pricing-contract", server.createSettingChangeWithType(common.ChangeCatalogPricingContract))
+	g.GET("/setting-change-pricing-contract", server.getSettingChangeWithType(common.ChangeCatalogPricingContract))
+	g.GET("/setting-change-pricing-contract/:id", server.getSettingChange)
+	g.PUT("/setting-change-pricing-contract/:id", server.confirmSettingChange)
+	g.DELETE("/setting-change-pricing-contract/:id", server.rejectSettingChange)
+
+	g.POST("/setting-change-reserve-admin", server.createSettingChangeWithType(common.ChangeCatalogReserveAdmin))
+	g.GET("/setting-change-reserve-admin", server.getSettingChangeWithType(common.ChangeCatalogReserveAdmin))
+	g.GET("/setting-change-reserve-admin/:id", server.getSettingChange)
+	g.PUT("/setting-change-reserve-admin/:id", server.confirmSettingChange)
+	g.DELETE("/setting-change-reserve-admin/:id", server.rejectSettingChange)
+
 	g.GET("/price-factor", server.getPriceFactor)
 	g.POST("/price-factor", server.setPriceFactor)
 
@@ -122,16 +158,35 @@ func NewServer(storage storage.Interface, host string, supportedExchanges map[rt
 	g.POST("/hold-rebalance", server.holdRebalance)
 	g.POST("/enable-rebalance", server.enableRebalance)
 
+	g.GET("/kill-switch", server.getKillSwitch)
+	g.POST("/kill-switch", server.setKillSwitch)
+
 	g.GET("/rate-trigger-period", server.getRateTriggerPeriod)
 	g.POST("/rate-trigger-period", server.setRateTriggerPeriod)
 	g.GET("/gas-threshold", server.getGasStatus)
 	g.POST("/gas-threshold", server.setGasThreshold)
 	g.GET("/gas-source", server.getPreferGasSource)
 	g.POST("/gas-source", server.setPreferGasSource)
+	g.GET("/set-rate-gas-policy", server.getSetRateGasPolicy)
+	g.POST("/set-rate-gas-policy", server.setSetRateGasPolicy)
+
+	g.POST("/webhook", server.createWebhookSubscription)
+	g.GET("/webhook", server.getWebhookSubscriptions)
+	g.GET("/webhook/:id", server.getWebhookSubscription)
+	g.PUT("/webhook/:id", server.updateWebhookSubscription)
+	g.DELETE("/webhook/:id", server.deleteWebhookSubscription)
+	g.GET("/webhook-delivery", server.getWebhookDeliveries)
+	g.GET("/webhook-dead-letter", server.getWebhookDeadLetters)
+	g.POST("/webhook-dead-letter/:id/redeliver", server.redeliverWebhookDeadLetter)
 
 	return server
 }
 
+// SetEventBus sets the bus to publish applied setting changes to.
+func (s *Server) SetEventBus(bus *eventbus.Bus) {
+	s.eventBus = bus
+}
+
 // EnableProfiler enable profiler on path "/debug/pprof"
 func (s *Server) EnableProfiler() {
 	pprof.Register(s.r)
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// pricingContractAsset returns the asset whose pricing contract parameters are changed.
+func (s *Server) pricingContractAsset(assetID rtypes.AssetID) (common.Asset, error) {
+	asset, err := s.storage.GetAsset(assetID)
+	if err != nil {
+		return common.Asset{}, errors.Wrapf(err, "asset not found, id: %v", assetID)
+	}
+	if common.IsZeroAddress(asset.Address) {
+		return common.Asset{}, errors.Wrapf(common.ErrAddressMissing, "asset %v", assetID)
+	}
+	return asset, nil
+}
+
+func (s *Server) checkSetStepFunctionParams(entry common.SetStepFunctionEntry) error {
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+	_, err := s.pricingContractAsset(entry.AssetID)
+	return err
+}
+
+func (s *Server) checkSetTokenControlInfoParams(entry common.SetTokenControlInfoEntry) error {
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+	_, err := s.pricingContractAsset(entry.AssetID)
+	return err
+}
+
+// applyPricingContractChanges asks core to set the step functions and control info of a setting
+// change in the pricing contract. Core sends each tx once and fails until the txs are mined and the
+// values are verified on chain, so the change stays pending and is confirmed again once they are.
+func (s *Server) applyPricingContractChanges(id rtypes.SettingChangeID) error {
+	change, err := s.storage.GetSettingChange(id)
+	if err != nil {
+		return err
+	}
+	for _, o := range change.ChangeList {
+		switch o.Data.(type) {
+		case *common.SetStepFunctionEntry, *common.SetTokenControlInfoEntry:
+		default:
+			continue
+		}
+		if s.coreClient == nil {
+			return errors.New("core client is not configured to set pricing contract")
+		}
+		return s.coreClient.ExecutePricingContract(id)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func (s *Server) checkReserveAdminParams(entry common.ReserveAdminEntry) error {
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+	if !entry.NeedsAsset() {
+		return nil
+	}
+	_, err := s.pricingContractAsset(entry.AssetID)
+	return err
+}
+
+// applyReserveAdminChanges asks core to send the reserve admin operations of a setting change,
+// core records the txs as activities and does not wait for them to be mined.
+func (s *Server) applyReserveAdminChanges(id rtypes.SettingChangeID) error {
+	change, err := s.storage.GetSettingChange(id)
+	if err != nil {
+		return err
+	}
+	for _, o := range change.ChangeList {
+		if _, ok := o.Data.(*common.ReserveAdminEntry); !ok {
+			continue
+		}
+		if s.coreClient == nil {
+			return errors.New("core client is not configured to send reserve admin operations")
+		}
+		return s.coreClient.ExecuteReserveAdmin(id)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+func (s *Server) getRiskLimits(c *gin.Context) {
+	limits, err := s.storage.GetRiskLimits()
+	if err != nil {
+		s.l.Errorw("failed to get risk limits", "err", err)
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(limits))
+}
+
+type killSwitch struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+func (s *Server) getKillSwitch(c *gin.Context) {
+	enabled, err := s.storage.GetKillSwitch()
+	if err != nil {
+		s.l.Errorw("failed to get kill switch", "err", err)
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(killSwitch{Enabled: &enabled}))
+}
+
+// setKillSwitch takes effect immediately, it does not go through setting change as it is used
+// to stop core in emergency.
+func (s *Server) setKillSwitch(c *gin.Context) {
+	var input killSwitch
+	if err := c.ShouldBindJSON(&input); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if err := s.storage.SetKillSwitch(*input.Enabled); err != nil {
+		s.l.Warnw("failed to set kill switch", "err", err)
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	s.l.Infow("kill switch updated", "enabled", *input.Enabled)
+	httputil.ResponseSuccess(c)
+}
@@ -0,0 +1,35 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func (s *Server) getSetRateGasPolicy(c *gin.Context) {
+	policy, err := s.storage.GetSetRateGasPolicy()
+	if err != nil {
+		s.l.Errorw("failed to get set rate gas policy", "err", err)
+		httputil.ResponseFailure(c, httputil.WithReason(err.Error()))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(policy))
+}
+
+func (s *Server) setSetRateGasPolicy(c *gin.Context) {
+	var input common.SetRateGasPolicy
+	if err := c.BindJSON(&input); err != nil {
+		httputil.ResponseFailure(c, httputil.WithReason(err.Error()))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		httputil.ResponseFailure(c, httputil.WithReason(err.Error()))
+		return
+	}
+	if err := s.storage.SetSetRateGasPolicy(input); err != nil {
+		httputil.ResponseFailure(c, httputil.WithReason(err.Error()))
+		return
+	}
+	httputil.ResponseSuccess(c)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/KyberNetwork/reserve-data/common/feed"
 	"github.com/KyberNetwork/reserve-data/http/httputil"
+	"github.com/KyberNetwork/reserve-data/lib/eventbus"
 	"github.com/KyberNetwork/reserve-data/lib/rtypes"
 	"github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
@@ -36,6 +37,8 @@ func (s *Server) validateChangeEntry(e common.SettingChangeType, changeType comm
 		err = s.checkChangeAssetAddressParams(*e.(*common.ChangeAssetAddressEntry))
 	case common.ChangeTypeUpdateExchange:
 		err = s.checkUpdateExchangeParams(*e.(*common.UpdateExchangeEntry))
+	case common.ChangeTypeCreateExchange:
+		err = s.checkCreateExchangeParams(*e.(*common.CreateExchangeEntry))
 	case common.ChangeTypeDeleteTradingPair:
 		err = s.checkDeleteTradingPairParams(*e.(*common.DeleteTradingPairEntry))
 	case common.ChangeTypeDeleteAssetExchange:
@@ -44,6 +47,20 @@ func (s *Server) validateChangeEntry(e common.SettingChangeType, changeType comm
 		return nil
 	case common.ChangeTypeSetFeedConfiguration:
 		err = s.checkSetFeedConfigurationParams(*e.(*common.SetFeedConfigurationEntry))
+	case common.ChangeTypeUpdateTradingPair:
+		err = s.checkUpdateTradingPairParams(*e.(*common.UpdateTradingPairEntry))
+	case common.ChangeTypeUpdateRiskLimits:
+		err = s.checkUpdateRiskLimitsParams(*e.(*common.UpdateRiskLimitsEntry))
+	case common.ChangeTypeAddAllowedAddress:
+		err = s.checkAddAllowedAddressParams(*e.(*common.AddAllowedAddressEntry))
+	case common.ChangeTypeRemoveAllowedAddress:
+		err = s.checkRemoveAllowedAddressParams(*e.(*common.RemoveAllowedAddressEntry))
+	case common.ChangeTypeSetStepFunction:
+		err = s.checkSetStepFunctionParams(*e.(*common.SetStepFunctionEntry))
+	case common.ChangeTypeSetTokenControlInfo:
+		err = s.checkSetTokenControlInfoParams(*e.(*common.SetTokenControlInfoEntry))
+	case common.ChangeTypeReserveAdmin:
+		err = s.checkReserveAdminParams(*e.(*common.ReserveAdminEntry))
 	default:
 		return errors.Errorf("unknown type of setting change: %v", reflect.TypeOf(e))
 	}
@@ -230,9 +247,23 @@ func (s *Server) createSettingChange(c *gin.Context, t common.ChangeCatalog) {
 		}
 		return
 	}
+	s.publishSettingChange(eventbus.SettingChangeCreated, id)
 	httputil.ResponseSuccess(c, httputil.WithField("id", id))
 }
 
+// publishSettingChange publishes an event of type t with the setting change of given id.
+func (s *Server) publishSettingChange(t eventbus.Type, id rtypes.SettingChangeID) {
+	if s.eventBus == nil {
+		return
+	}
+	change, err := s.storage.GetSettingChange(id)
+	if err != nil {
+		s.l.Warnw("failed to get setting change to publish", "id", id, "type", t, "err", err)
+		return
+	}
+	s.eventBus.Publish(t, change)
+}
+
 func (s *Server) getSettingChange(c *gin.Context) {
 	var input struct {
 		ID uint64 `uri:"id" binding:"required"`
@@ -312,11 +343,22 @@ func (s *Server) confirmSettingChange(c *gin.Context) {
 		httputil.ResponseFailure(c, httputil.WithError(err))
 		return
 	}
+	// pricing contract changes and reserve admin operations are applied first so that the change
+	// stays pending if they fail
+	if err := s.applyPricingContractChanges(rtypes.SettingChangeID(input.ID)); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if err := s.applyReserveAdminChanges(rtypes.SettingChangeID(input.ID)); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
 	additionalDataReturn, err := s.storage.ConfirmSettingChange(rtypes.SettingChangeID(input.ID), true)
 	if err != nil {
 		httputil.ResponseFailure(c, httputil.WithError(err))
 		return
 	}
+	s.publishSettingChange(eventbus.SettingChangeApplied, rtypes.SettingChangeID(input.ID))
 	if s.marketDataClient != nil {
 		// add pair to market data
 		for _, tpID := range additionalDataReturn.AddedTradingPairs {
@@ -326,7 +368,7 @@ func (s *Server) confirmSettingChange(c *gin.Context) {
 				httputil.ResponseFailure(c, httputil.WithError(err))
 				return
 			}
-			exchange, sourceSymbol, publicSymbol, err := dataForMarketDataByExchange(tradingPair.ExchangeID, tradingPair.BaseSymbol, tradingPair.QuoteSymbol)
+			exchange, sourceSymbol, publicSymbol, err := s.dataForMarketDataByExchange(tradingPair.ExchangeID, tradingPair.BaseSymbol, tradingPair.QuoteSymbol)
 			if err != nil {
 				httputil.ResponseFailure(c, httputil.WithError(err))
 				return
@@ -341,17 +383,20 @@ func (s *Server) confirmSettingChange(c *gin.Context) {
 	httputil.ResponseSuccess(c)
 }
 
-func dataForMarketDataByExchange(exchangeID rtypes.ExchangeID, base, quote string) (string, string, string, error) {
+func (s *Server) dataForMarketDataByExchange(exchangeID rtypes.ExchangeID, base, quote string) (string, string, string, error) {
 	var (
 		lowerBase  = strings.ToLower(base)
 		lowerQuote = strings.ToLower(quote)
 	)
 	publicSymbol := fmt.Sprintf("%s-%s", lowerBase, lowerQuote)
-	switch exchangeID {
-	case rtypes.Binance, rtypes.Binance2:
-		return rtypes.Binance.String(), fmt.Sprintf("%s%s", lowerBase, lowerQuote), publicSymbol, nil
-	case rtypes.Huobi:
-		return rtypes.Huobi.String(), fmt.Sprintf("%s%s", lowerBase, lowerQuote), publicSymbol, nil
+	// market data is per venue, all accounts on a venue share it.
+	exchange, err := s.storage.GetExchange(exchangeID)
+	if err != nil {
+		return "", "", "", err
+	}
+	switch exchange.Venue {
+	case common.VenueBinance, common.VenueHuobi:
+		return exchange.Venue, fmt.Sprintf("%s%s", lowerBase, lowerQuote), publicSymbol, nil
 	default:
 		return "", "", "", fmt.Errorf("%s exchange is not supported", exchangeID)
 	}
@@ -390,7 +435,7 @@ func (s *Server) checkCreateTradingPairParams(createEntry common.CreateTradingPa
 		return "", "", errors.Wrap(common.ErrQuoteAssetInvalid, "quote asset not config on exchange")
 	}
 	if s.marketDataClient != nil {
-		exchange, symbol, _, err := dataForMarketDataByExchange(createEntry.ExchangeID, baseAssetEx.Symbol, quoteAssetEx.Symbol)
+		exchange, symbol, _, err := s.dataForMarketDataByExchange(createEntry.ExchangeID, baseAssetEx.Symbol, quoteAssetEx.Symbol)
 		if err != nil {
 			return "", "", errors.Wrap(err, "cannot create params for market data client")
 		}
@@ -469,9 +514,90 @@ func (s *Server) checkUpdateAssetExchangeParams(updateEntry common.UpdateAssetEx
 	if asset.Transferable && updateEntry.DepositAddress != nil && common.IsZeroAddress(*updateEntry.DepositAddress) {
 		return common.ErrDepositAddressMissing
 	}
+	if updateEntry.WithdrawFee != nil && *updateEntry.WithdrawFee < 0 {
+		return errors.Errorf("withdraw fee must not be negative, asset_exchange: %v", updateEntry.ID)
+	}
+	return nil
+}
+
+func (s *Server) checkUpdateTradingPairParams(updateEntry common.UpdateTradingPairEntry) error {
+	if _, err := s.storage.GetTradingPair(updateEntry.ID, false); err != nil {
+		return errors.Wrapf(err, "trading pair not found, id: %v", updateEntry.ID)
+	}
+	if updateEntry.AmountLimitMin != nil && updateEntry.AmountLimitMax != nil && *updateEntry.AmountLimitMin > *updateEntry.AmountLimitMax {
+		return errors.Wrapf(common.ErrBadTradingPairConfiguration, "amount_limit_min > amount_limit_max, id: %v", updateEntry.ID)
+	}
+	if updateEntry.PriceLimitMin != nil && updateEntry.PriceLimitMax != nil && *updateEntry.PriceLimitMin > *updateEntry.PriceLimitMax {
+		return errors.Wrapf(common.ErrBadTradingPairConfiguration, "price_limit_min > price_limit_max, id: %v", updateEntry.ID)
+	}
+	return nil
+}
+
+func (s *Server) checkRiskLimitsByAsset(name string, limits map[rtypes.AssetID]float64) error {
+	for assetID, limit := range limits {
+		if limit < 0 {
+			return errors.Errorf("%s must not be negative, asset: %v", name, assetID)
+		}
+		if _, err := s.storage.GetAsset(assetID); err != nil {
+			return errors.Wrapf(err, "%s: asset not found, id: %v", name, assetID)
+		}
+	}
+	return nil
+}
+
+func (s *Server) checkUpdateRiskLimitsParams(updateEntry common.UpdateRiskLimitsEntry) error {
+	if updateEntry.MaxPriceDeviation < 0 || updateEntry.MaxPriceDeviation >= 1 {
+		return errors.Errorf("max_price_deviation must be in [0, 1), got %v", updateEntry.MaxPriceDeviation)
+	}
+	byAsset := []struct {
+		name   string
+		limits map[rtypes.AssetID]float64
+	}{
+		{name: "max_order_notional", limits: updateEntry.MaxOrderNotional},
+		{name: "max_hourly_notional", limits: updateEntry.MaxHourlyNotional},
+		{name: "max_daily_loss", limits: updateEntry.MaxDailyLoss},
+		{name: "max_daily_withdraw", limits: updateEntry.MaxDailyWithdraw},
+	}
+	for _, l := range byAsset {
+		if err := s.checkRiskLimitsByAsset(l.name, l.limits); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (s *Server) checkAddAllowedAddressParams(entry common.AddAllowedAddressEntry) error {
+	if entry.Type != common.AllowedAddressDeposit && entry.Type != common.AllowedAddressWithdraw {
+		return errors.Errorf("invalid allowed address type %s", entry.Type)
+	}
+	if common.IsZeroAddress(entry.Address) {
+		return common.ErrAddressMissing
+	}
+	asset, err := s.storage.GetAsset(entry.AssetID)
+	if err != nil {
+		return errors.Wrapf(err, "asset not found, id: %v", entry.AssetID)
+	}
+	for _, ae := range asset.Exchanges {
+		if ae.ExchangeID == entry.ExchangeID {
+			return nil
+		}
+	}
+	return errors.Wrapf(common.ErrAssetExchangeMissing, "asset %v, exchange %v", entry.AssetID, entry.ExchangeID)
+}
+
+func (s *Server) checkRemoveAllowedAddressParams(entry common.RemoveAllowedAddressEntry) error {
+	allowlist, err := s.storage.GetAddressAllowlist()
+	if err != nil {
+		return err
+	}
+	for _, a := range allowlist {
+		if a.ID == entry.ID {
+			return nil
+		}
+	}
+	return errors.Wrapf(common.ErrNotFound, "allowed address id: %v", entry.ID)
+}
+
 func (s *Server) checkCreateAssetExchangeParams(createEntry common.CreateAssetExchangeEntry) error {
 	asset, err := s.storage.GetAsset(createEntry.AssetID)
 	if err != nil {
@@ -519,7 +645,7 @@ func (s *Server) checkCreateAssetExchangeParams(createEntry common.CreateAssetEx
 				if quoteAssetExchangeSymbol == "" {
 					return errors.New(fmt.Sprintf("quote asset didn't have asset exchange, quote id: %v", tradingPair.Quote))
 				}
-				exchange, symbol, _, err := dataForMarketDataByExchange(createEntry.ExchangeID, createEntry.Symbol, quoteAssetExchangeSymbol)
+				exchange, symbol, _, err := s.dataForMarketDataByExchange(createEntry.ExchangeID, createEntry.Symbol, quoteAssetExchangeSymbol)
 				if err != nil {
 					return errors.Wrap(err, "cannot create params for market data client")
 				}
@@ -553,7 +679,7 @@ func (s *Server) checkCreateAssetExchangeParams(createEntry common.CreateAssetEx
 				if baseAssetExchangeSymbol == "" {
 					return errors.New(fmt.Sprintf("base asset didn't have asset exchange, base id: %v", tradingPair.Base))
 				}
-				exchange, symbol, _, err := dataForMarketDataByExchange(createEntry.ExchangeID, baseAssetExchangeSymbol, createEntry.Symbol)
+				exchange, symbol, _, err := s.dataForMarketDataByExchange(createEntry.ExchangeID, baseAssetExchangeSymbol, createEntry.Symbol)
 				if err != nil {
 					return errors.Wrap(err, "cannot create params for market data client")
 				}
@@ -692,7 +818,7 @@ func (s *Server) checkCreateAssetParams(createEntry common.CreateAssetEntry) err
 					if quoteAssetExchangeSymbol == "" {
 						return errors.New(fmt.Sprintf("quote asset didn't have asset exchange, quote id: %v", tradingPair.Quote))
 					}
-					exchange, symbol, _, err := dataForMarketDataByExchange(exchange.ExchangeID, exchange.Symbol, quoteAssetExchangeSymbol)
+					exchange, symbol, _, err := s.dataForMarketDataByExchange(exchange.ExchangeID, exchange.Symbol, quoteAssetExchangeSymbol)
 					if err != nil {
 						return errors.Wrap(err, "cannot create params for market data client")
 					}
@@ -726,7 +852,7 @@ func (s *Server) checkCreateAssetParams(createEntry common.CreateAssetEntry) err
 					if baseAssetExchangeSymbol == "" {
 						return errors.New(fmt.Sprintf("base asset didn't have asset exchange, base id: %v", tradingPair.Base))
 					}
-					exchange, symbol, _, err := dataForMarketDataByExchange(exchange.ExchangeID, baseAssetExchangeSymbol, exchange.Symbol)
+					exchange, symbol, _, err := s.dataForMarketDataByExchange(exchange.ExchangeID, baseAssetExchangeSymbol, exchange.Symbol)
 					if err != nil {
 						return errors.Wrap(err, "cannot create params for market data client")
 					}
@@ -762,6 +888,27 @@ func (s *Server) checkUpdateExchangeParams(updateExchangeEntry common.UpdateExch
 	return err
 }
 
+func (s *Server) checkCreateExchangeParams(createExchangeEntry common.CreateExchangeEntry) error {
+	if !common.ValidVenue(createExchangeEntry.Venue) {
+		return fmt.Errorf("invalid venue %s", createExchangeEntry.Venue)
+	}
+	if createExchangeEntry.TradingFeeMaker != nil && *createExchangeEntry.TradingFeeMaker < 0 {
+		return fmt.Errorf("trading_fee_maker must not be negative")
+	}
+	if createExchangeEntry.TradingFeeTaker != nil && *createExchangeEntry.TradingFeeTaker < 0 {
+		return fmt.Errorf("trading_fee_taker must not be negative")
+	}
+	_, err := s.storage.GetExchangeByName(createExchangeEntry.Name)
+	switch err {
+	case nil:
+		return common.ErrExchangeExists
+	case common.ErrNotFound:
+		return nil
+	default:
+		return err
+	}
+}
+
 func (s *Server) checkSetFeedConfigurationParams(setFeedConfigurationEntry common.SetFeedConfigurationEntry) error {
 	switch setFeedConfigurationEntry.SetRate {
 	case common.BTCFeed:
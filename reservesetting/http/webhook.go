@@ -0,0 +1,224 @@
+package http
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	v1common "github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+	"github.com/KyberNetwork/reserve-data/reservesetting/webhook"
+)
+
+// defaultWebhookDeliveryDuration is the duration of delivery log returned if from is not given.
+const defaultWebhookDeliveryDuration = 24 * time.Hour
+
+// SetWebhookDispatcher sets the dispatcher used to redeliver webhook dead letters.
+func (s *Server) SetWebhookDispatcher(dispatcher *webhook.Dispatcher) {
+	s.webhookDispatcher = dispatcher
+}
+
+func checkWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook url %s", rawURL)
+	}
+	return nil
+}
+
+func checkWebhookEvents(events []string) error {
+	if len(events) == 0 {
+		return fmt.Errorf("events must not be empty, valid events: %v", common.WebhookEvents())
+	}
+	for _, event := range events {
+		if !common.IsWebhookEvent(event) {
+			return fmt.Errorf("invalid event %s, valid events: %v", event, common.WebhookEvents())
+		}
+	}
+	return nil
+}
+
+func (s *Server) createWebhookSubscription(c *gin.Context) {
+	var input struct {
+		URL    string   `json:"url" binding:"required"`
+		Events []string `json:"events" binding:"required"`
+		KeyID  string   `json:"key_id" binding:"required"`
+		Secret string   `json:"secret" binding:"required"`
+		Active *bool    `json:"active"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if err := checkWebhookURL(input.URL); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if err := checkWebhookEvents(input.Events); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	sub := common.WebhookSubscription{
+		URL:    input.URL,
+		Events: input.Events,
+		KeyID:  input.KeyID,
+		Secret: input.Secret,
+		Active: input.Active == nil || *input.Active,
+	}
+	id, err := s.storage.CreateWebhookSubscription(sub)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithField("id", id))
+}
+
+func (s *Server) getWebhookSubscriptions(c *gin.Context) {
+	subscriptions, err := s.storage.GetWebhookSubscriptions()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(subscriptions))
+}
+
+func (s *Server) getWebhookSubscription(c *gin.Context) {
+	var input struct {
+		ID uint64 `uri:"id" binding:"required"`
+	}
+	if err := c.ShouldBindUri(&input); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	sub, err := s.storage.GetWebhookSubscription(input.ID)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(sub))
+}
+
+func (s *Server) updateWebhookSubscription(c *gin.Context) {
+	var input struct {
+		ID uint64 `uri:"id" binding:"required"`
+	}
+	if err := c.ShouldBindUri(&input); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	var opts common.UpdateWebhookSubscriptionOpts
+	if err := c.ShouldBindJSON(&opts); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if opts.URL != nil {
+		if err := checkWebhookURL(*opts.URL); err != nil {
+			httputil.ResponseFailure(c, httputil.WithError(err))
+			return
+		}
+	}
+	if opts.Events != nil {
+		if err := checkWebhookEvents(opts.Events); err != nil {
+			httputil.ResponseFailure(c, httputil.WithError(err))
+			return
+		}
+	}
+	if (opts.KeyID != nil && *opts.KeyID == "") || (opts.Secret != nil && *opts.Secret == "") {
+		httputil.ResponseFailure(c, httputil.WithReason("key_id and secret must not be empty"))
+		return
+	}
+	if err := s.storage.UpdateWebhookSubscription(input.ID, opts); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c)
+}
+
+func (s *Server) deleteWebhookSubscription(c *gin.Context) {
+	var input struct {
+		ID uint64 `uri:"id" binding:"required"`
+	}
+	if err := c.ShouldBindUri(&input); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if err := s.storage.DeleteWebhookSubscription(input.ID); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c)
+}
+
+func (s *Server) getWebhookDeliveries(c *gin.Context) {
+	var input struct {
+		SubscriptionID uint64 `form:"subscription_id"`
+		From           uint64 `form:"from"`
+		To             uint64 `form:"to"`
+	}
+	if err := c.ShouldBindQuery(&input); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	to := time.Now()
+	if input.To != 0 {
+		to = v1common.MillisToTime(input.To)
+	}
+	from := to.Add(-defaultWebhookDeliveryDuration)
+	if input.From != 0 {
+		from = v1common.MillisToTime(input.From)
+	}
+	deliveries, err := s.storage.GetWebhookDeliveries(input.SubscriptionID, from, to)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(deliveries))
+}
+
+func (s *Server) getWebhookDeadLetters(c *gin.Context) {
+	deadLetters, err := s.storage.GetWebhookDeadLetters()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(deadLetters))
+}
+
+func (s *Server) redeliverWebhookDeadLetter(c *gin.Context) {
+	var input struct {
+		ID uint64 `uri:"id" binding:"required"`
+	}
+	if err := c.ShouldBindUri(&input); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if s.webhookDispatcher == nil {
+		httputil.ResponseFailure(c, httputil.WithReason("webhook dispatcher is not enabled"))
+		return
+	}
+	deadLetter, err := s.storage.GetWebhookDeadLetter(input.ID)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	sub, err := s.storage.GetWebhookSubscription(deadLetter.SubscriptionID)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if err := s.webhookDispatcher.Redeliver(sub, deadLetter); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if err := s.storage.DeleteWebhookDeadLetter(input.ID); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c)
+}
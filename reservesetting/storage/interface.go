@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"time"
+
 	ethereum "github.com/ethereum/go-ethereum/common"
 
 	"github.com/KyberNetwork/reserve-data/lib/rtypes"
@@ -31,6 +33,26 @@ type Interface interface {
 	UpdateAssetExchangeWithdrawFee(withdrawFee float64, assetExchangeID rtypes.AssetExchangeID) error
 
 	SetPreferGasSource(v v3.PreferGasSource) error
+	SetSetRateGasPolicy(policy v3.SetRateGasPolicy) error
+
+	WebhookStorage
+}
+
+// WebhookStorage is the storage of webhook subscriptions and their deliveries.
+type WebhookStorage interface {
+	CreateWebhookSubscription(sub v3.WebhookSubscription) (uint64, error)
+	GetWebhookSubscriptions() ([]v3.WebhookSubscription, error)
+	GetWebhookSubscription(id uint64) (v3.WebhookSubscription, error)
+	UpdateWebhookSubscription(id uint64, opts v3.UpdateWebhookSubscriptionOpts) error
+	DeleteWebhookSubscription(id uint64) error
+
+	AddWebhookDelivery(d v3.WebhookDelivery) error
+	GetWebhookDeliveries(subscriptionID uint64, from, to time.Time) ([]v3.WebhookDelivery, error)
+
+	AddWebhookDeadLetter(d v3.WebhookDeadLetter) (uint64, error)
+	GetWebhookDeadLetters() ([]v3.WebhookDeadLetter, error)
+	GetWebhookDeadLetter(id uint64) (v3.WebhookDeadLetter, error)
+	DeleteWebhookDeadLetter(id uint64) error
 }
 
 // SettingReader is the common interface for reading exchanges, assets configuration.
@@ -58,6 +80,9 @@ type SettingReader interface {
 
 	GetGeneralData(key string) (v3.GeneralData, error)
 	GetPreferGasSource() (v3.PreferGasSource, error)
+	GetSetRateGasPolicy() (v3.SetRateGasPolicy, error)
+	GetRiskLimits() (v3.RiskLimits, error)
+	GetAddressAllowlist() ([]v3.AllowedAddress, error)
 }
 
 // ControlInfoInterface ...
@@ -67,6 +92,9 @@ type ControlInfoInterface interface {
 
 	GetRebalanceStatus() (bool, error)
 	SetRebalanceStatus(status bool) error
+
+	GetKillSwitch() (bool, error)
+	SetKillSwitch(enabled bool) error
 }
 
 // UpdateAssetExchangeOpts these type match user type define in common package so we just need to make an alias here
@@ -79,14 +107,5 @@ type UpdateExchangeOpts = v3.UpdateExchangeEntry
 // UpdateAssetOpts update asset options
 type UpdateAssetOpts = v3.UpdateAssetEntry
 
-// UpdateTradingPairOpts ...
-type UpdateTradingPairOpts struct {
-	ID              rtypes.TradingPairID `json:"id"`
-	PricePrecision  *uint64              `json:"price_precision"`
-	AmountPrecision *uint64              `json:"amount_precision"`
-	AmountLimitMin  *float64             `json:"amount_limit_min"`
-	AmountLimitMax  *float64             `json:"amount_limit_max"`
-	PriceLimitMin   *float64             `json:"price_limit_min"`
-	PriceLimitMax   *float64             `json:"price_limit_max"`
-	MinNotional     *float64             `json:"min_notional"`
-}
+// UpdateTradingPairOpts update trading pair options
+type UpdateTradingPairOpts = v3.UpdateTradingPairEntry
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type allowedAddressDB struct {
+	ID          uint64    `db:"id"`
+	AssetID     uint64    `db:"asset_id"`
+	ExchangeID  uint64    `db:"exchange_id"`
+	Type        string    `db:"type"`
+	Address     string    `db:"address"`
+	Description string    `db:"description"`
+	Created     time.Time `db:"created"`
+}
+
+func (a allowedAddressDB) ToCommon() common.AllowedAddress {
+	return common.AllowedAddress{
+		ID:          a.ID,
+		AssetID:     rtypes.AssetID(a.AssetID),
+		ExchangeID:  rtypes.ExchangeID(a.ExchangeID),
+		Type:        a.Type,
+		Address:     ethereum.HexToAddress(a.Address),
+		Description: a.Description,
+		Created:     a.Created,
+	}
+}
+
+// GetAddressAllowlist returns all allowed addresses.
+func (s *Storage) GetAddressAllowlist() ([]common.AllowedAddress, error) {
+	var records []allowedAddressDB
+	if err := s.stmts.getAllowedAddresses.Select(&records); err != nil {
+		return nil, fmt.Errorf("failed to get address allowlist, err=%s", err)
+	}
+	result := make([]common.AllowedAddress, 0, len(records))
+	for _, r := range records {
+		result = append(result, r.ToCommon())
+	}
+	return result, nil
+}
+
+func (s *Storage) addAllowedAddress(tx *sqlx.Tx, entry common.AddAllowedAddressEntry) error {
+	var id uint64
+	err := tx.Stmtx(s.stmts.newAllowedAddress).Get(&id,
+		entry.AssetID, entry.ExchangeID, entry.Type, entry.Address.Hex(), entry.Description)
+	if err != nil {
+		if pErr, ok := err.(*pq.Error); ok && pErr.Code == errCodeUniqueViolation {
+			return common.ErrAllowedAddressExists
+		}
+		return fmt.Errorf("failed to add allowed address, err=%s", err)
+	}
+	s.l.Infow("allowed address added", "id", id, "asset_id", entry.AssetID, "exchange_id", entry.ExchangeID,
+		"type", entry.Type, "address", entry.Address.Hex())
+	return nil
+}
+
+func (s *Storage) removeAllowedAddress(tx *sqlx.Tx, id uint64) error {
+	var removed uint64
+	err := tx.Stmtx(s.stmts.deleteAllowedAddress).Get(&removed, id)
+	switch err {
+	case nil:
+		s.l.Infow("allowed address removed", "id", removed)
+		return nil
+	case sql.ErrNoRows:
+		return common.ErrNotFound
+	default:
+		return fmt.Errorf("failed to remove allowed address, err=%s", err)
+	}
+}
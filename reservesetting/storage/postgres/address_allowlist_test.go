@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func TestAddressAllowlist(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		require.NoError(t, tearDown())
+	}()
+	s, err := NewStorage(db)
+	require.NoError(t, err)
+	initData(t, s)
+
+	reserve := ethereum.HexToAddress("0x63825c174ab367968EC60f061753D3bbD36A0D8F")
+	confirm := func(entries ...common.SettingChangeEntry) error {
+		id, err := s.CreateSettingChange(common.ChangeCatalogAddressAllowlist, common.SettingChange{ChangeList: entries})
+		require.NoError(t, err)
+		_, err = s.ConfirmSettingChange(id, true)
+		return err
+	}
+	add := common.SettingChangeEntry{
+		Type: common.ChangeTypeAddAllowedAddress,
+		Data: &common.AddAllowedAddressEntry{
+			AssetID:    1,
+			ExchangeID: binance,
+			Type:       common.AllowedAddressWithdraw,
+			Address:    reserve,
+		},
+	}
+	require.NoError(t, confirm(add))
+	allowlist, err := s.GetAddressAllowlist()
+	require.NoError(t, err)
+	require.Len(t, allowlist, 1)
+	require.Equal(t, reserve, allowlist[0].Address)
+	require.Equal(t, common.AllowedAddressWithdraw, allowlist[0].Type)
+
+	require.Error(t, confirm(add))
+
+	require.NoError(t, confirm(common.SettingChangeEntry{
+		Type: common.ChangeTypeRemoveAllowedAddress,
+		Data: &common.RemoveAllowedAddressEntry{ID: allowlist[0].ID},
+	}))
+	allowlist, err = s.GetAddressAllowlist()
+	require.NoError(t, err)
+	require.Len(t, allowlist, 0)
+}
@@ -19,6 +19,25 @@ type exchangeDB struct {
 	TradingFeeMaker sql.NullFloat64   `db:"trading_fee_maker"`
 	TradingFeeTaker sql.NullFloat64   `db:"trading_fee_taker"`
 	Disable         bool              `db:"disable"`
+	Venue           string            `db:"venue"`
+	Credentials     string            `db:"credentials"`
+}
+
+func (e exchangeDB) ToCommon() common.Exchange {
+	result := common.Exchange{
+		ID:          e.ID,
+		Name:        e.Name,
+		Venue:       e.Venue,
+		Credentials: e.Credentials,
+		Disable:     e.Disable,
+	}
+	if e.TradingFeeMaker.Valid {
+		result.TradingFeeMaker = e.TradingFeeMaker.Float64
+	}
+	if e.TradingFeeTaker.Valid {
+		result.TradingFeeTaker = e.TradingFeeTaker.Float64
+	}
+	return result
 }
 
 func (s *Storage) GetExchanges() ([]common.Exchange, error) {
@@ -32,28 +51,13 @@ func (s *Storage) GetExchanges() ([]common.Exchange, error) {
 	}
 
 	for _, qResult := range qResults {
-		result := common.Exchange{
-			ID:      qResult.ID,
-			Name:    qResult.Name,
-			Disable: qResult.Disable,
-		}
-
-		if qResult.TradingFeeMaker.Valid {
-			result.TradingFeeMaker = qResult.TradingFeeMaker.Float64
-		}
-		if qResult.TradingFeeTaker.Valid {
-			result.TradingFeeTaker = qResult.TradingFeeTaker.Float64
-		}
-		results = append(results, result)
+		results = append(results, qResult.ToCommon())
 	}
 	return results, nil
 }
 
 func (s *Storage) GetExchange(id rtypes.ExchangeID) (common.Exchange, error) {
-	var (
-		qResult = exchangeDB{}
-		result  common.Exchange
-	)
+	var qResult = exchangeDB{}
 
 	s.l.Debugw("querying exchange from database", "id", id)
 	if err := s.stmts.getExchange.Get(&qResult, id); err != nil {
@@ -62,44 +66,34 @@ func (s *Storage) GetExchange(id rtypes.ExchangeID) (common.Exchange, error) {
 		}
 		return common.Exchange{}, err
 	}
-	result = common.Exchange{
-		ID:      qResult.ID,
-		Name:    qResult.Name,
-		Disable: qResult.Disable,
-	}
-	if qResult.TradingFeeMaker.Valid {
-		result.TradingFeeMaker = qResult.TradingFeeMaker.Float64
-	}
-	if qResult.TradingFeeTaker.Valid {
-		result.TradingFeeTaker = qResult.TradingFeeTaker.Float64
-	}
-	return result, nil
+	return qResult.ToCommon(), nil
 }
 
 // GetExchangeByName return exchange by its name
 func (s *Storage) GetExchangeByName(name string) (common.Exchange, error) {
-	var (
-		qResult = exchangeDB{}
-		result  common.Exchange
-	)
+	var qResult = exchangeDB{}
 	s.l.Debugw("querying exchange from database", "name", name)
 	if err := s.stmts.getExchangeByName.Get(&qResult, name); err != nil {
 		if err == sql.ErrNoRows {
-			return result, common.ErrNotFound
+			return common.Exchange{}, common.ErrNotFound
 		}
+		return common.Exchange{}, err
 	}
-	result = common.Exchange{
-		ID:      qResult.ID,
-		Name:    qResult.Name,
-		Disable: qResult.Disable,
-	}
-	if qResult.TradingFeeMaker.Valid {
-		result.TradingFeeMaker = qResult.TradingFeeMaker.Float64
-	}
-	if qResult.TradingFeeTaker.Valid {
-		result.TradingFeeTaker = qResult.TradingFeeTaker.Float64
+	return qResult.ToCommon(), nil
+}
+
+func (s *Storage) createExchange(tx *sqlx.Tx, entry common.CreateExchangeEntry) (rtypes.ExchangeID, error) {
+	var id rtypes.ExchangeID
+	err := tx.Stmtx(s.stmts.newExchange).Get(&id,
+		entry.Name, entry.Venue, entry.Credentials, entry.TradingFeeMaker, entry.TradingFeeTaker)
+	if err != nil {
+		if pErr, ok := err.(*pq.Error); ok && pErr.Code == errCodeUniqueViolation {
+			return 0, common.ErrExchangeExists
+		}
+		return 0, fmt.Errorf("failed to create exchange err=%s", err)
 	}
-	return result, nil
+	s.l.Infow("exchange created", "id", id, "name", entry.Name, "venue", entry.Venue)
+	return id, nil
 }
 
 func (s *Storage) UpdateExchange(id rtypes.ExchangeID, updateOpts storage.UpdateExchangeOpts) error {
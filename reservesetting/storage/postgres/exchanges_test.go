@@ -104,3 +104,51 @@ func TestStorage_GetUpdateByName(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, exchangeByID, exchangeByName)
 }
+
+func TestStorage_CreateExchange(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		assert.NoError(t, tearDown())
+	}()
+
+	s, err := NewStorage(db)
+	require.NoError(t, err)
+
+	create := commonv3.SettingChangeEntry{
+		Type: commonv3.ChangeTypeCreateExchange,
+		Data: &commonv3.CreateExchangeEntry{
+			Name:            "binance_mm",
+			Venue:           commonv3.VenueBinance,
+			Credentials:     "binance_mm",
+			TradingFeeMaker: commonv3.FloatPointer(0.001),
+			TradingFeeTaker: commonv3.FloatPointer(0.001),
+		},
+	}
+	id, err := s.CreateSettingChange(commonv3.ChangeCatalogUpdateExchange, commonv3.SettingChange{
+		ChangeList: []commonv3.SettingChangeEntry{create},
+	})
+	require.NoError(t, err)
+	adr, err := s.ConfirmSettingChange(id, true)
+	require.NoError(t, err)
+	require.Len(t, adr.AddedExchanges, 1)
+
+	exchange, err := s.GetExchangeByName("binance_mm")
+	require.NoError(t, err)
+	assert.Equal(t, adr.AddedExchanges[0], exchange.ID)
+	assert.Equal(t, rtypes.ExchangeID(len(common.ValidExchangeNames)+1), exchange.ID)
+	assert.Equal(t, commonv3.VenueBinance, exchange.Venue)
+	assert.Equal(t, "binance_mm", exchange.Credentials)
+	assert.Equal(t, "binance_mm", exchange.Name)
+
+	huobi, err := s.GetExchange(rtypes.Huobi)
+	require.NoError(t, err)
+	assert.Equal(t, commonv3.VenueHuobi, huobi.Venue)
+
+	// name of exchange account must be unique
+	id, err = s.CreateSettingChange(commonv3.ChangeCatalogUpdateExchange, commonv3.SettingChange{
+		ChangeList: []commonv3.SettingChangeEntry{create},
+	})
+	require.NoError(t, err)
+	_, err = s.ConfirmSettingChange(id, true)
+	require.Error(t, err)
+}
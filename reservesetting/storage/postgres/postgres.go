@@ -20,8 +20,11 @@ type Storage struct {
 }
 
 func (s *Storage) initExchanges() error {
-	const query = `INSERT INTO "exchanges" (id, name)
+	// built-in accounts are named after their venue and use the venue credentials.
+	const query = `INSERT INTO "exchanges" (id, name, venue, credentials)
 VALUES (unnest($1::INT[]),
+        unnest($2::TEXT[]),
+        unnest($2::TEXT[]),
         unnest($2::TEXT[])) ON CONFLICT(name) DO NOTHING;`
 
 	var (
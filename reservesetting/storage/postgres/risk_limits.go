@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+const (
+	keyRiskLimits = "risk_limits"
+	keyKillSwitch = "risk_kill_switch"
+)
+
+// GetRiskLimits returns the risk limits of core, all checks are disabled if limits were never set.
+func (s *Storage) GetRiskLimits() (v3.RiskLimits, error) {
+	var result v3.RiskLimits
+	data, err := s.GetGeneralData(keyRiskLimits)
+	switch err {
+	case nil:
+	case v3.ErrNotFound:
+		return result, nil
+	default:
+		return result, err
+	}
+	err = json.Unmarshal([]byte(data.Value), &result)
+	return result, err
+}
+
+func (s *Storage) setRiskLimits(tx *sqlx.Tx, limits v3.RiskLimits) error {
+	byteData, err := json.Marshal(limits)
+	if err != nil {
+		return err
+	}
+	var id uint64
+	return tx.NamedStmt(s.stmts.setGeneralData).Get(&id, v3.GeneralData{
+		Key:   keyRiskLimits,
+		Value: string(byteData),
+	})
+}
+
+// GetKillSwitch returns true if the kill switch is on and core must not trade, withdraw or deposit.
+func (s *Storage) GetKillSwitch() (bool, error) {
+	data, err := s.GetGeneralData(keyKillSwitch)
+	switch err {
+	case nil:
+	case v3.ErrNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+	return strconv.ParseBool(data.Value)
+}
+
+// SetKillSwitch turns the kill switch on or off.
+func (s *Storage) SetKillSwitch(enabled bool) error {
+	_, err := s.SetGeneralData(v3.GeneralData{
+		Key:   keyKillSwitch,
+		Value: strconv.FormatBool(enabled),
+	})
+	return err
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+	"github.com/KyberNetwork/reserve-data/lib/rtypes"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func TestRiskLimits(t *testing.T) {
+	db, teardown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		require.NoError(t, teardown())
+	}()
+
+	s, err := NewStorage(db)
+	require.NoError(t, err)
+
+	limits, err := s.GetRiskLimits()
+	require.NoError(t, err)
+	require.Equal(t, common.RiskLimits{}, limits)
+
+	expected := common.RiskLimits{
+		MaxOrderNotional:  map[rtypes.AssetID]float64{1: 50},
+		MaxPriceDeviation: 0.02,
+		MaxDailyWithdraw:  map[rtypes.AssetID]float64{1: 500},
+	}
+	id, err := s.CreateSettingChange(common.ChangeCatalogRiskLimits, common.SettingChange{
+		ChangeList: []common.SettingChangeEntry{
+			{
+				Type: common.ChangeTypeUpdateRiskLimits,
+				Data: &common.UpdateRiskLimitsEntry{RiskLimits: expected},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = s.ConfirmSettingChange(id, true)
+	require.NoError(t, err)
+	limits, err = s.GetRiskLimits()
+	require.NoError(t, err)
+	require.Equal(t, expected, limits)
+
+	on, err := s.GetKillSwitch()
+	require.NoError(t, err)
+	require.False(t, on)
+	require.NoError(t, s.SetKillSwitch(true))
+	on, err = s.GetKillSwitch()
+	require.NoError(t, err)
+	require.True(t, on)
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+const (
+	keySetRateGasPolicy = "set-rate-gas-policy"
+)
+
+// GetSetRateGasPolicy returns the set rate gas policy, v3.ErrNotFound if it was never set.
+func (s *Storage) GetSetRateGasPolicy() (v3.SetRateGasPolicy, error) {
+	var result v3.SetRateGasPolicy
+	data, err := s.GetGeneralData(keySetRateGasPolicy)
+	if err != nil {
+		return result, err
+	}
+	err = json.Unmarshal([]byte(data.Value), &result)
+	return result, err
+}
+
+// SetSetRateGasPolicy stores the set rate gas policy.
+func (s *Storage) SetSetRateGasPolicy(policy v3.SetRateGasPolicy) error {
+	byteData, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	_, err = s.SetGeneralData(v3.GeneralData{
+		Key:   keySetRateGasPolicy,
+		Value: string(byteData),
+	})
+	return err
+}
@@ -187,12 +187,43 @@ func (s *Storage) applyChange(tx *sqlx.Tx, i int, entry common.SettingChangeEntr
 			s.l.Errorw("update asset exchange", "index", i, "err", err)
 			return err
 		}
+	case *common.UpdateTradingPairEntry:
+		err = s.updateTradingPair(tx, e.ID, *e)
+		if err != nil {
+			s.l.Errorw("update trading pair", "index", i, "err", err)
+			return err
+		}
+	case *common.UpdateRiskLimitsEntry:
+		err = s.setRiskLimits(tx, e.RiskLimits)
+		if err != nil {
+			s.l.Errorw("update risk limits", "index", i, "err", err)
+			return err
+		}
+	case *common.AddAllowedAddressEntry:
+		err = s.addAllowedAddress(tx, *e)
+		if err != nil {
+			s.l.Errorw("add allowed address", "index", i, "err", err)
+			return err
+		}
+	case *common.RemoveAllowedAddressEntry:
+		err = s.removeAllowedAddress(tx, e.ID)
+		if err != nil {
+			s.l.Errorw("remove allowed address", "index", i, "err", err)
+			return err
+		}
 	case *common.UpdateExchangeEntry:
 		err = s.updateExchange(tx, e.ExchangeID, *e)
 		if err != nil {
 			s.l.Errorw("update exchange", "index", i, "err", err)
 			return err
 		}
+	case *common.CreateExchangeEntry:
+		exchangeID, err := s.createExchange(tx, *e)
+		if err != nil {
+			s.l.Errorw("create exchange", "index", i, "err", err)
+			return err
+		}
+		adr.AddedExchanges = append(adr.AddedExchanges, exchangeID)
 	case *common.DeleteAssetExchangeEntry:
 		err = s.deleteAssetExchange(tx, e.AssetExchangeID)
 		if err != nil {
@@ -217,6 +248,10 @@ func (s *Storage) applyChange(tx *sqlx.Tx, i int, entry common.SettingChangeEntr
 			s.l.Errorw("set feed configuration", "index", i, "err", err)
 			return err
 		}
+	case *common.SetStepFunctionEntry, *common.SetTokenControlInfoEntry:
+		// applied to the pricing contract by the setting server before the change is confirmed
+	case *common.ReserveAdminEntry:
+		// sent to the reserve contract by core before the change is confirmed
 	default:
 		return fmt.Errorf("unexpected change object %+v", e)
 	}
@@ -10,6 +10,7 @@ type preparedStmts struct {
 	getExchange                    *sqlx.Stmt
 	getExchangeByName              *sqlx.Stmt
 	updateExchange                 *sqlx.NamedStmt
+	newExchange                    *sqlx.Stmt
 	newAsset                       *sqlx.NamedStmt
 	newAssetExchange               *sqlx.NamedStmt
 	updateAssetExchange            *sqlx.NamedStmt
@@ -58,6 +59,12 @@ type preparedStmts struct {
 
 	getGeneralData *sqlx.Stmt
 	setGeneralData *sqlx.NamedStmt
+
+	newAllowedAddress    *sqlx.Stmt
+	getAllowedAddresses  *sqlx.Stmt
+	deleteAllowedAddress *sqlx.Stmt
+
+	webhook *webhookStmts
 }
 
 func newPreparedStmts(db *sqlx.DB) (*preparedStmts, error) {
@@ -65,6 +72,10 @@ func newPreparedStmts(db *sqlx.DB) (*preparedStmts, error) {
 	if err != nil {
 		return nil, err
 	}
+	newExchange, err := newExchangeStatement(db)
+	if err != nil {
+		return nil, err
+	}
 
 	newAsset, getAsset, updateAsset, getAssetBySymbol, err := assetStatements(db)
 	if err != nil {
@@ -167,11 +178,22 @@ func newPreparedStmts(db *sqlx.DB) (*preparedStmts, error) {
 		return nil, err
 	}
 
+	newAllowedAddress, getAllowedAddresses, deleteAllowedAddress, err := addressAllowlistStatements(db)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := webhookStatements(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return &preparedStmts{
 		getExchanges:                   getExchanges,
 		getExchange:                    getExchange,
 		getExchangeByName:              getExchangeByName,
 		updateExchange:                 updateExchange,
+		newExchange:                    newExchange,
 		newAsset:                       newAsset,
 		newAssetExchange:               newAssetExchange,
 		updateAssetExchange:            updateAssetExchange,
@@ -220,6 +242,12 @@ func newPreparedStmts(db *sqlx.DB) (*preparedStmts, error) {
 
 		getGeneralData: getGeneralDataStmt,
 		setGeneralData: setGeneralDataStmt,
+
+		newAllowedAddress:    newAllowedAddress,
+		getAllowedAddresses:  getAllowedAddresses,
+		deleteAllowedAddress: deleteAllowedAddress,
+
+		webhook: webhook,
 	}, nil
 }
 
@@ -573,6 +601,7 @@ func assetExchangeStatements(db *sqlx.DB) (*sqlx.NamedStmt, *sqlx.NamedStmt, *sq
 		SET symbol = COALESCE(:symbol, symbol),
 		    deposit_address = COALESCE(:deposit_address, deposit_address),
 		    min_deposit           = COALESCE(:min_deposit, min_deposit),
+		    withdraw_fee = COALESCE(:withdraw_fee, withdraw_fee),
 		    target_recommended = coalesce(:target_recommended,target_recommended),
 		    target_ratio = coalesce(:target_ratio, target_ratio)
 		WHERE id = :id RETURNING id;`
@@ -663,6 +692,17 @@ func exchangeStatements(db *sqlx.DB) (*sqlx.Stmt, *sqlx.Stmt, *sqlx.Stmt, *sqlx.
 	return getExchanges, getExchange, getExchangeByName, updateExchange, nil
 }
 
+func newExchangeStatement(db *sqlx.DB) (*sqlx.Stmt, error) {
+	// ids of built-in exchanges are fixed, new accounts take the next one.
+	const newExchangeQuery = `INSERT INTO "exchanges" (id, name, venue, credentials, trading_fee_maker, trading_fee_taker)
+	SELECT COALESCE(MAX(id), 0) + 1, $1, $2, $3, $4, $5 FROM "exchanges" RETURNING id;`
+	newExchange, err := db.Preparex(newExchangeQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare new exchange")
+	}
+	return newExchange, nil
+}
+
 func tradingByStatements(db *sqlx.DB) (*sqlx.Stmt, *sqlx.Stmt, *sqlx.Stmt, error) {
 	const createTradingByQuery = `SELECT new_trading_by FROM new_trading_by($1,$2);`
 	tradingBy, err := db.Preparex(createTradingByQuery)
@@ -797,3 +837,80 @@ func generalDataStatements(db *sqlx.DB) (*sqlx.NamedStmt, *sqlx.Stmt, error) {
 	}
 	return setGeneralDataStmt, getGeneralDataStmt, err
 }
+
+func addressAllowlistStatements(db *sqlx.DB) (*sqlx.Stmt, *sqlx.Stmt, *sqlx.Stmt, error) {
+	const newQuery = `INSERT INTO address_allowlist(asset_id, exchange_id, type, address, description)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id;`
+	newStmt, err := db.Preparex(newQuery)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	const getQuery = `SELECT id, asset_id, exchange_id, type, address, description, created
+		FROM address_allowlist ORDER BY id;`
+	getStmt, err := db.Preparex(getQuery)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	const deleteQuery = `DELETE FROM address_allowlist WHERE id = $1 RETURNING id;`
+	deleteStmt, err := db.Preparex(deleteQuery)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return newStmt, getStmt, deleteStmt, nil
+}
+
+type webhookStmts struct {
+	newSubscription    *sqlx.Stmt
+	getSubscriptions   *sqlx.Stmt
+	getSubscription    *sqlx.Stmt
+	updateSubscription *sqlx.Stmt
+	deleteSubscription *sqlx.Stmt
+	newDelivery        *sqlx.Stmt
+	getDeliveries      *sqlx.Stmt
+	newDeadLetter      *sqlx.Stmt
+	getDeadLetters     *sqlx.Stmt
+	getDeadLetter      *sqlx.Stmt
+	deleteDeadLetter   *sqlx.Stmt
+}
+
+func webhookStatements(db *sqlx.DB) (*webhookStmts, error) {
+	const (
+		subscriptionFields = `id, url, events, key_id, secret, active, created`
+		deadLetterFields   = `id, subscription_id, event_id, event, payload, attempts, error, created`
+	)
+	stmts := &webhookStmts{}
+	for _, q := range []struct {
+		stmt  **sqlx.Stmt
+		query string
+	}{
+		{&stmts.newSubscription, `INSERT INTO webhook_subscriptions(url, events, key_id, secret, active)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id;`},
+		{&stmts.getSubscriptions, `SELECT ` + subscriptionFields + ` FROM webhook_subscriptions ORDER BY id;`},
+		{&stmts.getSubscription, `SELECT ` + subscriptionFields + ` FROM webhook_subscriptions WHERE id = $1;`},
+		{&stmts.updateSubscription, `UPDATE webhook_subscriptions
+		SET url    = coalesce($2, url),
+		    events = coalesce($3, events),
+		    key_id = coalesce($4, key_id),
+		    secret = coalesce($5, secret),
+		    active = coalesce($6, active)
+		WHERE id = $1 RETURNING id;`},
+		{&stmts.deleteSubscription, `DELETE FROM webhook_subscriptions WHERE id = $1 RETURNING id;`},
+		{&stmts.newDelivery, `INSERT INTO webhook_deliveries(subscription_id, event_id, event, attempt, status_code, error, success)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id;`},
+		{&stmts.getDeliveries, `SELECT id, subscription_id, event_id, event, attempt, status_code, error, success, created
+		FROM webhook_deliveries
+		WHERE ($1 = 0 OR subscription_id = $1) AND created >= $2 AND created <= $3 ORDER BY id;`},
+		{&stmts.newDeadLetter, `INSERT INTO webhook_dead_letters(subscription_id, event_id, event, payload, attempts, error)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id;`},
+		{&stmts.getDeadLetters, `SELECT ` + deadLetterFields + ` FROM webhook_dead_letters ORDER BY id;`},
+		{&stmts.getDeadLetter, `SELECT ` + deadLetterFields + ` FROM webhook_dead_letters WHERE id = $1;`},
+		{&stmts.deleteDeadLetter, `DELETE FROM webhook_dead_letters WHERE id = $1 RETURNING id;`},
+	} {
+		stmt, err := db.Preparex(q.query)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to prepare %s", q.query)
+		}
+		*q.stmt = stmt
+	}
+	return stmts, nil
+}
@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type webhookSubscriptionDB struct {
+	ID      uint64         `db:"id"`
+	URL     string         `db:"url"`
+	Events  pq.StringArray `db:"events"`
+	KeyID   string         `db:"key_id"`
+	Secret  string         `db:"secret"`
+	Active  bool           `db:"active"`
+	Created time.Time      `db:"created"`
+}
+
+func (w webhookSubscriptionDB) ToCommon() common.WebhookSubscription {
+	return common.WebhookSubscription{
+		ID:      w.ID,
+		URL:     w.URL,
+		Events:  []string(w.Events),
+		KeyID:   w.KeyID,
+		Secret:  w.Secret,
+		Active:  w.Active,
+		Created: w.Created,
+	}
+}
+
+type webhookDeliveryDB struct {
+	ID             uint64    `db:"id"`
+	SubscriptionID uint64    `db:"subscription_id"`
+	EventID        string    `db:"event_id"`
+	Event          string    `db:"event"`
+	Attempt        int       `db:"attempt"`
+	StatusCode     int       `db:"status_code"`
+	Error          string    `db:"error"`
+	Success        bool      `db:"success"`
+	Created        time.Time `db:"created"`
+}
+
+type webhookDeadLetterDB struct {
+	ID             uint64    `db:"id"`
+	SubscriptionID uint64    `db:"subscription_id"`
+	EventID        string    `db:"event_id"`
+	Event          string    `db:"event"`
+	Payload        []byte    `db:"payload"`
+	Attempts       int       `db:"attempts"`
+	Error          string    `db:"error"`
+	Created        time.Time `db:"created"`
+}
+
+func (w webhookDeadLetterDB) ToCommon() common.WebhookDeadLetter {
+	return common.WebhookDeadLetter{
+		ID:             w.ID,
+		SubscriptionID: w.SubscriptionID,
+		EventID:        w.EventID,
+		Event:          w.Event,
+		Payload:        json.RawMessage(w.Payload),
+		Attempts:       w.Attempts,
+		Error:          w.Error,
+		Created:        w.Created,
+	}
+}
+
+// CreateWebhookSubscription stores a new webhook subscription and returns its id.
+func (s *Storage) CreateWebhookSubscription(sub common.WebhookSubscription) (uint64, error) {
+	var id uint64
+	err := s.stmts.webhook.newSubscription.Get(&id, sub.URL, pq.StringArray(sub.Events), sub.KeyID, sub.Secret, sub.Active)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook subscription, err=%s", err)
+	}
+	s.l.Infow("webhook subscription created", "id", id, "url", sub.URL, "events", sub.Events)
+	return id, nil
+}
+
+// GetWebhookSubscriptions returns all webhook subscriptions.
+func (s *Storage) GetWebhookSubscriptions() ([]common.WebhookSubscription, error) {
+	var records []webhookSubscriptionDB
+	if err := s.stmts.webhook.getSubscriptions.Select(&records); err != nil {
+		return nil, fmt.Errorf("failed to get webhook subscriptions, err=%s", err)
+	}
+	result := make([]common.WebhookSubscription, 0, len(records))
+	for _, r := range records {
+		result = append(result, r.ToCommon())
+	}
+	return result, nil
+}
+
+// GetWebhookSubscription returns the webhook subscription with given id.
+func (s *Storage) GetWebhookSubscription(id uint64) (common.WebhookSubscription, error) {
+	var record webhookSubscriptionDB
+	err := s.stmts.webhook.getSubscription.Get(&record, id)
+	switch err {
+	case nil:
+		return record.ToCommon(), nil
+	case sql.ErrNoRows:
+		return common.WebhookSubscription{}, common.ErrNotFound
+	default:
+		return common.WebhookSubscription{}, fmt.Errorf("failed to get webhook subscription, err=%s", err)
+	}
+}
+
+// UpdateWebhookSubscription updates the webhook subscription with given id.
+func (s *Storage) UpdateWebhookSubscription(id uint64, opts common.UpdateWebhookSubscriptionOpts) error {
+	var updated uint64
+	err := s.stmts.webhook.updateSubscription.Get(&updated, id, opts.URL, pq.StringArray(opts.Events),
+		opts.KeyID, opts.Secret, opts.Active)
+	switch err {
+	case nil:
+		s.l.Infow("webhook subscription updated", "id", id)
+		return nil
+	case sql.ErrNoRows:
+		return common.ErrNotFound
+	default:
+		return fmt.Errorf("failed to update webhook subscription, err=%s", err)
+	}
+}
+
+// DeleteWebhookSubscription deletes the webhook subscription with given id with its deliveries and dead letters.
+func (s *Storage) DeleteWebhookSubscription(id uint64) error {
+	var deleted uint64
+	err := s.stmts.webhook.deleteSubscription.Get(&deleted, id)
+	switch err {
+	case nil:
+		s.l.Infow("webhook subscription deleted", "id", id)
+		return nil
+	case sql.ErrNoRows:
+		return common.ErrNotFound
+	default:
+		return fmt.Errorf("failed to delete webhook subscription, err=%s", err)
+	}
+}
+
+// AddWebhookDelivery records an attempt to send an event to a webhook subscription.
+func (s *Storage) AddWebhookDelivery(d common.WebhookDelivery) error {
+	var id uint64
+	err := s.stmts.webhook.newDelivery.Get(&id, d.SubscriptionID, d.EventID, d.Event, d.Attempt, d.StatusCode, d.Error, d.Success)
+	if err != nil {
+		return fmt.Errorf("failed to add webhook delivery, err=%s", err)
+	}
+	return nil
+}
+
+// GetWebhookDeliveries returns delivery attempts created in [from, to] of a subscription, or of all
+// subscriptions if subscriptionID is 0.
+func (s *Storage) GetWebhookDeliveries(subscriptionID uint64, from, to time.Time) ([]common.WebhookDelivery, error) {
+	var records []webhookDeliveryDB
+	if err := s.stmts.webhook.getDeliveries.Select(&records, subscriptionID, from, to); err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries, err=%s", err)
+	}
+	result := make([]common.WebhookDelivery, 0, len(records))
+	for _, r := range records {
+		result = append(result, common.WebhookDelivery(r))
+	}
+	return result, nil
+}
+
+// AddWebhookDeadLetter stores an event that could not be sent and returns its id.
+func (s *Storage) AddWebhookDeadLetter(d common.WebhookDeadLetter) (uint64, error) {
+	var id uint64
+	err := s.stmts.webhook.newDeadLetter.Get(&id, d.SubscriptionID, d.EventID, d.Event, []byte(d.Payload), d.Attempts, d.Error)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add webhook dead letter, err=%s", err)
+	}
+	return id, nil
+}
+
+// GetWebhookDeadLetters returns all events that could not be sent.
+func (s *Storage) GetWebhookDeadLetters() ([]common.WebhookDeadLetter, error) {
+	var records []webhookDeadLetterDB
+	if err := s.stmts.webhook.getDeadLetters.Select(&records); err != nil {
+		return nil, fmt.Errorf("failed to get webhook dead letters, err=%s", err)
+	}
+	result := make([]common.WebhookDeadLetter, 0, len(records))
+	for _, r := range records {
+		result = append(result, r.ToCommon())
+	}
+	return result, nil
+}
+
+// GetWebhookDeadLetter returns the dead letter with given id.
+func (s *Storage) GetWebhookDeadLetter(id uint64) (common.WebhookDeadLetter, error) {
+	var record webhookDeadLetterDB
+	err := s.stmts.webhook.getDeadLetter.Get(&record, id)
+	switch err {
+	case nil:
+		return record.ToCommon(), nil
+	case sql.ErrNoRows:
+		return common.WebhookDeadLetter{}, common.ErrNotFound
+	default:
+		return common.WebhookDeadLetter{}, fmt.Errorf("failed to get webhook dead letter, err=%s", err)
+	}
+}
+
+// DeleteWebhookDeadLetter deletes the dead letter with given id.
+func (s *Storage) DeleteWebhookDeadLetter(id uint64) error {
+	var deleted uint64
+	err := s.stmts.webhook.deleteDeadLetter.Get(&deleted, id)
+	switch err {
+	case nil:
+		return nil
+	case sql.ErrNoRows:
+		return common.ErrNotFound
+	default:
+		return fmt.Errorf("failed to delete webhook dead letter, err=%s", err)
+	}
+}
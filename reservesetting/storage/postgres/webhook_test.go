@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func TestWebhookStorage(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB(migrationPath)
+	defer func() {
+		require.NoError(t, tearDown())
+	}()
+	s, err := NewStorage(db)
+	require.NoError(t, err)
+
+	id, err := s.CreateWebhookSubscription(common.WebhookSubscription{
+		URL:    "https://ops.local/hook",
+		Events: []string{common.WebhookDepositCompleted},
+		KeyID:  "ops",
+		Secret: "secret",
+		Active: true,
+	})
+	require.NoError(t, err)
+
+	inactive := false
+	newURL := "https://ops.local/hook2"
+	require.NoError(t, s.UpdateWebhookSubscription(id, common.UpdateWebhookSubscriptionOpts{
+		URL:    &newURL,
+		Events: []string{common.WebhookDepositCompleted, common.WebhookTradeFilled},
+		Active: &inactive,
+	}))
+	sub, err := s.GetWebhookSubscription(id)
+	require.NoError(t, err)
+	assert.Equal(t, newURL, sub.URL)
+	assert.Equal(t, []string{common.WebhookDepositCompleted, common.WebhookTradeFilled}, sub.Events)
+	assert.Equal(t, "ops", sub.KeyID)
+	assert.Equal(t, "secret", sub.Secret)
+	assert.False(t, sub.Active)
+	assert.Equal(t, common.ErrNotFound, s.UpdateWebhookSubscription(id+1, common.UpdateWebhookSubscriptionOpts{}))
+
+	require.NoError(t, s.AddWebhookDelivery(common.WebhookDelivery{
+		SubscriptionID: id,
+		EventID:        "e1",
+		Event:          common.WebhookTradeFilled,
+		Attempt:        1,
+		StatusCode:     500,
+		Error:          "unexpected status code 500",
+	}))
+	deliveries, err := s.GetWebhookDeliveries(0, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 500, deliveries[0].StatusCode)
+	deliveries, err = s.GetWebhookDeliveries(id+1, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 0)
+
+	deadLetterID, err := s.AddWebhookDeadLetter(common.WebhookDeadLetter{
+		SubscriptionID: id,
+		EventID:        "e1",
+		Event:          common.WebhookTradeFilled,
+		Payload:        json.RawMessage(`{"id":"e1"}`),
+		Attempts:       5,
+	})
+	require.NoError(t, err)
+	deadLetter, err := s.GetWebhookDeadLetter(deadLetterID)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"e1"}`, string(deadLetter.Payload))
+	require.NoError(t, s.DeleteWebhookDeadLetter(deadLetterID))
+	deadLetters, err := s.GetWebhookDeadLetters()
+	require.NoError(t, err)
+	assert.Len(t, deadLetters, 0)
+
+	require.NoError(t, s.DeleteWebhookSubscription(id))
+	_, err = s.GetWebhookSubscription(id)
+	assert.Equal(t, common.ErrNotFound, err)
+}
@@ -0,0 +1,225 @@
+// Package webhook sends activity and setting change notifications to webhook subscriptions. Requests are
+// signed with the key pair of the subscription by the httpsign-utils scheme used between reserve services.
+package webhook
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/KyberNetwork/httpsign-utils/sign"
+	"go.uber.org/zap"
+
+	v1common "github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/eventbus"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+const (
+	// HeaderEvent is the header holding the event of a webhook request.
+	HeaderEvent = "X-Webhook-Event"
+	// HeaderEventID is the header holding the event id of a webhook request, it is the same for all
+	// attempts to send an event so receivers can drop duplicates.
+	HeaderEventID = "X-Webhook-Event-ID"
+
+	defaultMaxAttempts = 5
+	defaultBackoff     = 2 * time.Second
+)
+
+// Storage is the storage of webhook subscriptions and their deliveries.
+type Storage interface {
+	GetWebhookSubscriptions() ([]common.WebhookSubscription, error)
+	AddWebhookDelivery(d common.WebhookDelivery) error
+	AddWebhookDeadLetter(d common.WebhookDeadLetter) (uint64, error)
+}
+
+// Payload is the body of webhook requests.
+type Payload struct {
+	ID    string      `json:"id"`
+	Event string      `json:"event"`
+	Time  uint64      `json:"time"` // in milliseconds
+	Data  interface{} `json:"data"`
+}
+
+// Dispatcher sends events to webhook subscriptions. A failed request is retried with exponential
+// backoff, the event is stored as a dead letter after the last attempt fails. Every attempt is recorded
+// in the delivery log.
+type Dispatcher struct {
+	storage     Storage
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+	l           *zap.SugaredLogger
+}
+
+// NewDispatcher creates a new Dispatcher.
+func NewDispatcher(storage Storage, client *http.Client) *Dispatcher {
+	return &Dispatcher{
+		storage:     storage,
+		client:      client,
+		maxAttempts: defaultMaxAttempts,
+		backoff:     defaultBackoff,
+		l:           zap.S(),
+	}
+}
+
+// Attach dispatches webhook events for activity and setting change events published to bus.
+func (d *Dispatcher) Attach(bus *eventbus.Bus) func() {
+	return bus.Subscribe("webhook", func(e eventbus.Event) {
+		if event, ok := webhookEvent(e); ok {
+			d.Dispatch(event, e.Data)
+		}
+	}, eventbus.ActivityCreated, eventbus.ActivityStatusChanged, eventbus.SettingChangeCreated, eventbus.SettingChangeApplied)
+}
+
+func webhookEvent(e eventbus.Event) (string, bool) {
+	switch e.Type {
+	case eventbus.SettingChangeCreated:
+		return common.WebhookSettingChangeCreated, true
+	case eventbus.SettingChangeApplied:
+		return common.WebhookSettingChangeConfirmed, true
+	case eventbus.ActivityCreated:
+		if activity, ok := e.Data.(v1common.ActivityRecord); ok {
+			return activityEvent(activity, "", "")
+		}
+	case eventbus.ActivityStatusChanged:
+		if change, ok := e.Data.(v1common.ActivityStatusChange); ok {
+			return activityEvent(change.Activity, change.PreviousExchangeStatus, change.PreviousMiningStatus)
+		}
+	}
+	return "", false
+}
+
+// activityEvent returns the webhook event of an activity which statuses were changed from the previous ones.
+func activityEvent(activity v1common.ActivityRecord, previousExchangeStatus, previousMiningStatus string) (string, bool) {
+	switch activity.Action {
+	case v1common.ActionDeposit:
+		if activity.ExchangeStatus == v1common.ExchangeStatusDone && previousExchangeStatus != v1common.ExchangeStatusDone {
+			return common.WebhookDepositCompleted, true
+		}
+	case v1common.ActionWithdraw:
+		failed := func(exchangeStatus, miningStatus string) bool {
+			return exchangeStatus == v1common.ExchangeStatusFailed || miningStatus == v1common.MiningStatusFailed
+		}
+		if failed(activity.ExchangeStatus, activity.MiningStatus) && !failed(previousExchangeStatus, previousMiningStatus) {
+			return common.WebhookWithdrawalFailed, true
+		}
+	case v1common.ActionTrade:
+		if activity.ExchangeStatus == v1common.ExchangeStatusDone && previousExchangeStatus != v1common.ExchangeStatusDone {
+			return common.WebhookTradeFilled, true
+		}
+	}
+	return "", false
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// Dispatch sends event with data to active subscriptions of the event, requests are sent in background.
+func (d *Dispatcher) Dispatch(event string, data interface{}) {
+	subscriptions, err := d.storage.GetWebhookSubscriptions()
+	if err != nil {
+		d.l.Errorw("failed to get webhook subscriptions, event is not sent", "event", event, "err", err)
+		return
+	}
+	payload := Payload{ID: newEventID(), Event: event, Time: v1common.NowInMillis(), Data: data}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		d.l.Errorw("failed to encode webhook payload", "event", event, "err", err)
+		return
+	}
+	for _, sub := range subscriptions {
+		if sub.Subscribed(event) {
+			go d.deliver(sub, payload.ID, event, body)
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(sub common.WebhookSubscription, eventID, event string, body []byte) {
+	var (
+		err     error
+		backoff = d.backoff
+	)
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		if attempt > 1 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		if err = d.attempt(sub, eventID, event, body, attempt); err == nil {
+			return
+		}
+	}
+	d.l.Errorw("failed to send webhook event, storing dead letter",
+		"subscription", sub.ID, "event", event, "event_id", eventID, "err", err)
+	_, sErr := d.storage.AddWebhookDeadLetter(common.WebhookDeadLetter{
+		SubscriptionID: sub.ID,
+		EventID:        eventID,
+		Event:          event,
+		Payload:        body,
+		Attempts:       d.maxAttempts,
+		Error:          err.Error(),
+	})
+	if sErr != nil {
+		d.l.Errorw("failed to store webhook dead letter", "subscription", sub.ID, "event_id", eventID, "err", sErr)
+	}
+}
+
+// Redeliver sends a dead letter to its subscription once, the attempt is recorded in the delivery log.
+func (d *Dispatcher) Redeliver(sub common.WebhookSubscription, deadLetter common.WebhookDeadLetter) error {
+	return d.attempt(sub, deadLetter.EventID, deadLetter.Event, deadLetter.Payload, deadLetter.Attempts+1)
+}
+
+func (d *Dispatcher) attempt(sub common.WebhookSubscription, eventID, event string, body []byte, attempt int) error {
+	statusCode, err := d.send(sub, eventID, event, body)
+	delivery := common.WebhookDelivery{
+		SubscriptionID: sub.ID,
+		EventID:        eventID,
+		Event:          event,
+		Attempt:        attempt,
+		StatusCode:     statusCode,
+		Success:        err == nil,
+	}
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+	if sErr := d.storage.AddWebhookDelivery(delivery); sErr != nil {
+		d.l.Warnw("failed to record webhook delivery", "subscription", sub.ID, "event_id", eventID, "err", sErr)
+	}
+	return err
+}
+
+func (d *Dispatcher) send(sub common.WebhookSubscription, eventID, event string, body []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, event)
+	req.Header.Set(HeaderEventID, eventID)
+	if req, err = sign.Sign(req, sub.KeyID, sub.Secret); err != nil {
+		return 0, err
+	}
+	rsp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, rsp.Body)
+		_ = rsp.Body.Close()
+	}()
+	if rsp.StatusCode < http.StatusOK || rsp.StatusCode >= http.StatusMultipleChoices {
+		return rsp.StatusCode, fmt.Errorf("unexpected status code %d", rsp.StatusCode)
+	}
+	return rsp.StatusCode, nil
+}
@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KyberNetwork/httpsign-utils/authenticator"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1common "github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/lib/eventbus"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type memoryStorage struct {
+	mu            sync.Mutex
+	subscriptions []common.WebhookSubscription
+	deliveries    []common.WebhookDelivery
+	deadLetters   []common.WebhookDeadLetter
+}
+
+func (s *memoryStorage) GetWebhookSubscriptions() ([]common.WebhookSubscription, error) {
+	return s.subscriptions, nil
+}
+
+func (s *memoryStorage) AddWebhookDelivery(d common.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deliveries = append(s.deliveries, d)
+	return nil
+}
+
+func (s *memoryStorage) AddWebhookDeadLetter(d common.WebhookDeadLetter) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deadLetters = append(s.deadLetters, d)
+	return uint64(len(s.deadLetters)), nil
+}
+
+func (s *memoryStorage) counts() (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.deliveries), len(s.deadLetters)
+}
+
+// newReceiver returns a server verifying signatures of key pair ops/secret, the first failures
+// requests are answered with status 500.
+func newReceiver(t *testing.T, failures int) (*httptest.Server, <-chan Payload) {
+	gin.SetMode(gin.TestMode)
+	auth, err := authenticator.NewAuthenticator(authenticator.KeyPair{AccessKeyID: "ops", SecretAccessKey: "secret"})
+	require.NoError(t, err)
+	received := make(chan Payload, 10)
+	var mu sync.Mutex
+	r := gin.New()
+	r.Use(auth.Authenticated())
+	r.POST("/hook", func(c *gin.Context) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failures > 0 {
+			failures--
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		body, err := ioutil.ReadAll(c.Request.Body)
+		require.NoError(t, err)
+		var p Payload
+		require.NoError(t, json.Unmarshal(body, &p))
+		assert.Equal(t, p.Event, c.GetHeader(HeaderEvent))
+		assert.Equal(t, p.ID, c.GetHeader(HeaderEventID))
+		received <- p
+	})
+	return httptest.NewServer(r), received
+}
+
+func TestDispatcherRetry(t *testing.T) {
+	srv, received := newReceiver(t, 2)
+	defer srv.Close()
+
+	s := &memoryStorage{subscriptions: []common.WebhookSubscription{
+		{ID: 1, URL: srv.URL + "/hook", Events: []string{common.WebhookTradeFilled}, KeyID: "ops", Secret: "secret", Active: true},
+		{ID: 2, URL: srv.URL + "/hook", Events: []string{common.WebhookTradeFilled}, KeyID: "ops", Secret: "secret"},
+	}}
+	d := NewDispatcher(s, srv.Client())
+	d.backoff = time.Millisecond
+
+	d.Dispatch(common.WebhookTradeFilled, map[string]string{"id": "trade"})
+	select {
+	case p := <-received:
+		assert.Equal(t, common.WebhookTradeFilled, p.Event)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook is not received")
+	}
+	require.Eventually(t, func() bool {
+		deliveries, _ := s.counts()
+		return deliveries == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.False(t, s.deliveries[0].Success)
+	assert.Equal(t, http.StatusInternalServerError, s.deliveries[0].StatusCode)
+	assert.True(t, s.deliveries[2].Success)
+	assert.Equal(t, 3, s.deliveries[2].Attempt)
+}
+
+func TestDispatcherDeadLetter(t *testing.T) {
+	srv, received := newReceiver(t, 0)
+	defer srv.Close()
+
+	sub := common.WebhookSubscription{
+		ID: 1, URL: srv.URL + "/hook", Events: []string{common.WebhookDepositCompleted}, KeyID: "ops", Secret: "wrong", Active: true,
+	}
+	s := &memoryStorage{subscriptions: []common.WebhookSubscription{sub}}
+	d := NewDispatcher(s, srv.Client())
+	d.backoff = time.Millisecond
+
+	d.Dispatch(common.WebhookDepositCompleted, nil)
+	require.Eventually(t, func() bool {
+		_, deadLetters := s.counts()
+		return deadLetters == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	deliveries, _ := s.counts()
+	assert.Equal(t, defaultMaxAttempts, deliveries)
+	deadLetter := s.deadLetters[0]
+	assert.Equal(t, common.WebhookDepositCompleted, deadLetter.Event)
+	assert.Equal(t, defaultMaxAttempts, deadLetter.Attempts)
+
+	sub.Secret = "secret"
+	require.NoError(t, d.Redeliver(sub, deadLetter))
+	p := <-received
+	assert.Equal(t, deadLetter.EventID, p.ID)
+}
+
+func TestWebhookEvent(t *testing.T) {
+	statusChange := func(action, exchangeStatus, miningStatus, previousExchangeStatus, previousMiningStatus string) eventbus.Event {
+		return eventbus.Event{Type: eventbus.ActivityStatusChanged, Data: v1common.ActivityStatusChange{
+			Activity:               v1common.ActivityRecord{Action: action, ExchangeStatus: exchangeStatus, MiningStatus: miningStatus},
+			PreviousExchangeStatus: previousExchangeStatus,
+			PreviousMiningStatus:   previousMiningStatus,
+		}}
+	}
+	tests := []struct {
+		event    eventbus.Event
+		expected string
+	}{
+		{statusChange(v1common.ActionDeposit, v1common.ExchangeStatusDone, v1common.MiningStatusMined, v1common.ExchangeStatusPending, v1common.MiningStatusMined),
+			common.WebhookDepositCompleted},
+		{statusChange(v1common.ActionDeposit, v1common.ExchangeStatusPending, v1common.MiningStatusMined, v1common.ExchangeStatusPending, v1common.MiningStatusPending),
+			""},
+		{statusChange(v1common.ActionWithdraw, v1common.ExchangeStatusFailed, "", v1common.ExchangeStatusSubmitted, ""),
+			common.WebhookWithdrawalFailed},
+		{statusChange(v1common.ActionWithdraw, v1common.ExchangeStatusDone, v1common.MiningStatusFailed, v1common.ExchangeStatusDone, v1common.MiningStatusPending),
+			common.WebhookWithdrawalFailed},
+		{statusChange(v1common.ActionTrade, v1common.ExchangeStatusDone, "", v1common.ExchangeStatusSubmitted, ""),
+			common.WebhookTradeFilled},
+		{eventbus.Event{Type: eventbus.ActivityCreated, Data: v1common.ActivityRecord{Action: v1common.ActionTrade, ExchangeStatus: v1common.ExchangeStatusDone}},
+			common.WebhookTradeFilled},
+		{eventbus.Event{Type: eventbus.SettingChangeCreated}, common.WebhookSettingChangeCreated},
+		{eventbus.Event{Type: eventbus.SettingChangeApplied}, common.WebhookSettingChangeConfirmed},
+		{eventbus.Event{Type: eventbus.PriceUpdated}, ""},
+	}
+	for _, tc := range tests {
+		event, ok := webhookEvent(tc.event)
+		assert.Equal(t, tc.expected != "", ok)
+		assert.Equal(t, tc.expected, event)
+	}
+}